@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	realtime "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+// A script to start games between bots, for soak testing the server or for
+// exhibition games.
+
+func main() {
+	natsURL := flag.String("nats-url", "nats://localhost:4222", "the NATS server URL")
+	bot1 := flag.String("bot1", "", "the username of the first bot; random if blank")
+	bot2 := flag.String("bot2", "", "the username of the second bot; random if blank")
+	tier := flag.Int("tier", 0, "the difficulty tier of the random bots; 0 for any")
+	lexicon := flag.String("lexicon", "CSW19", "the lexicon")
+	initialTime := flag.Int("initial-time", 600, "initial time per player, in seconds")
+	increment := flag.Int("increment", 0, "increment per turn, in seconds")
+	numGames := flag.Int("n", 1, "the number of games to start")
+	flag.Parse()
+
+	nc, err := nats.Connect(*natsURL)
+	if err != nil {
+		panic(err)
+	}
+	defer nc.Close()
+
+	req := &realtime.BotGameRequest{
+		GameRequest: &realtime.GameRequest{
+			Lexicon: *lexicon,
+			Rules: &realtime.GameRules{
+				BoardLayoutName:        entity.CrosswordGame,
+				LetterDistributionName: "English",
+				VariantName:            "classic",
+			},
+			InitialTimeSeconds: int32(*initialTime),
+			IncrementSeconds:   int32(*increment),
+			ChallengeRule:      macondopb.ChallengeRule_FIVE_POINT,
+			GameMode:           realtime.GameMode_REAL_TIME,
+		},
+		Bot1:    *bot1,
+		Bot2:    *bot2,
+		BotTier: int32(*tier),
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		panic(err)
+	}
+
+	for i := 0; i < *numGames; i++ {
+		msg, err := nc.Request("ipc.request.botGame", data, 10*time.Second)
+		if err != nil {
+			panic(err)
+		}
+		resp := &realtime.BotGameResponse{}
+		err = proto.Unmarshal(msg.Data, resp)
+		if err != nil {
+			panic(err)
+		}
+		if resp.Error != "" {
+			panic(resp.Error)
+		}
+		fmt.Println(resp.GameId)
+	}
+}
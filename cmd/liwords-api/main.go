@@ -14,11 +14,15 @@ import (
 
 	"github.com/domino14/liwords/pkg/apiserver"
 	"github.com/domino14/liwords/pkg/bus"
+	"github.com/domino14/liwords/pkg/catalog"
 	"github.com/domino14/liwords/pkg/gameplay"
 	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/quickpair"
 	"github.com/domino14/liwords/pkg/stores/session"
 	"github.com/domino14/liwords/pkg/stores/soughtgame"
 	"github.com/domino14/liwords/pkg/stores/stats"
+	tournamentstore "github.com/domino14/liwords/pkg/stores/tournament"
+	"github.com/domino14/liwords/pkg/tournament"
 	"github.com/domino14/macondo/alphabet"
 
 	"github.com/domino14/liwords/pkg/registration"
@@ -34,6 +38,7 @@ import (
 	"github.com/domino14/liwords/pkg/stores/user"
 	pkguser "github.com/domino14/liwords/pkg/user"
 	gameservice "github.com/domino14/liwords/rpc/api/proto/game_service"
+	tournamentservice "github.com/domino14/liwords/rpc/api/proto/tournament_service"
 	userservice "github.com/domino14/liwords/rpc/api/proto/user_service"
 )
 
@@ -107,16 +112,37 @@ func main() {
 	}
 
 	gameStore := game.NewCache(tmpGameStore)
-	soughtGameStore := soughtgame.NewMemoryStore()
+	soughtGameStore := soughtgame.NewRedisStore(redisPool,
+		time.Duration(cfg.SeekExpirationMinutes)*time.Minute)
 	listStatStore, err := stats.NewListStatStore(cfg.DBConnString)
 	if err != nil {
 		panic(err)
 	}
+	tmpTournamentStore, err := tournamentstore.NewDBStore(cfg.DBConnString)
+	if err != nil {
+		panic(err)
+	}
+	tournamentStore := tournamentstore.NewCache(tmpTournamentStore)
 
 	authenticationService := auth.NewAuthenticationService(userStore, sessionStore, cfg.SecretKey, cfg.MailgunKey)
 	registrationService := registration.NewRegistrationService(userStore)
-	gameService := gameplay.NewGameService(userStore, gameStore)
-	profileService := pkguser.NewProfileService(userStore)
+	// Create any caches, and fill them with everything in the catalog.
+	alphabet.CreateLetterDistributionCache()
+	gaddag.CreateGaddagCache()
+	lexCatalog, err := catalog.New(&cfg.MacondoConfig)
+	if err != nil {
+		panic(err)
+	}
+	if err := lexCatalog.Preload(); err != nil {
+		panic(err)
+	}
+
+	gameService := gameplay.NewGameService(userStore, gameStore, tournamentStore, lexCatalog)
+	annotationService := gameplay.NewAnnotationService(cfg, gameStore, listStatStore)
+	importService := gameplay.NewImportService(cfg, userStore, gameStore, lexCatalog)
+	tournamentService := tournament.NewTournamentService(userStore, tournamentStore, lexCatalog)
+	profileService := pkguser.NewProfileService(userStore, listStatStore)
+	leaderboardService := pkguser.NewLeaderboardService(userStore, userStore)
 
 	router.Handle("/ping", http.HandlerFunc(pingEndpoint))
 
@@ -129,12 +155,20 @@ func main() {
 	router.Handle(gameservice.GameMetadataServicePathPrefix,
 		middlewares.Then(gameservice.NewGameMetadataServiceServer(gameService, nil)))
 
+	router.Handle(gameservice.AnnotationServicePathPrefix,
+		middlewares.Then(gameservice.NewAnnotationServiceServer(annotationService, nil)))
+
+	router.Handle(gameservice.GameImportServicePathPrefix,
+		middlewares.Then(gameservice.NewGameImportServiceServer(importService, nil)))
+
 	router.Handle(userservice.ProfileServicePathPrefix,
 		middlewares.Then(userservice.NewProfileServiceServer(profileService, nil)))
 
-	// Create any caches
-	alphabet.CreateLetterDistributionCache()
-	gaddag.CreateGaddagCache()
+	router.Handle(userservice.LeaderboardServicePathPrefix,
+		middlewares.Then(userservice.NewLeaderboardServiceServer(leaderboardService, nil)))
+
+	router.Handle(tournamentservice.TournamentServicePathPrefix,
+		middlewares.Then(tournamentservice.NewTournamentServiceServer(tournamentService, nil)))
 
 	srv := &http.Server{
 		Addr:         cfg.ListenAddr,
@@ -148,10 +182,12 @@ func main() {
 	presenceStore := user.NewRedisPresenceStore(redisPool)
 	// Handle bus.
 	pubsubBus, err := bus.NewBus(cfg, userStore, gameStore, soughtGameStore,
-		presenceStore, listStatStore, redisPool)
+		presenceStore, listStatStore, tournamentStore, game.NewRedisLeaseStore(redisPool),
+		quickpair.NewRedisStore(redisPool), redisPool, lexCatalog)
 	if err != nil {
 		panic(err)
 	}
+	tournamentService.SetGameStarter(pubsubBus)
 
 	ctx, pubsubCancel := context.WithCancel(context.Background())
 
@@ -166,8 +202,12 @@ func main() {
 			// Error from closing listeners, or context timeout:
 			log.Error().Msgf("HTTP server Shutdown: %v", err)
 		}
-		shutdownCancel()
 		pubsubCancel()
+		// Let the other nodes take over our games.
+		if err := pubsubBus.Handoff(ctx); err != nil {
+			log.Err(err).Msg("game-handoff")
+		}
+		shutdownCancel()
 		close(idleConnsClosed)
 	}()
 	log.Info().Msg("starting listening...")
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/config"
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/stats"
+	"github.com/domino14/liwords/pkg/stores/user"
+)
+
+// A command that rebuilds the ratings and/or the profile stats of some
+// variants from scratch, by replaying every game that ended in them in the
+// order they ended. Everything after a -- is the server's configuration:
+//
+//   rerate -variants NWL18.classic.blitz,NWL18.classic.rapid -ratings -stats \
+//     -- -db-conn-string "..." -lexicon-path ...
+//
+// Nothing is saved until every game was replayed, and then it's all saved at
+// once. The server should be down in the meantime, so that no games end.
+// Progress is saved to the checkpoint every so often; if the command is
+// interrupted, running it again picks up where it left off. The list stats
+// are left as they are, except for the rating history, which is rewritten
+// along with the ratings.
+
+func main() {
+	fs := flag.NewFlagSet("rerate", flag.ExitOnError)
+	variantList := fs.String("variants", "", "comma-separated variant keys to rebuild, like NWL18.classic.blitz")
+	rebuildRatings := fs.Bool("ratings", false, "rebuild the ratings")
+	rebuildStats := fs.Bool("stats", false, "rebuild the profile stats")
+	dryRun := fs.Bool("dry-run", false, "print how the ratings would change instead of saving anything")
+	checkpointPath := fs.String("checkpoint", "rerate-checkpoint.json", "where progress is saved, so that an interrupted run can resume")
+	checkpointEvery := fs.Int("checkpoint-every", 500, "save progress after this many games")
+	fs.Parse(os.Args[1:])
+
+	cfg := &config.Config{}
+	if err := cfg.Load(fs.Args()); err != nil {
+		panic(err)
+	}
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	if *variantList == "" || !*rebuildRatings && !*rebuildStats {
+		fmt.Fprintln(os.Stderr, "pick the -variants to rebuild, and -ratings and/or -stats")
+		os.Exit(2)
+	}
+	variants := []entity.VariantKey{}
+	for _, v := range strings.Split(*variantList, ",") {
+		variants = append(variants, entity.VariantKey(strings.TrimSpace(v)))
+	}
+	sort.Slice(variants, func(i, j int) bool { return variants[i] < variants[j] })
+
+	userStore, err := user.NewDBStore(cfg.DBConnString)
+	if err != nil {
+		panic(err)
+	}
+	gameStore, err := game.NewDBStore(cfg, userStore)
+	if err != nil {
+		panic(err)
+	}
+	listStatStore, err := stats.NewListStatStore(cfg.DBConnString)
+	if err != nil {
+		panic(err)
+	}
+	ctx := context.WithValue(context.Background(), gameplay.ConfigCtxKey("config"),
+		&cfg.MacondoConfig)
+
+	cp := newCheckpoint(variants, *rebuildRatings, *rebuildStats)
+	saved, err := loadCheckpoint(*checkpointPath)
+	if err != nil {
+		panic(err)
+	}
+	if saved != nil {
+		if !saved.matches(cp) {
+			log.Fatal().Str("checkpoint", *checkpointPath).
+				Msg("the checkpoint is for another rebuild; remove it to start over")
+		}
+		cp = saved
+		log.Info().Int("games", cp.Games).Str("lastGameID", cp.LastGameID).Msg("resuming")
+	}
+
+	r := &replayer{
+		gameStore: gameStore,
+		users:     &replayStore{Store: userStore, rebuild: cp.Rebuild},
+		listStats: knownListStats{listStatStore},
+		cp:        cp,
+	}
+
+	ids, err := gameStore.ListEndedGameIDs(ctx, variants)
+	if err != nil {
+		panic(err)
+	}
+	start := 0
+	if cp.LastGameID != "" {
+		start = -1
+		for i, id := range ids {
+			if id == cp.LastGameID {
+				start = i + 1
+				break
+			}
+		}
+		if start == -1 {
+			log.Fatal().Str("lastGameID", cp.LastGameID).
+				Msg("the last game replayed is gone; remove the checkpoint to start over")
+		}
+	}
+	log.Info().Int("games", len(ids)).Int("left", len(ids)-start).Msg("listed-games")
+
+	for i := start; i < len(ids); i++ {
+		err = r.replayGame(ctx, ids[i])
+		if err != nil {
+			log.Err(err).Str("gameID", ids[i]).Msg("replaying-game")
+			cp.Failed++
+		}
+		cp.LastGameID = ids[i]
+		cp.Games++
+		if cp.Games%*checkpointEvery == 0 {
+			if err = cp.save(*checkpointPath); err != nil {
+				panic(err)
+			}
+			log.Info().Int("games", cp.Games).Msg("saved-checkpoint")
+		}
+	}
+	if err = cp.save(*checkpointPath); err != nil {
+		panic(err)
+	}
+	log.Info().Int("games", cp.Games).Int("failed", cp.Failed).Msg("replayed-games")
+
+	if *dryRun {
+		if err = printDiff(ctx, userStore, cp.Rebuild); err != nil {
+			panic(err)
+		}
+	} else {
+		if err = userStore.SaveRebuild(ctx, cp.Rebuild); err != nil {
+			panic(err)
+		}
+		if cp.Rebuild.Ratings != nil {
+			err = listStatStore.ReplaceRatingHistory(cp.Rebuild.Variants, cp.RatingHistory)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for id, deltas := range cp.RatingDeltas {
+			if err = gameStore.SetRatingDeltas(ctx, id, deltas); err != nil {
+				log.Err(err).Str("gameID", id).Msg("setting-rating-deltas")
+			}
+		}
+		log.Info().Msg("saved-rebuild")
+	}
+	if err = os.Remove(*checkpointPath); err != nil {
+		log.Err(err).Msg("removing-checkpoint")
+	}
+}
+
+// printDiff prints the old and the new ratings of every user who has a
+// rating in the rebuilt variants, either before or after the rebuild.
+func printDiff(ctx context.Context, userStore *user.DBStore, rebuild *user.VariantRebuild) error {
+	if rebuild.Ratings == nil {
+		fmt.Printf("rebuilt the stats of %d users\n", len(rebuild.Stats))
+		return nil
+	}
+	// Users can lose their rating, if none of their games count anymore.
+	uuids, err := userStore.ListRatedIn(ctx, rebuild.Variants)
+	if err != nil {
+		return err
+	}
+	for uuid := range rebuild.Ratings {
+		uuids = append(uuids, uuid)
+	}
+	type row struct {
+		username string
+		variant  entity.VariantKey
+		old, new string
+		change   string
+	}
+	rows := []row{}
+	seen := map[string]bool{}
+	for _, uuid := range uuids {
+		if seen[uuid] {
+			continue
+		}
+		seen[uuid] = true
+		u, err := userStore.GetByUUID(ctx, uuid)
+		if err != nil {
+			log.Err(err).Str("uuid", uuid).Msg("getting-user")
+			continue
+		}
+		for _, variant := range rebuild.Variants {
+			old, hadOld := u.Profile.Ratings.Data[variant]
+			rating, hasNew := rebuild.Ratings[uuid][variant]
+			if !hadOld && !hasNew {
+				continue
+			}
+			rw := row{username: u.Username, variant: variant, old: "-", new: "-"}
+			oldRating := int(math.Round(old.Rating))
+			newRating := int(math.Round(rating.Rating))
+			if hadOld {
+				rw.old = fmt.Sprint(oldRating)
+			}
+			if hasNew {
+				rw.new = fmt.Sprint(newRating)
+			}
+			switch {
+			case !hadOld:
+				rw.change = "new"
+			case !hasNew:
+				rw.change = "gone"
+			default:
+				rw.change = fmt.Sprintf("%+d", newRating-oldRating)
+			}
+			rows = append(rows, rw)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].username != rows[j].username {
+			return rows[i].username < rows[j].username
+		}
+		return rows[i].variant < rows[j].variant
+	})
+	fmt.Printf("%-24s %-32s %6s %6s %6s\n", "user", "variant", "old", "new", "change")
+	for _, rw := range rows {
+		fmt.Printf("%-24s %-32s %6s %6s %6s\n", rw.username, rw.variant, rw.old, rw.new, rw.change)
+	}
+	return nil
+}
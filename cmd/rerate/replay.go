@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	"github.com/domino14/liwords/pkg/stats"
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/user"
+	pkguser "github.com/domino14/liwords/pkg/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// replayStore is a user store that keeps the ratings and profile stats that
+// are being rebuilt in memory, over the users in the database. What's being
+// rebuilt starts from scratch; nothing is saved until the replay is over.
+type replayStore struct {
+	pkguser.Store
+	rebuild *user.VariantRebuild
+}
+
+func (r *replayStore) Get(ctx context.Context, username string) (*entity.User, error) {
+	u, err := r.Store.Get(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	r.overlay(u)
+	return u, nil
+}
+
+func (r *replayStore) GetByUUID(ctx context.Context, uuid string) (*entity.User, error) {
+	u, err := r.Store.GetByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	r.overlay(u)
+	return u, nil
+}
+
+// overlay replaces what the user has in the rebuilt variants with what they
+// have so far in the rebuild.
+func (r *replayStore) overlay(u *entity.User) {
+	if u.Profile == nil {
+		return
+	}
+	if r.rebuild.Ratings != nil {
+		if u.Profile.Ratings.Data == nil {
+			u.Profile.Ratings.Data = map[entity.VariantKey]entity.SingleRating{}
+		}
+		for _, v := range r.rebuild.Variants {
+			if rating, ok := r.rebuild.Ratings[u.UUID][v]; ok {
+				u.Profile.Ratings.Data[v] = rating
+			} else {
+				delete(u.Profile.Ratings.Data, v)
+			}
+		}
+	}
+	if r.rebuild.Stats != nil {
+		if u.Profile.Stats.Data == nil {
+			u.Profile.Stats.Data = map[entity.VariantKey]*entity.Stats{}
+		}
+		for _, v := range r.rebuild.Variants {
+			if st, ok := r.rebuild.Stats[u.UUID][v]; ok {
+				u.Profile.Stats.Data[v] = st
+			} else {
+				delete(u.Profile.Stats.Data, v)
+			}
+		}
+	}
+}
+
+func (r *replayStore) SetRating(ctx context.Context, uuid string, variant entity.VariantKey,
+	rating entity.SingleRating) error {
+
+	if r.rebuild.Ratings[uuid] == nil {
+		r.rebuild.Ratings[uuid] = map[entity.VariantKey]entity.SingleRating{}
+	}
+	r.rebuild.Ratings[uuid][variant] = rating
+	return nil
+}
+
+func (r *replayStore) SetStats(ctx context.Context, uuid string, variant entity.VariantKey,
+	st *entity.Stats) error {
+
+	if r.rebuild.Stats[uuid] == nil {
+		r.rebuild.Stats[uuid] = map[entity.VariantKey]*entity.Stats{}
+	}
+	r.rebuild.Stats[uuid][variant] = st
+	return nil
+}
+
+// knownListStats is a list stat store that doesn't add anything. The list
+// stats of the games being replayed were added when they ended; the rating
+// history, which changes with the ratings, is kept in the checkpoint instead.
+type knownListStats struct {
+	stats.ListStatStore
+}
+
+func (knownListStats) AddListItem(gameID string, playerID string, statType int,
+	time int64, item entity.ListDatum) error {
+	return nil
+}
+
+// A checkpoint is how far a replay got. It's saved every so often, so that
+// an interrupted replay can pick up where it left off.
+type checkpoint struct {
+	Rebuild *user.VariantRebuild
+	// LastGameID is the last game that was replayed.
+	LastGameID string
+	Games      int
+	Failed     int
+	// RatingDeltas are the rating changes of the rated games, by game ID.
+	RatingDeltas map[string][2]int
+	// RatingHistory is the rating every player had after each of their
+	// rated games, in the order the games ended.
+	RatingHistory []*entity.ListItem
+}
+
+func newCheckpoint(variants []entity.VariantKey, ratings, profileStats bool) *checkpoint {
+	cp := &checkpoint{
+		Rebuild:      &user.VariantRebuild{Variants: variants},
+		RatingDeltas: map[string][2]int{},
+	}
+	if ratings {
+		cp.Rebuild.Ratings = map[string]map[entity.VariantKey]entity.SingleRating{}
+	}
+	if profileStats {
+		cp.Rebuild.Stats = map[string]map[entity.VariantKey]*entity.Stats{}
+	}
+	return cp
+}
+
+// matches returns whether the checkpoint is for the same rebuild as cp.
+func (cp *checkpoint) matches(other *checkpoint) bool {
+	a, b := cp.Rebuild, other.Rebuild
+	if len(a.Variants) != len(b.Variants) ||
+		(a.Ratings == nil) != (b.Ratings == nil) || (a.Stats == nil) != (b.Stats == nil) {
+		return false
+	}
+	for i := range a.Variants {
+		if a.Variants[i] != b.Variants[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// loadCheckpoint loads a checkpoint. It returns nil if there isn't one.
+func loadCheckpoint(path string) (*checkpoint, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	cp := &checkpoint{}
+	if err = json.Unmarshal(data, cp); err != nil {
+		return nil, err
+	}
+	if cp.RatingDeltas == nil {
+		cp.RatingDeltas = map[string][2]int{}
+	}
+	return cp, nil
+}
+
+// save saves the checkpoint. It's written next to where it goes first, so
+// that an interruption can't leave half of it behind.
+func (cp *checkpoint) save(path string) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// A replayer replays finished games, one at a time, in the order they ended.
+type replayer struct {
+	gameStore *game.DBStore
+	users     *replayStore
+	listStats stats.ListStatStore
+	cp        *checkpoint
+}
+
+// rated returns whether the game counted towards the players' ratings when
+// it ended. Abandoned games that ended before that was saved with them can
+// still be told apart, since nobody's rating changed.
+func (r *replayer) rated(g *entity.Game) bool {
+	if g.CreationRequest().RatingMode != pb.RatingMode_RATED || g.Unrated {
+		return false
+	}
+	return g.GameEndReason != pb.GameEndReason_ABANDONED || g.RatingDeltas != [2]int{}
+}
+
+// replayGame rates the game and adds it to the players' stats, as it was
+// when it ended, for whatever is being rebuilt.
+func (r *replayer) replayGame(ctx context.Context, id string) error {
+	g, err := r.gameStore.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	variantKey, err := g.RatingKey()
+	if err != nil {
+		return err
+	}
+	hist := g.History()
+	p0, p1 := hist.Players[0].Nickname, hist.Players[1].Nickname
+	winner, loser := p0, p1
+	if g.WinnerIdx == 1 {
+		winner, loser = p1, p0
+	}
+	scores := map[string]int32{p0: int32(g.PointsFor(0)), p1: int32(g.PointsFor(1))}
+	evt := &pb.GameEndedEvent{
+		Scores:              scores,
+		NewRatings:          map[string]int32{},
+		NewRatingDeviations: map[string]int32{},
+		EndReason:           g.GameEndReason,
+		Winner:              winner,
+		Loser:               loser,
+		Tie:                 g.WinnerIdx == -1,
+		Time:                g.Timers.TimeOfLastUpdate,
+		GameId:              id,
+		TournamentId:        g.GameReq.TournamentId,
+	}
+
+	if r.cp.Rebuild.Ratings != nil && r.rated(g) {
+		ratings, err := gameplay.Rate(ctx, scores, g, winner, r.users,
+			g.Timers.TimeOfLastUpdate/1000)
+		if err != nil {
+			return err
+		}
+		for username, rating := range ratings {
+			evt.NewRatings[username] = int32(math.Round(rating.Rating))
+			evt.NewRatingDeviations[username] = int32(math.Round(rating.RatingDeviation))
+		}
+		r.cp.RatingDeltas[id] = g.RatingDeltas
+		for _, p := range hist.Players {
+			r.cp.RatingHistory = append(r.cp.RatingHistory, &entity.ListItem{
+				GameId:   id,
+				PlayerId: p.UserId,
+				Time:     g.Timers.TimeOfLastUpdate,
+				Item: entity.ListDatum{
+					Rating:          int(evt.NewRatings[p.Nickname]),
+					RatingDeviation: int(evt.NewRatingDeviations[p.Nickname]),
+					Variant:         string(variantKey),
+				},
+			})
+		}
+	}
+	if r.cp.Rebuild.Stats != nil {
+		_, err = gameplay.ComputeGameStats(ctx, hist, g.GameReq, variantKey, evt,
+			r.users, r.listStats)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package autopair
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Weights for the Swiss pairer. Lower is better; they're ordered such that
+// avoiding a rematch always beats keeping score groups together, which in
+// turn always beats balancing firsts and seconds. A rematch costs more than
+// everything else could in the whole round, so its penalty depends on the
+// field; see repeatPenalty.
+const (
+	ScoreGroupPenalty = 1000
+	BalancePenalty    = 10
+)
+
+// A SwissPlayer is a player's standing going into a Swiss round.
+type SwissPlayer struct {
+	ID string
+	// Wins counts a draw as half a win.
+	Wins float64
+	// Opponents contains every opponent so far, including repeats.
+	Opponents []string
+	Firsts    int
+	Seconds   int
+	HadBye    bool
+}
+
+// A SwissPairing is a pairing for a Swiss round; the first player goes first.
+// If Second is blank, First has a bye.
+type SwissPairing struct {
+	First  string
+	Second string
+}
+
+// SwissPair pairs the next round of a Swiss tournament. The players must be
+// ordered by their standing, best first. The fixed pairings are made as
+// given, and everyone else is paired so as to (in order of importance)
+// avoid rematches, keep players with the same number of wins together, and
+// even out how often everyone goes first. Within a score group, the top half
+// plays the bottom half. If an odd number of players is left, the bye goes
+// to the lowest-ranked player who hasn't had one.
+func SwissPair(players []*SwissPlayer, fixed []SwissPairing) ([]SwissPairing, error) {
+	byID := make(map[string]*SwissPlayer)
+	for _, p := range players {
+		byID[p.ID] = p
+	}
+	taken := make(map[string]bool)
+	for _, f := range fixed {
+		for _, id := range []string{f.First, f.Second} {
+			if id == "" {
+				continue
+			}
+			if byID[id] == nil {
+				return nil, fmt.Errorf("fixed pairing for unknown player %v", id)
+			}
+			if taken[id] {
+				return nil, fmt.Errorf("player %v is in more than one fixed pairing", id)
+			}
+			taken[id] = true
+		}
+	}
+
+	remaining := []*SwissPlayer{}
+	for _, p := range players {
+		if !taken[p.ID] {
+			remaining = append(remaining, p)
+		}
+	}
+	var bye *SwissPlayer
+	if len(remaining)%2 == 1 {
+		bye = remaining[len(remaining)-1]
+		for i := len(remaining) - 1; i >= 0; i-- {
+			if !remaining[i].HadBye {
+				bye = remaining[i]
+				break
+			}
+		}
+		others := []*SwissPlayer{}
+		for _, p := range remaining {
+			if p != bye {
+				others = append(others, p)
+			}
+		}
+		remaining = others
+	}
+
+	pairings := append([]SwissPairing{}, fixed...)
+	if len(remaining) > 0 {
+		edges := []*Edge{}
+		repeat := repeatPenalty(remaining)
+		for i := 0; i < len(remaining); i++ {
+			for j := i + 1; j < len(remaining); j++ {
+				edges = append(edges, &Edge{i, j, swissWeight(remaining, i, j, repeat)})
+			}
+		}
+		mates, err := minWeightMatching(edges, true)
+		if err != nil {
+			return nil, err
+		}
+		if len(mates) != len(remaining) {
+			return nil, errors.New("could not pair every player")
+		}
+		for i, m := range mates {
+			if m == -1 {
+				return nil, errors.New("could not pair every player")
+			}
+			if m < i {
+				continue
+			}
+			pairings = append(pairings, firstAndSecond(remaining[i], remaining[m]))
+		}
+	}
+	if bye != nil {
+		pairings = append(pairings, SwissPairing{First: bye.ID})
+	}
+	return pairings, nil
+}
+
+// halfWins counts in half wins, so that draws can be compared exactly.
+func halfWins(p *SwissPlayer) int {
+	return int(2 * p.Wins)
+}
+
+// repeatPenalty is the penalty for a rematch between any of the players. No
+// pairing without a rematch can weigh more than the score groups that are
+// furthest apart, the most lopsided firsts and seconds, and the top half
+// against the bottom half put together; a rematch costs more than that for
+// every pairing in the round, so that it's always avoided first.
+func repeatPenalty(players []*SwissPlayer) int {
+	lo, hi := halfWins(players[0]), halfWins(players[0])
+	balance := 0
+	for _, p := range players {
+		lo = min(lo, halfWins(p))
+		hi = max(hi, halfWins(p))
+		balance = max(balance, abs(p.Firsts-p.Seconds))
+	}
+	most := (hi-lo)*(hi-lo)*ScoreGroupPenalty + balance*BalancePenalty + len(players)
+	return most*(len(players)/2) + 1
+}
+
+// swissWeight weighs the pairing of the players at the given indices, with
+// the given penalty for every time they've met. The players must be ordered
+// by standing.
+func swissWeight(players []*SwissPlayer, i, j, repeat int) int {
+	a, b := players[i], players[j]
+	weight := 0
+	for _, opp := range a.Opponents {
+		if opp == b.ID {
+			weight += repeat
+		}
+	}
+	scoreDiff := abs(halfWins(a) - halfWins(b))
+	weight += scoreDiff * scoreDiff * ScoreGroupPenalty
+
+	balA, balB := a.Firsts-a.Seconds, b.Firsts-b.Seconds
+	if (balA > 0 && balB > 0) || (balA < 0 && balB < 0) {
+		weight += min(abs(balA), abs(balB)) * BalancePenalty
+	}
+
+	if scoreDiff == 0 {
+		// Top half against bottom half: the ideal opponent is half the
+		// score group away.
+		groupSize := 0
+		for _, p := range players {
+			if p.Wins == a.Wins {
+				groupSize++
+			}
+		}
+		weight += abs(abs(i-j) - groupSize/2)
+	}
+	return weight
+}
+
+// firstAndSecond decides who goes first: whoever has gone first less often
+// (relative to going second), or else the higher-ranked player.
+func firstAndSecond(higher, lower *SwissPlayer) SwissPairing {
+	if lower.Firsts-lower.Seconds < higher.Firsts-higher.Seconds {
+		return SwissPairing{First: lower.ID, Second: higher.ID}
+	}
+	return SwissPairing{First: higher.ID, Second: lower.ID}
+}
@@ -0,0 +1,135 @@
+package autopair
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func swissPlayers(ids ...string) []*SwissPlayer {
+	players := []*SwissPlayer{}
+	for _, id := range ids {
+		players = append(players, &SwissPlayer{ID: id})
+	}
+	return players
+}
+
+func played(a, b *SwissPlayer) {
+	a.Opponents = append(a.Opponents, b.ID)
+	b.Opponents = append(b.Opponents, a.ID)
+	a.Firsts++
+	b.Seconds++
+}
+
+func TestSwissFirstRoundTopHalfVsBottomHalf(t *testing.T) {
+	is := is.New(t)
+	pairings, err := SwissPair(swissPlayers("a", "b", "c", "d", "e", "f"), nil)
+	is.NoErr(err)
+	is.Equal(pairings, []SwissPairing{{"a", "d"}, {"b", "e"}, {"c", "f"}})
+}
+
+func TestSwissAvoidsRepeats(t *testing.T) {
+	is := is.New(t)
+	ps := swissPlayers("a", "b", "c", "d")
+	played(ps[0], ps[2])
+	played(ps[3], ps[1])
+	ps[0].Wins, ps[1].Wins = 1, 1
+
+	pairings, err := SwissPair(ps, nil)
+	is.NoErr(err)
+	// The winners play each other, and so do the losers. Whoever went
+	// first last time goes second now.
+	is.Equal(pairings, []SwissPairing{{"b", "a"}, {"c", "d"}})
+
+	// a and b have met too, and the only pairing left with no rematches
+	// crosses score groups.
+	played(ps[0], ps[1])
+	played(ps[2], ps[3])
+	pairings, err = SwissPair(ps, nil)
+	is.NoErr(err)
+	is.Equal(pairings, []SwissPairing{{"d", "a"}, {"b", "c"}})
+}
+
+func TestSwissAvoidsRepeatsFarApart(t *testing.T) {
+	is := is.New(t)
+	ps := swissPlayers("a", "b", "c", "d")
+	played(ps[0], ps[1])
+	played(ps[2], ps[3])
+	// The score groups are 40 half wins apart, which is as bad as it gets,
+	// but a rematch is still worse.
+	ps[0].Wins, ps[1].Wins = 20, 20
+
+	pairings, err := SwissPair(ps, nil)
+	is.NoErr(err)
+	is.Equal(pairings, []SwissPairing{{"d", "a"}, {"b", "c"}})
+}
+
+func TestSwissKeepsScoreGroupsTogether(t *testing.T) {
+	is := is.New(t)
+	ps := swissPlayers("a", "b", "c", "d", "e", "f")
+	ps[0].Wins, ps[1].Wins = 2, 2
+	ps[2].Wins, ps[3].Wins = 1.5, 1.5
+
+	pairings, err := SwissPair(ps, nil)
+	is.NoErr(err)
+	is.Equal(pairings, []SwissPairing{{"a", "b"}, {"c", "d"}, {"e", "f"}})
+}
+
+func TestSwissBalancesFirsts(t *testing.T) {
+	is := is.New(t)
+	ps := swissPlayers("a", "b", "c", "d")
+	// a and c have both gone first twice, b and d both went second.
+	for _, p := range []*SwissPlayer{ps[0], ps[2]} {
+		p.Firsts = 2
+	}
+	for _, p := range []*SwissPlayer{ps[1], ps[3]} {
+		p.Seconds = 2
+	}
+	pairings, err := SwissPair(ps, nil)
+	is.NoErr(err)
+	// a-c would be the usual pairing, but they both need to go second.
+	is.Equal(len(pairings), 2)
+	for _, p := range pairings {
+		is.True(p.First == "b" || p.First == "d")
+		is.True(p.Second == "a" || p.Second == "c")
+	}
+}
+
+func TestSwissByeGoesToLowestEligible(t *testing.T) {
+	is := is.New(t)
+	ps := swissPlayers("a", "b", "c", "d", "e")
+	ps[4].HadBye = true
+	ps[3].HadBye = true
+
+	pairings, err := SwissPair(ps, nil)
+	is.NoErr(err)
+	is.Equal(len(pairings), 3)
+	is.Equal(pairings[2], SwissPairing{First: "c"})
+
+	// If everyone has had a bye, the last player gets another.
+	for _, p := range ps {
+		p.HadBye = true
+	}
+	pairings, err = SwissPair(ps, nil)
+	is.NoErr(err)
+	is.Equal(pairings[2], SwissPairing{First: "e"})
+}
+
+func TestSwissFixedPairings(t *testing.T) {
+	is := is.New(t)
+	ps := swissPlayers("a", "b", "c", "d", "e")
+
+	pairings, err := SwissPair(ps, []SwissPairing{{"e", "a"}})
+	is.NoErr(err)
+	is.Equal(pairings, []SwissPairing{{"e", "a"}, {"b", "c"}, {"d", ""}})
+
+	// A fixed bye means nobody else gets one.
+	pairings, err = SwissPair(ps, []SwissPairing{{First: "a"}})
+	is.NoErr(err)
+	is.Equal(pairings, []SwissPairing{{First: "a"}, {"b", "d"}, {"c", "e"}})
+
+	_, err = SwissPair(ps, []SwissPairing{{"a", "b"}, {"b", "c"}})
+	is.True(err != nil)
+	_, err = SwissPair(ps, []SwissPairing{{"a", "z"}})
+	is.True(err != nil)
+}
@@ -0,0 +1,39 @@
+// Package bot comes up with the moves of the internal bots. Moves are
+// normally made by a macondo bot listening on NATS, but there is also an
+// in-process bot that can stand in for it.
+package bot
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+// A Provider comes up with bot moves.
+type Provider interface {
+	// Move returns the move the player on turn makes in the game with the
+	// given history.
+	Move(ctx context.Context, hist *macondopb.GameHistory) (*macondopb.GameEvent, error)
+}
+
+// A FallbackProvider asks each of its providers for a move in turn, until one
+// of them comes up with one.
+type FallbackProvider []Provider
+
+// Move implements Provider. It returns the last error if no provider could
+// come up with a move.
+func (fp FallbackProvider) Move(ctx context.Context, hist *macondopb.GameHistory) (*macondopb.GameEvent, error) {
+	err := errors.New("no bot provider")
+	for idx, p := range fp {
+		var evt *macondopb.GameEvent
+		evt, err = p.Move(ctx, hist)
+		if err == nil {
+			return evt, nil
+		}
+		log.Err(err).Int("provider", idx).Str("gameID", hist.Uid).Msg("bot-provider-failed")
+	}
+	return nil, err
+}
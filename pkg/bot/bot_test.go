@@ -0,0 +1,152 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	nats "github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/gaddagmaker"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+// flakyRequester fails the first few requests, then responds with resp.
+type flakyRequester struct {
+	failures int
+	requests int
+	resp     *macondopb.BotResponse
+}
+
+func (r *flakyRequester) Request(subj string, data []byte, timeout time.Duration) (*nats.Msg, error) {
+	r.requests++
+	if r.requests <= r.failures {
+		return nil, nats.ErrTimeout
+	}
+	bts, err := proto.Marshal(r.resp)
+	if err != nil {
+		return nil, err
+	}
+	return &nats.Msg{Subject: subj, Data: bts}, nil
+}
+
+type stubProvider struct {
+	evt *macondopb.GameEvent
+	err error
+}
+
+func (p *stubProvider) Move(ctx context.Context, hist *macondopb.GameHistory) (*macondopb.GameEvent, error) {
+	return p.evt, p.err
+}
+
+var pass = &macondopb.GameEvent{Nickname: "bot", Type: macondopb.GameEvent_PASS}
+
+func newTestNATSProvider(r Requester) *NATSProvider {
+	p := NewNATSProvider(r)
+	p.Backoff = time.Millisecond
+	return p
+}
+
+func TestNATSProviderRetries(t *testing.T) {
+	is := is.New(t)
+	r := &flakyRequester{failures: 2,
+		resp: &macondopb.BotResponse{Response: &macondopb.BotResponse_Move{Move: pass}}}
+	evt, err := newTestNATSProvider(r).Move(context.Background(), &macondopb.GameHistory{})
+	is.NoErr(err)
+	is.True(proto.Equal(evt, pass))
+	is.Equal(r.requests, 3)
+}
+
+func TestNATSProviderGivesUp(t *testing.T) {
+	is := is.New(t)
+	r := &flakyRequester{failures: 3,
+		resp: &macondopb.BotResponse{Response: &macondopb.BotResponse_Move{Move: pass}}}
+	_, err := newTestNATSProvider(r).Move(context.Background(), &macondopb.GameHistory{})
+	is.Equal(err, nats.ErrTimeout)
+	is.Equal(r.requests, 3)
+}
+
+func TestNATSProviderBotError(t *testing.T) {
+	is := is.New(t)
+	r := &flakyRequester{
+		resp: &macondopb.BotResponse{Response: &macondopb.BotResponse_Error{Error: "no lexicon"}}}
+	_, err := newTestNATSProvider(r).Move(context.Background(), &macondopb.GameHistory{})
+	is.Equal(err.Error(), "no lexicon")
+	// Errors from the bot itself aren't retried.
+	is.Equal(r.requests, 1)
+}
+
+func TestFallbackProvider(t *testing.T) {
+	is := is.New(t)
+	broken := &stubProvider{err: errors.New("broken")}
+	fp := FallbackProvider{broken, &stubProvider{evt: pass}}
+	evt, err := fp.Move(context.Background(), &macondopb.GameHistory{})
+	is.NoErr(err)
+	is.Equal(evt, pass)
+
+	_, err = FallbackProvider{broken, broken}.Move(context.Background(), &macondopb.GameHistory{})
+	is.Equal(err.Error(), "broken")
+}
+
+// testBotConfig saves a tiny lexicon with every letter in it, and returns a
+// config that loads it along with a function that cleans it up.
+func testBotConfig(is *is.I) (*macondoconfig.Config, func()) {
+	dir, err := ioutil.TempDir("", "bot")
+	is.NoErr(err)
+	is.NoErr(os.Mkdir(filepath.Join(dir, "gaddag"), 0755))
+	words := "DOG\nDOGS\nGOD\nGODS\nFOX\nJAB\nMOCK\nQUIZ\nVEX\nWRY\nGLYPH\nTEN\nNET\n"
+	gd := gaddagmaker.GenerateGaddagFromStream(strings.NewReader(words), "BOTTEST")
+	gd.Save(filepath.Join(dir, "gaddag", "BOTTEST.gaddag"), gaddagmaker.GaddagMagicNumber)
+	return &macondoconfig.Config{
+		LexiconPath:            dir,
+		LetterDistributionPath: "../../data/letterdistributions",
+	}, func() { os.RemoveAll(dir) }
+}
+
+func TestStaticProviderMoves(t *testing.T) {
+	is := is.New(t)
+	cfg, cleanup := testBotConfig(is)
+	defer cleanup()
+	p := NewStaticProvider(cfg)
+	hist := &macondopb.GameHistory{
+		Players:        []*macondopb.PlayerInfo{{Nickname: "bot1", UserId: "1"}, {Nickname: "bot2", UserId: "2"}},
+		Lexicon:        "BOTTEST",
+		ChallengeRule:  macondopb.ChallengeRule_DOUBLE,
+		LastKnownRacks: []string{"DOGSEEE", "FOXEEEE"},
+	}
+	evt, err := p.Move(context.Background(), hist)
+	is.NoErr(err)
+	is.Equal(evt.Nickname, "bot1")
+	is.Equal(evt.Type, macondopb.GameEvent_TILE_PLACEMENT_MOVE)
+	// DOGS or GODS, on a double word square.
+	is.Equal(evt.Score, int32(12))
+	is.Equal(len(hist.Events), 0)
+
+	// The other bot plays through the O.
+	hist.Events = append(hist.Events, evt)
+	hist.LastKnownRacks = []string{"", "FOXEEEE"}
+	evt, err = p.Move(context.Background(), hist)
+	is.NoErr(err)
+	is.Equal(evt.Nickname, "bot2")
+	is.Equal(evt.Type, macondopb.GameEvent_TILE_PLACEMENT_MOVE)
+	is.Equal(evt.PlayedTiles, "F.X")
+	is.Equal(evt.Score, int32(13))
+
+	// A phony gets challenged off.
+	hist.Events = []*macondopb.GameEvent{{Nickname: "bot1", Type: macondopb.GameEvent_TILE_PLACEMENT_MOVE,
+		Rack: "ZOGEEEE", Row: 7, Column: 7, Direction: macondopb.GameEvent_HORIZONTAL,
+		Position: "8H", PlayedTiles: "ZOG", Score: 26, Cumulative: 26}}
+	hist.LastKnownRacks = []string{"", "DOGSEEE"}
+	evt, err = p.Move(context.Background(), hist)
+	is.NoErr(err)
+	is.Equal(evt.Nickname, "bot2")
+	is.Equal(evt.Type, macondopb.GameEvent_CHALLENGE)
+}
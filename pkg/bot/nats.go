@@ -0,0 +1,85 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+const natsBotSubject = "macondo.bot"
+
+// A Requester sends a NATS request and waits for the response. A *nats.Conn
+// is a Requester.
+type Requester interface {
+	Request(subj string, data []byte, timeout time.Duration) (*nats.Msg, error)
+}
+
+// A NATSProvider gets moves from a macondo bot listening on NATS.
+type NATSProvider struct {
+	conn Requester
+
+	// Timeout is how long to wait for a response to each request.
+	Timeout time.Duration
+	// Retries is how many more times to send a request that failed. Backoff
+	// is how long to wait before the first retry; it doubles every time.
+	Retries int
+	Backoff time.Duration
+}
+
+// NewNATSProvider creates a NATSProvider with the default timeout and
+// retries.
+func NewNATSProvider(conn Requester) *NATSProvider {
+	return &NATSProvider{
+		conn:    conn,
+		Timeout: 10 * time.Second,
+		Retries: 2,
+		Backoff: 250 * time.Millisecond,
+	}
+}
+
+// Move implements Provider. Only failures to get a response are retried; if
+// the bot responds with an error, that error is returned.
+func (p *NATSProvider) Move(ctx context.Context, hist *macondopb.GameHistory) (*macondopb.GameEvent, error) {
+	data, err := proto.Marshal(&macondopb.BotRequest{GameHistory: hist})
+	if err != nil {
+		return nil, err
+	}
+	backoff := p.Backoff
+	var res *nats.Msg
+	for attempt := 0; ; attempt++ {
+		res, err = p.conn.Request(natsBotSubject, data, p.Timeout)
+		if err == nil {
+			break
+		}
+		log.Err(err).Int("attempt", attempt).Str("gameID", hist.Uid).Msg("bot-request-failed")
+		if attempt == p.Retries {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	resp := &macondopb.BotResponse{}
+	err = proto.Unmarshal(res.Data, resp)
+	if err != nil {
+		return nil, err
+	}
+	switch r := resp.Response.(type) {
+	case *macondopb.BotResponse_Move:
+		return r.Move, nil
+	case *macondopb.BotResponse_Error:
+		return nil, errors.New(r.Error)
+	default:
+		return nil, errors.New("empty bot response")
+	}
+}
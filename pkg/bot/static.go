@@ -0,0 +1,79 @@
+package bot
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/macondo/ai/player"
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/gaddag"
+	"github.com/domino14/macondo/game"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/domino14/macondo/move"
+	"github.com/domino14/macondo/movegen"
+	"github.com/domino14/macondo/runner"
+	"github.com/domino14/macondo/strategy"
+)
+
+// A StaticProvider comes up with moves in-process, with Macondo's move
+// generator. It makes the move with the best static equity, challenging
+// its opponent's last move first if it formed a phony. This is what the
+// macondo bot does too, though it may be tuned differently.
+type StaticProvider struct {
+	cfg *macondoconfig.Config
+}
+
+// NewStaticProvider creates a StaticProvider that loads lexica and leave
+// values according to the config.
+func NewStaticProvider(cfg *macondoconfig.Config) *StaticProvider {
+	return &StaticProvider{cfg: cfg}
+}
+
+// Move implements Provider.
+func (p *StaticProvider) Move(ctx context.Context, hist *macondopb.GameHistory) (*macondopb.GameEvent, error) {
+	// Replaying the history modifies it, so work on a copy.
+	hist = proto.Clone(hist).(*macondopb.GameHistory)
+	boardLayout, ldName := game.HistoryToVariant(hist)
+	rules, err := runner.NewAIGameRules(p.cfg, boardLayout, hist.Lexicon, ldName)
+	if err != nil {
+		return nil, err
+	}
+	g, err := game.NewFromHistory(hist, rules, len(hist.Events))
+	if err != nil {
+		return nil, err
+	}
+	onTurn := g.PlayerOnTurn()
+	rack := g.RackFor(onTurn)
+
+	last := g.LastEvent()
+	if last != nil && last.Type == macondopb.GameEvent_TILE_PLACEMENT_MOVE &&
+		hist.ChallengeRule != macondopb.ChallengeRule_VOID {
+		for _, word := range g.LastWordsFormed() {
+			if !g.Lexicon().HasWord(word) {
+				return g.EventFromMove(move.NewChallengeMove(rack.TilesOn(), g.Alphabet())), nil
+			}
+		}
+	}
+	if g.Playing() != macondopb.PlayState_PLAYING {
+		// The opponent went out; all that's left is to pass.
+		return g.EventFromMove(move.NewPassMove(rack.TilesOn(), g.Alphabet())), nil
+	}
+
+	var strat strategy.Strategizer
+	strat, err = strategy.NewExhaustiveLeaveStrategy(g.LexiconName(), g.Alphabet(),
+		p.cfg.StrategyParamsPath, strategy.LeaveFilename)
+	if err != nil {
+		log.Err(err).Str("lexicon", g.LexiconName()).Msg("no-leave-values-using-score-only")
+		strat = strategy.NewNoLeaveStrategy()
+	}
+	gd, err := gaddag.LoadFromCache(p.cfg, g.LexiconName())
+	if err != nil {
+		return nil, err
+	}
+	gen := movegen.NewGordonGenerator(gd.(*gaddag.SimpleGaddag), g.Board(),
+		g.Bag().LetterDistribution())
+	m := player.GenBestStaticTurn(g, gen, player.NewRawEquityPlayer(strat), onTurn)
+	return g.EventFromMove(m), nil
+}
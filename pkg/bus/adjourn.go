@@ -0,0 +1,47 @@
+package bus
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+func (b *Bus) adjournResponse(ctx context.Context, evt *pb.AdjournResponse, userID string) error {
+	entGame, err := gameplay.RespondToAdjournment(ctx, b.gameStore, userID, evt.GameId, evt.Accept)
+	if entGame != nil {
+		// An adjourned game has no flag-fall to wait for.
+		entGame.RLock()
+		b.adjudicator.Track(entGame)
+		entGame.RUnlock()
+	}
+	return err
+}
+
+// resumeGame restarts the clocks of an adjourned game whose players are both
+// ready, and puts it back on the lobby's list of live games. The caller must
+// hold the game's lock.
+func (b *Bus) resumeGame(ctx context.Context, g *entity.Game) error {
+	err := gameplay.ResumeGame(ctx, b.gameStore, b.gameEventChan, g.GameID())
+	if err != nil {
+		return err
+	}
+	b.adjudicator.Track(g)
+
+	var users [2]*entity.User
+	for idx, p := range g.History().Players {
+		users[idx], err = b.userStore.GetByUUID(ctx, p.UserId)
+		if err != nil {
+			log.Err(err).Str("userID", p.UserId).Msg("resume-game-get-user")
+			return nil
+		}
+	}
+	err = b.broadcastGameCreation(g, users[0], users[1])
+	if err != nil {
+		log.Err(err).Msg("broadcasting-game-resumption")
+	}
+	return nil
+}
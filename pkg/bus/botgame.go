@@ -0,0 +1,83 @@
+package bus
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// getBot gets the bot with the given username, or a random bot of the tier
+// if the username is blank.
+func (b *Bus) getBot(ctx context.Context, username string, tier int32) (*entity.User, error) {
+	if username == "" {
+		return b.userStore.GetRandomBot(ctx, int(tier))
+	}
+	u, err := b.userStore.Get(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsBot {
+		return nil, errors.New(username + " is not a bot")
+	}
+	return u, nil
+}
+
+// botGame starts a casual game between two bots, and returns its ID. Both
+// sides are played through handleBotMove; the game shows up in the lobby
+// like any other, so it can be watched.
+func (b *Bus) botGame(ctx context.Context, req *pb.BotGameRequest) (string, error) {
+	if req.GameRequest == nil {
+		return "", errors.New("no game request")
+	}
+	gameReq := proto.Clone(req.GameRequest).(*pb.GameRequest)
+	gameReq.PlayerVsBot = true
+	gameReq.RatingMode = pb.RatingMode_CASUAL
+	if gameReq.GameMode != pb.GameMode_REAL_TIME {
+		return "", errors.New("games between bots must be real-time")
+	}
+	err := gameplay.ValidateSoughtGame(ctx, b.catalog, gameReq)
+	if err != nil {
+		return "", err
+	}
+
+	bot1, err := b.getBot(ctx, req.Bot1, req.BotTier)
+	if err != nil {
+		return "", err
+	}
+	bot2, err := b.getBot(ctx, req.Bot2, req.BotTier)
+	if err != nil {
+		return "", err
+	}
+	if bot1.UUID == bot2.UUID {
+		return "", errors.New("a bot can't play itself")
+	}
+
+	sg := &entity.SoughtGame{MatchRequest: &pb.MatchRequest{
+		User:          &pb.MatchUser{UserId: bot1.UUID},
+		ReceivingUser: &pb.MatchUser{UserId: bot2.UUID},
+		GameRequest:   gameReq,
+	}}
+	g, err := b.instantiateAndStartGame(ctx, bot2, bot1.UUID, gameReq, sg, BotRequestID, "")
+	if err != nil {
+		return "", err
+	}
+
+	// Nobody is going to send a ReadyForGame; start right away.
+	g.Lock()
+	defer g.Unlock()
+	err = gameplay.StartGame(ctx, b.gameStore, b.gameEventChan, g.GameID())
+	if err != nil {
+		return "", err
+	}
+	b.adjudicator.Track(g)
+	log.Info().Str("gameID", g.GameID()).Str("bot1", bot1.Username).
+		Str("bot2", bot2.Username).Msg("bot-game-started")
+	go b.handleBotMove(ctx, g)
+	return g.GameID(), nil
+}
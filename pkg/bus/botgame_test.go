@@ -0,0 +1,202 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/gaddagmaker"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/domino14/macondo/runner"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	"github.com/domino14/liwords/pkg/user"
+	"github.com/domino14/liwords/pkg/variants"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// botUserStore is a user.Store that only knows about a few users.
+type botUserStore struct {
+	user.Store
+	users []*entity.User
+}
+
+func (s *botUserStore) Get(ctx context.Context, username string) (*entity.User, error) {
+	for _, u := range s.users {
+		if u.Username == username {
+			return u, nil
+		}
+	}
+	return nil, errors.New("user not found")
+}
+
+func (s *botUserStore) GetByUUID(ctx context.Context, uuid string) (*entity.User, error) {
+	for _, u := range s.users {
+		if u.UUID == uuid {
+			return u, nil
+		}
+	}
+	return nil, errors.New("user not found")
+}
+
+func (s *botUserStore) GetRandomBot(ctx context.Context, tier int) (*entity.User, error) {
+	for _, u := range s.users {
+		if u.IsBot && (tier == 0 || u.BotTier == tier) {
+			return u, nil
+		}
+	}
+	return nil, errors.New("no bot is available")
+}
+
+// setRecorder is a GameStore that only keeps track of how often a game was
+// saved.
+type setRecorder struct {
+	gameplay.GameStore
+	sync.Mutex
+	sets int
+}
+
+func (s *setRecorder) Set(ctx context.Context, g *entity.Game) error {
+	s.Lock()
+	defer s.Unlock()
+	s.sets++
+	return nil
+}
+
+// passingProvider passes a number of times, then fails, which stops the
+// game between the bots.
+type passingProvider struct {
+	sync.Mutex
+	passes int
+	done   chan struct{}
+}
+
+func (p *passingProvider) Move(ctx context.Context, hist *macondopb.GameHistory) (*macondopb.GameEvent, error) {
+	p.Lock()
+	defer p.Unlock()
+	if p.passes == 0 {
+		close(p.done)
+		return nil, errors.New("out of moves")
+	}
+	p.passes--
+	return &macondopb.GameEvent{Type: macondopb.GameEvent_PASS}, nil
+}
+
+var (
+	hastyBot    = &entity.User{Username: "HastyBot", UUID: "hastybotuuid", IsBot: true, BotTier: 3}
+	beginnerBot = &entity.User{Username: "BeginnerBot", UUID: "beginnerbotuuid", IsBot: true, BotTier: 1}
+	cesar       = &entity.User{Username: "cesar", UUID: "cesaruuid"}
+)
+
+func TestGetBot(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	b := &Bus{userStore: &botUserStore{users: []*entity.User{cesar, beginnerBot, hastyBot}}}
+
+	u, err := b.getBot(ctx, "HastyBot", 1)
+	is.NoErr(err)
+	is.Equal(u, hastyBot)
+	_, err = b.getBot(ctx, "cesar", 0)
+	is.Equal(err.Error(), "cesar is not a bot")
+	_, err = b.getBot(ctx, "nobody", 0)
+	is.True(err != nil)
+
+	// Without a name, any bot of the tier will do.
+	u, err = b.getBot(ctx, "", 3)
+	is.NoErr(err)
+	is.Equal(u, hastyBot)
+	u, err = b.getBot(ctx, "", 0)
+	is.NoErr(err)
+	is.True(u.IsBot)
+	_, err = b.getBot(ctx, "", 2)
+	is.True(err != nil)
+
+	b = &Bus{userStore: &botUserStore{users: []*entity.User{cesar}}}
+	_, err = b.getBot(ctx, "", 0)
+	is.Equal(err.Error(), "no bot is available")
+}
+
+func newBotGame(is *is.I, cfg *macondoconfig.Config) *entity.Game {
+	req := &pb.GameRequest{
+		Lexicon:            "BUSTEST",
+		Rules:              &pb.GameRules{BoardLayoutName: entity.CrosswordGame, LetterDistributionName: "english"},
+		InitialTimeSeconds: 300,
+		PlayerVsBot:        true,
+		RatingMode:         pb.RatingMode_CASUAL,
+	}
+	rules, err := variants.GameRules(cfg, req.Rules, req.Lexicon)
+	is.NoErr(err)
+	players := []*macondopb.PlayerInfo{
+		{Nickname: beginnerBot.Username, UserId: beginnerBot.UUID},
+		{Nickname: hastyBot.Username, UserId: hastyBot.UUID},
+	}
+	r, err := runner.NewGameRunnerFromRules(&runner.GameOptions{
+		FirstIsAssigned: true,
+		GoesFirst:       0,
+	}, players, rules)
+	is.NoErr(err)
+	g := entity.NewGame(&r.Game, req)
+	g.SetTimerModule(entity.NewFakeNower(1000))
+	g.ResetTimersAndStart()
+	g.ChangeHook = make(chan *entity.EventWrapper, 10)
+	return g
+}
+
+func TestBotsTakeTurns(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "bus")
+	is.NoErr(err)
+	defer os.RemoveAll(dir)
+	is.NoErr(os.Mkdir(filepath.Join(dir, "gaddag"), 0755))
+	gd := gaddagmaker.GenerateGaddagFromStream(strings.NewReader("DOG\nGOD\n"), "BUSTEST")
+	gd.Save(filepath.Join(dir, "gaddag", "BUSTEST.gaddag"), gaddagmaker.GaddagMagicNumber)
+	cfg := &macondoconfig.Config{
+		LexiconPath:            dir,
+		LetterDistributionPath: "../../data/letterdistributions",
+	}
+
+	users := &botUserStore{users: []*entity.User{beginnerBot, hastyBot}}
+	gs := &setRecorder{}
+	// Six passes in a row would end the game.
+	provider := &passingProvider{passes: 4, done: make(chan struct{})}
+	b := &Bus{
+		userStore:   users,
+		gameStore:   gs,
+		botProvider: provider,
+		adjudicator: gameplay.NewAdjudicator(gs, users, nil, entity.NewFakeNower(1000)),
+	}
+	g := newBotGame(is, cfg)
+	go b.handleBotMove(ctx, g)
+
+	select {
+	case <-provider.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the bots stopped moving")
+	}
+	g.RLock()
+	defer g.RUnlock()
+	evts := g.History().Events
+	is.Equal(len(evts), 4)
+	for i, evt := range evts {
+		is.Equal(evt.Type, macondopb.GameEvent_PASS)
+		is.Equal(evt.Nickname, []string{"BeginnerBot", "HastyBot"}[i%2])
+	}
+	is.Equal(len(g.ChangeHook), 4)
+	is.Equal(g.PlayerIDOnTurn(), beginnerBot.UUID)
+	gs.Lock()
+	defer gs.Unlock()
+	is.Equal(gs.sets, 4)
+	_, tracked := b.adjudicator.Deadline(g.GameID())
+	is.True(tracked)
+}
@@ -11,15 +11,19 @@ import (
 	"time"
 
 	"github.com/gomodule/redigo/redis"
+	"github.com/lithammer/shortuuid"
 	"github.com/rs/zerolog/log"
 
 	nats "github.com/nats-io/nats.go"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/domino14/liwords/pkg/bot"
+	"github.com/domino14/liwords/pkg/catalog"
 	"github.com/domino14/liwords/pkg/config"
 	"github.com/domino14/liwords/pkg/entity"
 	"github.com/domino14/liwords/pkg/gameplay"
 	"github.com/domino14/liwords/pkg/stats"
+	"github.com/domino14/liwords/pkg/tournament"
 	"github.com/domino14/liwords/pkg/user"
 	macondogame "github.com/domino14/macondo/game"
 
@@ -32,11 +36,32 @@ const (
 
 	MaxMessageLength = 500
 
-	AdjudicateInterval = 300 * time.Second
+	AdjudicateInterval = 1 * time.Second
+
+	AbandonCheckInterval = 5 * time.Second
+
+	QuickPairInterval = 5 * time.Second
+
+	SeekExpiryInterval = 1 * time.Minute
+
+	// Correspondence games that nobody touched for CorresIdleTimeout are
+	// unloaded every CorresUnloadInterval.
+	CorresIdleTimeout    = 30 * time.Minute
+	CorresUnloadInterval = 5 * time.Minute
+
+	// GameLeaseTTL is how long a node owns a game for, unless it renews the
+	// lease. Leases are renewed every GameLeaseRenewInterval.
+	GameLeaseTTL           = 30 * time.Second
+	GameLeaseRenewInterval = 10 * time.Second
+	// Every OrphanSweepInterval, each node adopts the active games that no
+	// live node holds a lease on, such as those of a node that crashed.
+	OrphanSweepInterval = GameLeaseTTL
 )
 
 const (
-	BotRequestID = "bot-request"
+	BotRequestID        = "bot-request"
+	TournamentRequestID = "tournament-request"
+	QuickPairRequestID  = "quick-pair-request"
 )
 
 // Bus is the struct; it should contain all the stores to verify messages, etc.
@@ -48,6 +73,10 @@ type Bus struct {
 	soughtGameStore gameplay.SoughtGameStore
 	presenceStore   user.PresenceStore
 	listStatStore   stats.ListStatStore
+	tournamentStore tournament.TournamentStore
+	// catalog has the lexica and letter distributions seeks are validated
+	// against.
+	catalog *catalog.Catalog
 
 	redisPool *redis.Pool
 
@@ -55,14 +84,30 @@ type Bus struct {
 	subchans      map[string]chan *nats.Msg
 
 	gameEventChan chan *entity.EventWrapper
+
+	// botProvider makes the moves of the bots. It asks the macondo bot over
+	// NATS, and falls back to an in-process bot if that doesn't work out.
+	botProvider bot.Provider
+
+	adjudicator    *gameplay.Adjudicator
+	abandonWatcher *gameplay.AbandonWatcher
+	turnNotifier   gameplay.TurnNotifier
+	quickPairer    *gameplay.QuickPairer
+	ownership      *gameplay.Ownership
 }
 
 func NewBus(cfg *config.Config, userStore user.Store, gameStore gameplay.GameStore,
 	soughtGameStore gameplay.SoughtGameStore, presenceStore user.PresenceStore,
-	listStatStore stats.ListStatStore, redisPool *redis.Pool) (*Bus, error) {
+	listStatStore stats.ListStatStore, tournamentStore tournament.TournamentStore,
+	leaseStore gameplay.GameLeaseStore, quickPairStore gameplay.QuickPairStore,
+	redisPool *redis.Pool, cat *catalog.Catalog) (*Bus, error) {
 
 	natsconn, err := nats.Connect(cfg.NatsURL)
 
+	if err != nil {
+		return nil, err
+	}
+	abandonPolicies, err := gameplay.AbandonPoliciesFromConfig(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +118,30 @@ func NewBus(cfg *config.Config, userStore user.Store, gameStore gameplay.GameSto
 		soughtGameStore: soughtGameStore,
 		presenceStore:   presenceStore,
 		listStatStore:   listStatStore,
+		tournamentStore: tournamentStore,
 		subscriptions:   []*nats.Subscription{},
 		subchans:        map[string]chan *nats.Msg{},
 		config:          cfg,
 		gameEventChan:   make(chan *entity.EventWrapper, 64),
 		redisPool:       redisPool,
-	}
+		catalog:         cat,
+		adjudicator: gameplay.NewAdjudicator(gameStore, userStore, listStatStore,
+			&entity.GameTimer{}),
+		abandonWatcher: gameplay.NewAbandonWatcher(gameStore, userStore, presenceStore,
+			listStatStore, int64(cfg.AbandonGraceSeconds)*1000, abandonPolicies,
+			&entity.GameTimer{}),
+		botProvider: bot.FallbackProvider{
+			bot.NewNATSProvider(natsconn),
+			bot.NewStaticProvider(&cfg.MacondoConfig),
+		},
+		quickPairer: gameplay.NewQuickPairer(quickPairStore, QuickPairInterval),
+		ownership:   gameplay.NewOwnership(shortuuid.New(), GameLeaseTTL, leaseStore, gameStore),
+	}
+	bus.turnNotifier = bus
+	bus.ownership.OnAcquire(bus.adoptGame)
+	bus.adjudicator.SetOwner(bus.ownership)
+	bus.adjudicator.SetReadyTimeout(int64(cfg.ReadyTimeoutSeconds) * 1000)
+	bus.abandonWatcher.SetOwner(bus.ownership)
 	gameStore.SetGameEventChan(bus.gameEventChan)
 
 	topics := []string{
@@ -86,6 +149,8 @@ func NewBus(cfg *config.Config, userStore user.Store, gameStore gameplay.GameSto
 		"ipc.pb.>",
 		// ipc.request are NATS requests. also uses protobuf
 		"ipc.request.>",
+		// messages about games this node owns, forwarded by other nodes.
+		nodeTopic(bus.ownership.NodeID()) + ".>",
 	}
 
 	for _, topic := range topics {
@@ -97,6 +162,11 @@ func NewBus(cfg *config.Config, userStore user.Store, gameStore gameplay.GameSto
 			if err != nil {
 				return nil, err
 			}
+		} else if strings.HasPrefix(topic, "node.") {
+			sub, err = natsconn.ChanSubscribe(topic, ch)
+			if err != nil {
+				return nil, err
+			}
 		} else {
 			sub, err = natsconn.ChanQueueSubscribe(topic, "pbworkers", ch)
 			if err != nil {
@@ -115,9 +185,24 @@ func (b *Bus) ProcessMessages(ctx context.Context) {
 
 	ctx = context.WithValue(ctx, gameplay.ConfigCtxKey("config"), &b.config.MacondoConfig)
 
-	// Adjudicate unfinished games every few minutes.
-	// adjudicator := time.NewTicker(AdjudicateInterval)
-	// defer adjudicator.Stop()
+	// Rebuild the flag-fall schedule from the games that were in progress
+	// when we last went down, then adjudicate unfinished games every tick.
+	go b.sweepOrphanedGames(ctx)
+	adjudicator := time.NewTicker(AdjudicateInterval)
+	defer adjudicator.Stop()
+	abandonChecker := time.NewTicker(AbandonCheckInterval)
+	defer abandonChecker.Stop()
+	leaseRenewer := time.NewTicker(GameLeaseRenewInterval)
+	defer leaseRenewer.Stop()
+	orphanSweeper := time.NewTicker(OrphanSweepInterval)
+	defer orphanSweeper.Stop()
+	nodeChan := b.subchans[nodeTopic(b.ownership.NodeID())+".>"]
+	quickPairer := time.NewTicker(QuickPairInterval)
+	defer quickPairer.Stop()
+	seekExpirer := time.NewTicker(SeekExpiryInterval)
+	defer seekExpirer.Stop()
+	corresUnloader := time.NewTicker(CorresUnloadInterval)
+	defer corresUnloader.Stop()
 outerfor:
 	for {
 		select {
@@ -125,21 +210,13 @@ outerfor:
 			// Regular messages.
 			log.Debug().Str("topic", msg.Subject).Msg("got ipc.pb message")
 			subtopics := strings.Split(msg.Subject, ".")
+			go b.processPublish(ctx, subtopics[2:], msg.Data, false)
 
-			go func() {
-				err := b.handleNatsPublish(ctx, subtopics[2:], msg.Data)
-				if err != nil {
-					log.Err(err).Msg("process-message-publish-error")
-					// The user ID should have hopefully come in the topic name.
-					// It would be in subtopics[4]
-					if len(subtopics) > 4 {
-						userID := subtopics[4]
-						// XXX might have to make realm specific
-						b.pubToUser(userID, entity.WrapEvent(&pb.ErrorMessage{Message: err.Error()},
-							pb.MessageType_ERROR_MESSAGE), "")
-					}
-				}
-			}()
+		case msg := <-nodeChan:
+			// Messages about our games, forwarded by other nodes.
+			log.Debug().Str("topic", msg.Subject).Msg("got forwarded message")
+			subtopics := strings.Split(msg.Subject, ".")
+			go b.processPublish(ctx, subtopics[3:], msg.Data, true)
 
 		case msg := <-b.subchans["ipc.request.>"]:
 			log.Debug().Str("topic", msg.Subject).Msg("got ipc.request")
@@ -168,6 +245,14 @@ outerfor:
 		case msg := <-b.gameEventChan:
 			// A game event. Publish directly to the right realm.
 			log.Debug().Interface("msg", msg).Msg("game event chan")
+			if evt, ok := msg.Event.(*pb.GameEndedEvent); ok {
+				if evt.TournamentId != "" {
+					go b.recordTournamentResult(ctx, evt)
+				}
+				// Nothing changes in a game after it ends, so anyone can
+				// have it now.
+				go b.ownership.Release(ctx, evt.GameId)
+			}
 			topics := msg.Audience()
 			data, err := msg.Serialize()
 			if err != nil {
@@ -193,13 +278,28 @@ outerfor:
 			log.Info().Msg("context done, breaking")
 			break outerfor
 
-			// case <-adjudicator.C:
-			// 	err := b.adjudicateGames(ctx)
-			// 	if err != nil {
-			// 		log.Err(err).Msg("adjudicate-error")
-			// 		break
-			// 	}
+		case <-adjudicator.C:
+			// Timing a game out sends events down the game event channel,
+			// which is consumed by this very loop, so don't block on it.
+			go b.adjudicateGames(ctx)
+
+		case <-abandonChecker.C:
+			go b.checkAbandonedGames(ctx)
+
+		case <-quickPairer.C:
+			go b.quickPair(ctx)
+
+		case <-seekExpirer.C:
+			go b.expireSoughtGames(ctx)
 
+		case <-corresUnloader.C:
+			go b.unloadIdleCorresGames(ctx)
+
+		case <-leaseRenewer.C:
+			go b.ownership.Renew(ctx)
+
+		case <-orphanSweeper.C:
+			go b.sweepOrphanedGames(ctx)
 		}
 
 	}
@@ -207,6 +307,24 @@ outerfor:
 	log.Info().Msg("exiting processMessages loop")
 }
 
+// processPublish handles a published message, and reports any error to the
+// user who sent it. The subtopics start with the message type.
+func (b *Bus) processPublish(ctx context.Context, subtopics []string, data []byte,
+	forwarded bool) {
+
+	err := b.routeNatsPublish(ctx, subtopics, data, forwarded)
+	if err != nil {
+		log.Err(err).Msg("process-message-publish-error")
+		// The user ID should have hopefully come in the topic name.
+		if len(subtopics) > 2 {
+			userID := subtopics[2]
+			// XXX might have to make realm specific
+			b.pubToUser(userID, entity.WrapEvent(&pb.ErrorMessage{Message: err.Error()},
+				pb.MessageType_ERROR_MESSAGE), "")
+		}
+	}
+}
+
 func (b *Bus) handleNatsRequest(ctx context.Context, topic string,
 	replyTopic string, data []byte) error {
 
@@ -243,6 +361,8 @@ func (b *Bus) handleNatsRequest(ctx context.Context, topic string,
 				realm = "game-" + gameID
 			}
 			log.Debug().Str("computed-realm", realm)
+		} else if strings.HasPrefix(path, "/tournament/") {
+			realm = "tournament-" + strings.TrimPrefix(path, "/tournament/")
 		} else {
 			log.Info().Str("path", path).Msg("realm-req-not-handled-sending-blank-realm")
 		}
@@ -255,6 +375,22 @@ func (b *Bus) handleNatsRequest(ctx context.Context, topic string,
 		b.natsconn.Publish(replyTopic, retdata)
 		log.Debug().Str("topic", topic).Str("replyTopic", replyTopic).
 			Msg("published response")
+	case "botGame":
+		msg := &pb.BotGameRequest{}
+		err := proto.Unmarshal(data, msg)
+		if err != nil {
+			return err
+		}
+		resp := &pb.BotGameResponse{}
+		resp.GameId, err = b.botGame(ctx, msg)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+		retdata, err := proto.Marshal(resp)
+		if err != nil {
+			return err
+		}
+		b.natsconn.Publish(replyTopic, retdata)
 	default:
 		return fmt.Errorf("unhandled-req-topic: %v", topic)
 	}
@@ -274,60 +410,69 @@ func setMatchUser(msg proto.Message, reqUser *pb.MatchUser) {
 
 func (b *Bus) handleBotMove(ctx context.Context, g *entity.Game) {
 	// This function should only be called if it's the bot's turn.
+	g.RLock()
 	onTurn := g.Game.PlayerOnTurn()
 	userID := g.Game.PlayerIDOnTurn()
-	g.Lock()
-	defer g.Unlock()
-	// We check if that game is not over because a triple challenge
-	// could have ended it
-	for g.PlayerOnTurn() == onTurn && g.Game.Playing() != macondopb.PlayState_GAME_OVER {
-		hist := g.History()
-		req := macondopb.BotRequest{GameHistory: hist}
-		data, err := proto.Marshal(&req)
-		if err != nil {
-			log.Err(err).Msg("bot-cant-move")
-			return
+	g.RUnlock()
+	// The game isn't locked while the bot thinks. That can take a while
+	// (a bot on NATS that doesn't answer is retried before we fall back to
+	// another one), and in the meantime its opponent should still be able
+	// to resign, or time out.
+	for {
+		g.RLock()
+		// We check if that game is not over because a triple challenge
+		// could have ended it
+		if g.PlayerOnTurn() != onTurn || g.Game.Playing() == macondopb.PlayState_GAME_OVER {
+			g.RUnlock()
+			break
 		}
-		res, err := b.natsconn.Request("macondo.bot", data, 10*time.Second)
+		hist := proto.Clone(g.History()).(*macondopb.GameHistory)
+		g.RUnlock()
 
+		evt, err := b.botProvider.Move(ctx, hist)
 		if err != nil {
-			if b.natsconn.LastError() != nil {
-				log.Error().Msgf("bot-cant-move %v for request", b.natsconn.LastError())
-			}
-			log.Error().Msgf("bot-cant-move %v for request", err)
+			log.Err(err).Str("gameID", g.GameID()).Msg("bot-cant-move")
 			return
 		}
-		log.Debug().Msgf("res: %v", string(res.Data))
-
-		resp := macondopb.BotResponse{}
-		err = proto.Unmarshal(res.Data, &resp)
-		if err != nil {
-			log.Err(err).Msg("bot-cant-move-unmarshal-error")
+		g.Lock()
+		if len(g.History().Events) != len(hist.Events) || g.PlayerOnTurn() != onTurn ||
+			g.Game.Playing() == macondopb.PlayState_GAME_OVER {
+			// Something else happened in the game while the bot was
+			// thinking; its move no longer applies.
+			g.Unlock()
+			log.Info().Str("gameID", g.GameID()).Msg("bot-move-stale")
 			return
 		}
-		switch r := resp.Response.(type) {
-		case *macondopb.BotResponse_Move:
-			timeRemaining := g.TimeRemaining(onTurn)
+		timeRemaining := g.TimeRemaining(onTurn)
 
-			m := macondogame.MoveFromEvent(r.Move, g.Alphabet(), g.Board())
-			err = gameplay.PlayMove(ctx, g, b.userStore, b.listStatStore, userID, onTurn, timeRemaining, m)
-			if err != nil {
-				log.Err(err).Msg("bot-cant-move-play-error")
-				return
-			}
-		case *macondopb.BotResponse_Error:
-			log.Error().Str("error", r.Error).Msg("bot-error")
+		m := macondogame.MoveFromEvent(evt, g.Alphabet(), g.Board())
+		err = gameplay.PlayMove(ctx, g, b.gameStore, b.userStore, b.listStatStore, userID, onTurn, timeRemaining, m)
+		g.Unlock()
+		if err != nil {
+			log.Err(err).Msg("bot-cant-move-play-error")
 			return
-		default:
-			log.Err(errors.New("should never happen")).Msg("bot-cant-move")
 		}
 	}
 
+	g.Lock()
+	defer g.Unlock()
 	err := b.gameStore.Set(ctx, g)
 	if err != nil {
 		log.Err(err).Msg("setting-game-after-bot-move")
 	}
-
+	b.adjudicator.Track(g)
+	b.notifyCorresTurn(ctx, g)
+	if g.Game.Playing() != macondopb.PlayState_GAME_OVER && g.PlayerIDOnTurn() != userID {
+		// In a game between two bots, the other one moves next.
+		u, err := b.userStore.GetByUUID(ctx, g.PlayerIDOnTurn())
+		if err != nil {
+			log.Err(err).Msg("bot-game-get-user")
+			return
+		}
+		if u.IsBot {
+			go b.handleBotMove(ctx, g)
+		}
+	}
 }
 
 // handleNatsPublish runs in a separate goroutine
@@ -349,6 +494,10 @@ func (b *Bus) handleNatsPublish(ctx context.Context, subtopics []string, data []
 	switch msgType {
 	case "seekRequest", "matchRequest":
 		return b.seekRequest(ctx, msgType, auth, userID, wsConnID, data)
+	case "quickPairRequest":
+		return b.quickPairRequest(ctx, auth, userID, wsConnID, data)
+	case "quickPairCancel":
+		return b.quickPairCancel(ctx, userID)
 	case "chat":
 		// The user is subtopics[2]
 		evt := &pb.ChatMessage{}
@@ -384,12 +533,18 @@ func (b *Bus) handleNatsPublish(ctx context.Context, subtopics []string, data []
 		}
 		entGame, err := gameplay.HandleEvent(ctx, b.gameStore, b.userStore, b.listStatStore,
 			userID, evt)
+		if entGame != nil {
+			// The clocks changed (or the game ended); reschedule its flag-fall.
+			entGame.RLock()
+			b.adjudicator.Track(entGame)
+			entGame.RUnlock()
+		}
 		if err != nil {
 			return err
 		}
 		entGame.RLock()
-		// Determine if one of our players is a bot (no bot-vs-bot supported yet?)
-		// and if it is the bot's turn.
+		// Determine if one of our players is a bot, and if it is the bot's
+		// turn. (Games between two bots are driven by handleBotMove alone.)
 		if entGame.GameReq != nil &&
 			entGame.GameReq.PlayerVsBot &&
 			entGame.Game.Playing() != macondopb.PlayState_GAME_OVER &&
@@ -399,6 +554,7 @@ func (b *Bus) handleNatsPublish(ctx context.Context, subtopics []string, data []
 			// Do this in a separate goroutine as it blocks while waiting for bot move.
 			go b.handleBotMove(ctx, entGame)
 		} else {
+			b.notifyCorresTurn(ctx, entGame)
 			entGame.RUnlock()
 		}
 		return nil
@@ -409,7 +565,12 @@ func (b *Bus) handleNatsPublish(ctx context.Context, subtopics []string, data []
 		if err != nil {
 			return err
 		}
-		return gameplay.TimedOut(ctx, b.gameStore, b.userStore, b.listStatStore, evt.UserId, evt.GameId)
+		err = gameplay.TimedOut(ctx, b.gameStore, b.userStore, b.listStatStore, evt.UserId, evt.GameId)
+		if err != nil {
+			return err
+		}
+		b.adjudicator.Untrack(evt.GameId)
+		return nil
 
 	case "initRealmInfo":
 		evt := &pb.InitRealmInfo{}
@@ -425,6 +586,53 @@ func (b *Bus) handleNatsPublish(ctx context.Context, subtopics []string, data []
 			return err
 		}
 		return b.readyForGame(ctx, evt, userID)
+	case "adjournOffer":
+		evt := &pb.AdjournOffer{}
+		err := proto.Unmarshal(data, evt)
+		if err != nil {
+			return err
+		}
+		return gameplay.OfferAdjournment(ctx, b.gameStore, userID, evt.GameId)
+	case "adjournResponse":
+		evt := &pb.AdjournResponse{}
+		err := proto.Unmarshal(data, evt)
+		if err != nil {
+			return err
+		}
+		return b.adjournResponse(ctx, evt, userID)
+	case "resumeOffer":
+		evt := &pb.ResumeOffer{}
+		err := proto.Unmarshal(data, evt)
+		if err != nil {
+			return err
+		}
+		return gameplay.OfferResumption(ctx, b.gameStore, userID, evt.GameId)
+	case "takebackRequest":
+		evt := &pb.TakebackRequest{}
+		err := proto.Unmarshal(data, evt)
+		if err != nil {
+			return err
+		}
+		entGame, err := gameplay.RequestTakeback(ctx, b.gameStore, userID, evt.GameId)
+		b.trackTakeback(entGame)
+		return err
+	case "takebackResponse":
+		evt := &pb.TakebackResponse{}
+		err := proto.Unmarshal(data, evt)
+		if err != nil {
+			return err
+		}
+		entGame, err := gameplay.RespondToTakeback(ctx, b.gameStore, userID, evt.GameId, evt.Accept)
+		b.trackTakeback(entGame)
+		return err
+	case "gameHandoff":
+		evt := &pb.GameHandoff{}
+		err := proto.Unmarshal(data, evt)
+		if err != nil {
+			return err
+		}
+		b.takeOverGames(ctx, evt)
+		return nil
 	case "leaveSite":
 		// There is no event here. We have the user ID in the subject.
 		return b.leaveSite(ctx, userID)
@@ -497,7 +705,7 @@ func (b *Bus) seekRequest(ctx context.Context, seekOrMatch, auth, userID, connID
 	reqUser.UserId = userID
 	setMatchUser(req, reqUser)
 
-	err = gameplay.ValidateSoughtGame(ctx, gameRequest)
+	err = gameplay.ValidateSoughtGame(ctx, b.catalog, gameRequest)
 	if err != nil {
 		return err
 	}
@@ -518,18 +726,20 @@ func (b *Bus) seekRequest(ctx context.Context, seekOrMatch, auth, userID, connID
 	if seekOrMatch == "seekRequest" {
 		sr := req.(*pb.SeekRequest)
 		sr.ConnectionId = connID
+		err = gameplay.ValidateRatingRange(sr.MinimumRating, sr.MaximumRating)
+		if err != nil {
+			return err
+		}
 		sg, err := gameplay.NewSoughtGame(ctx, b.soughtGameStore, sr)
 		if err != nil {
 			return err
 		}
-		evt := entity.WrapEvent(sg.SeekRequest, pb.MessageType_SEEK_REQUEST)
-		data, err := evt.Serialize()
+		err = b.publishSeek(ctx, sg)
 		if err != nil {
 			return err
 		}
-
-		log.Debug().Interface("evt", evt).Msg("publishing seek request to lobby topic")
-		b.natsconn.Publish("lobby.seekRequest", data)
+		// A seek replaces a quick-pair request.
+		b.leaveQuickPairPool(ctx, userID)
 	} else {
 		mr := req.(*pb.MatchRequest)
 		mr.ConnectionId = connID
@@ -571,17 +781,23 @@ func (b *Bus) newBotGame(ctx context.Context, req *pb.MatchRequest, botUserID st
 	// NewBotGame creates and starts a new game against a bot!
 	var err error
 	var accUser *entity.User
-	if botUserID == "" {
-		accUser, err = b.userStore.GetRandomBot(ctx)
-	} else {
+	if botUserID != "" {
 		accUser, err = b.userStore.GetByUUID(ctx, botUserID)
+	} else if req.ReceivingUser != nil && req.ReceivingUser.DisplayName != "" {
+		accUser, err = b.userStore.Get(ctx, req.ReceivingUser.DisplayName)
+		if err == nil && !accUser.IsBot {
+			err = errors.New(accUser.Username + " is not a bot")
+		}
+	} else {
+		accUser, err = b.userStore.GetRandomBot(ctx, int(req.BotTier))
 	}
 	if err != nil {
 		return err
 	}
 	sg := &entity.SoughtGame{MatchRequest: req}
-	return b.instantiateAndStartGame(ctx, accUser, req.User.UserId, req.GameRequest,
+	_, err = b.instantiateAndStartGame(ctx, accUser, req.User.UserId, req.GameRequest,
 		sg, BotRequestID, "")
+	return err
 }
 
 func (b *Bus) gameAccepted(ctx context.Context, evt *pb.SoughtGameProcessEvent,
@@ -608,48 +824,71 @@ func (b *Bus) gameAccepted(ctx context.Context, evt *pb.SoughtGameProcessEvent,
 		// broadcast a seek deletion.
 		return b.broadcastSeekDeletion(evt.RequestId)
 	}
-	// Otherwise create a game
+	accUser, err := b.userStore.GetByUUID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if sg.Type() == entity.TypeSeek {
+		err = gameplay.SeekEligibility(sg.SeekRequest, accUser)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Otherwise create a game. Claim the seek first, so that nobody else
+	// can accept it at the same time.
+	sg, err = b.soughtGameStore.Claim(ctx, evt.RequestId)
+	if err != nil {
+		return errors.New("that game is no longer available")
+	}
 	// If the ACCEPTOR of the seek has a seek request open, we must cancel it.
 	err = b.deleteSoughtForUser(ctx, userID)
 	if err != nil {
+		b.restoreSoughtGame(ctx, sg)
 		return err
 	}
 
-	accUser, err := b.userStore.GetByUUID(ctx, userID)
+	_, err = b.instantiateAndStartGame(ctx, accUser, requester, gameReq, sg, evt.RequestId, connID)
 	if err != nil {
+		b.restoreSoughtGame(ctx, sg)
 		return err
 	}
-
-	return b.instantiateAndStartGame(ctx, accUser, requester, gameReq, sg, evt.RequestId, connID)
+	// Neither of them is waiting for a quick pairing anymore.
+	b.leaveQuickPairPool(ctx, userID)
+	b.leaveQuickPairPool(ctx, requester)
+	return nil
 }
 
 func (b *Bus) instantiateAndStartGame(ctx context.Context, accUser *entity.User, requester string,
-	gameReq *pb.GameRequest, sg *entity.SoughtGame, reqID, acceptingConnID string) error {
+	gameReq *pb.GameRequest, sg *entity.SoughtGame, reqID, acceptingConnID string) (*entity.Game, error) {
 
 	reqUser, err := b.userStore.GetByUUID(ctx, requester)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// disallow anon game acceptance for now.
 	if accUser.Anonymous || reqUser.Anonymous {
-		return errors.New("you must log in to play games")
+		return nil, errors.New("you must log in to play games")
 	}
 
 	if (accUser.Anonymous || reqUser.Anonymous) && gameReq.RatingMode == pb.RatingMode_RATED {
-		return errors.New("anonymous-players-cant-play-rated")
+		return nil, errors.New("anonymous-players-cant-play-rated")
 	}
 
 	log.Debug().Interface("req", sg).
 		Str("seek-conn", sg.ConnID()).
 		Str("accepting-conn", acceptingConnID).Msg("game-request-accepted")
 	assignedFirst := -1
-	if sg.Type() == entity.TypeMatch {
+	if reqID == TournamentRequestID {
+		// The tournament decides who goes first; that's the requester.
+		assignedFirst = 1
+	} else if sg.Type() == entity.TypeMatch {
 		if sg.MatchRequest.RematchFor != "" {
 			// Assign firsts to be the the other player.
 			gameID := sg.MatchRequest.RematchFor
 			g, err := b.gameStore.Get(ctx, gameID)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			wentFirst := 0
 			players := g.History().Players
@@ -670,10 +909,18 @@ func (b *Bus) instantiateAndStartGame(ctx context.Context, accUser *entity.User,
 	g, err := gameplay.InstantiateNewGame(ctx, b.gameStore, b.config,
 		[2]*entity.User{accUser, reqUser}, assignedFirst, gameReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	err = b.ownership.Claim(ctx, g.GameID())
+	if err != nil {
+		return nil, err
+	}
+	// If they never both get ready for it, the game will be aborted.
+	g.RLock()
+	b.adjudicator.Track(g)
+	g.RUnlock()
 	// Broadcast a seek delete event, and send both parties a game redirect.
-	if reqID != BotRequestID {
+	if reqID != BotRequestID && reqID != TournamentRequestID && reqID != QuickPairRequestID {
 		b.soughtGameStore.Delete(ctx, reqID)
 		err = b.broadcastSeekDeletion(reqID)
 		if err != nil {
@@ -696,6 +943,12 @@ func (b *Bus) instantiateAndStartGame(ctx context.Context, accUser *entity.User,
 	b.pubToUser(accUser.UUID, ngevt, "")
 	b.pubToUser(reqUser.UUID, ngevt, "")
 
+	if g.IsCorrespondence() {
+		// Nobody waits around for their opponent to show up in a
+		// correspondence game; start the clock right away.
+		b.startCorresGame(ctx, g, requester)
+	}
+
 	log.Info().Str("newgameid", g.History().Uid).
 		Str("sender", accUser.UUID).
 		Str("requester", requester).
@@ -703,7 +956,7 @@ func (b *Bus) instantiateAndStartGame(ctx context.Context, accUser *entity.User,
 		Interface("starting-in", GameStartDelay).
 		Str("onturn", g.NickOnTurn()).Msg("game-accepted")
 
-	return nil
+	return g, nil
 }
 
 func (b *Bus) readyForGame(ctx context.Context, evt *pb.ReadyForGame, userID string) error {
@@ -717,6 +970,9 @@ func (b *Bus) readyForGame(ctx context.Context, evt *pb.ReadyForGame, userID str
 	if g.Playing() != macondopb.PlayState_PLAYING {
 		return errors.New("game is over")
 	}
+	if g.Adjourned && g.ResumeOfferedBy == "" {
+		return errors.New("game is adjourned")
+	}
 
 	if g.History().Players[0].UserId == userID {
 		g.PlayersReady[0] = true
@@ -726,6 +982,17 @@ func (b *Bus) readyForGame(ctx context.Context, evt *pb.ReadyForGame, userID str
 		log.Error().Str("userID", userID).Str("gameID", evt.GameId).Msg("not-in-game")
 		return errors.New("ready for game but not in game")
 	}
+	if g.IsCorrespondence() {
+		// These were already started when they were created.
+		return nil
+	}
+
+	if g.Adjourned {
+		if g.PlayersReady[0] && g.PlayersReady[1] {
+			return b.resumeGame(ctx, g)
+		}
+		return nil
+	}
 
 	// Start the game if both players are ready (or if it's a bot game).
 	if g.PlayersReady[0] && g.PlayersReady[1] || g.GameReq.PlayerVsBot {
@@ -733,6 +1000,7 @@ func (b *Bus) readyForGame(ctx context.Context, evt *pb.ReadyForGame, userID str
 		if err != nil {
 			log.Err(err).Msg("starting-game")
 		}
+		b.adjudicator.Track(g)
 
 		if g.GameReq.PlayerVsBot && g.PlayerIDOnTurn() != userID {
 			// Make a bot move if it's the bot's turn at the beginning.
@@ -742,6 +1010,62 @@ func (b *Bus) readyForGame(ctx context.Context, evt *pb.ReadyForGame, userID str
 	return nil
 }
 
+// publishSeek sends a new seek to everyone in the lobby who can accept it.
+// Seeks with no rating range go to everyone, even those who aren't in the
+// lobby right now.
+func (b *Bus) publishSeek(ctx context.Context, sg *entity.SoughtGame) error {
+	evt := entity.WrapEvent(sg.SeekRequest, pb.MessageType_SEEK_REQUEST)
+	sr := sg.SeekRequest
+	if sr.MinimumRating == 0 && sr.MaximumRating == 0 {
+		data, err := evt.Serialize()
+		if err != nil {
+			return err
+		}
+		log.Debug().Interface("evt", evt).Msg("publishing seek request to lobby topic")
+		return b.natsconn.Publish("lobby.seekRequest", data)
+	}
+
+	users, err := b.presenceStore.GetInChannel(ctx, "lobby.presence")
+	if err != nil {
+		return err
+	}
+	sentToSeeker := false
+	for _, lu := range users {
+		if lu.UUID == sg.Seeker() {
+			sentToSeeker = true
+		} else {
+			if lu.Anonymous {
+				continue
+			}
+			u, err := b.userStore.GetByUUID(ctx, lu.UUID)
+			if err != nil {
+				log.Err(err).Str("userID", lu.UUID).Msg("publish-seek-get-user")
+				continue
+			}
+			if gameplay.SeekEligibility(sr, u) != nil {
+				continue
+			}
+		}
+		b.pubToUser(lu.UUID, evt, "lobby")
+	}
+	if !sentToSeeker {
+		// So that they can see it (and cancel it) themselves.
+		b.pubToUser(sg.Seeker(), evt, "lobby")
+	}
+	return nil
+}
+
+// restoreSoughtGame puts back a sought game that was claimed by someone who
+// then failed to start a game with it.
+func (b *Bus) restoreSoughtGame(ctx context.Context, sg *entity.SoughtGame) {
+	err := b.soughtGameStore.Set(ctx, sg)
+	if err != nil {
+		log.Err(err).Str("reqID", sg.ID()).Msg("restoring-sought-game")
+		// It's gone for good; take it off everyone's screens.
+		b.broadcastSeekDeletion(sg.ID())
+	}
+}
+
 func (b *Bus) matchDeclined(ctx context.Context, evt *pb.DeclineMatchRequest, userID string) error {
 	// the sending user declined the match request. Send this declination
 	// to the matcher and delete the request.
@@ -883,7 +1207,7 @@ func (b *Bus) initRealmInfo(ctx context.Context, evt *pb.InitRealmInfo) error {
 
 	if evt.Realm == "lobby" {
 		// open seeks
-		seeks, err := b.openSeeks(ctx)
+		seeks, err := b.openSeeks(ctx, evt.UserId)
 		if err != nil {
 			return err
 		}
@@ -909,6 +1233,16 @@ func (b *Bus) initRealmInfo(ctx context.Context, evt *pb.InitRealmInfo) error {
 		if err != nil {
 			return err
 		}
+		// quick-pair pools
+		pools, err := b.quickPairer.Pools(ctx)
+		if err != nil {
+			return err
+		}
+		err = b.pubToUser(evt.UserId, entity.WrapEvent(pools,
+			pb.MessageType_QUICK_PAIR_POOLS), "lobby")
+		if err != nil {
+			return err
+		}
 		// TODO: send followed online
 
 	} else if strings.HasPrefix(evt.Realm, "game-") || strings.HasPrefix(evt.Realm, "gametv-") {
@@ -992,6 +1326,15 @@ func (b *Bus) deleteSoughtForConnID(ctx context.Context, connID string) error {
 }
 
 func (b *Bus) leaveTab(ctx context.Context, userID, connID string) error {
+	left, err := b.quickPairer.LeaveConn(ctx, connID)
+	if err != nil {
+		log.Err(err).Msg("leaving-quick-pair-pool")
+	} else if left {
+		err = b.broadcastQuickPairPools(ctx)
+		if err != nil {
+			log.Err(err).Msg("broadcasting-quick-pair-pools")
+		}
+	}
 	return b.deleteSoughtForConnID(ctx, connID)
 }
 
@@ -1005,6 +1348,7 @@ func (b *Bus) leaveSite(ctx context.Context, userID string) error {
 		return err
 	}
 	log.Debug().Str("oldchannel", oldchannel).Str("userid", userID).Msg("left-site")
+	b.leaveQuickPairPool(ctx, userID)
 
 	err = b.broadcastPresence(username, userID, anon, oldchannel, true)
 	if err != nil {
@@ -1014,15 +1358,24 @@ func (b *Bus) leaveSite(ctx context.Context, userID string) error {
 	return nil
 }
 
-func (b *Bus) openSeeks(ctx context.Context) (*entity.EventWrapper, error) {
+// openSeeks gets the open seeks that the given user can accept, as well as
+// their own.
+func (b *Bus) openSeeks(ctx context.Context, userID string) (*entity.EventWrapper, error) {
 	sgs, err := b.soughtGameStore.ListOpenSeeks(ctx)
 	if err != nil {
 		return nil, err
 	}
 	log.Debug().Interface("open-seeks", sgs).Msg("open-seeks")
+	u, err := b.userStore.GetByUUID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	pbobj := &pb.SeekRequests{Requests: []*pb.SeekRequest{}}
 	for _, sg := range sgs {
+		if sg.Seeker() != userID && gameplay.SeekEligibility(sg.SeekRequest, u) != nil {
+			continue
+		}
 		pbobj.Requests = append(pbobj.Requests, sg.SeekRequest)
 	}
 	evt := entity.WrapEvent(pbobj, pb.MessageType_SEEK_REQUESTS)
@@ -1053,34 +1406,57 @@ func (b *Bus) activeGames(ctx context.Context) (*entity.EventWrapper, error) {
 
 	pbobj := &pb.ActiveGames{Games: []*pb.GameMeta{}}
 	for _, g := range gs {
+		if g.GameRequest.GameMode == pb.GameMode_CORRESPONDENCE {
+			// The lobby only shows games that are being played live.
+			continue
+		}
 		pbobj.Games = append(pbobj.Games, g)
 	}
 	evt := entity.WrapEvent(pbobj, pb.MessageType_ACTIVE_GAMES)
 	return evt, nil
 }
 
-func (b *Bus) adjudicateGames(ctx context.Context) error {
-	gs, err := b.gameStore.ListActive(ctx)
+func (b *Bus) adjudicateGames(ctx context.Context) {
+	timedOut := b.adjudicator.Adjudicate(ctx)
+	if len(timedOut) > 0 {
+		log.Info().Interface("games", timedOut).Msg("adjudicated-games")
+	}
+}
 
+// sweepOrphanedGames adopts the active games that nobody owns, and makes sure
+// all the games this node owns are on the flag-fall schedule.
+func (b *Bus) sweepOrphanedGames(ctx context.Context) {
+	err := b.adjudicator.Rebuild(ctx)
 	if err != nil {
-		return err
+		log.Err(err).Msg("adjudicator-rebuild-error")
 	}
-	log.Debug().Interface("active-games", gs).Msg("adjudicating...")
-	for _, g := range gs {
-		// These will likely be in the cache.
-		entGame, err := b.gameStore.Get(ctx, g.Id)
+}
+
+func (b *Bus) expireSoughtGames(ctx context.Context) {
+	expired, err := b.soughtGameStore.DeleteExpired(ctx)
+	if err != nil {
+		log.Err(err).Msg("expire-sought-games-error")
+	}
+	for _, id := range expired {
+		err := b.broadcastSeekDeletion(id)
 		if err != nil {
-			return err
-		}
-		onTurn := entGame.Game.PlayerOnTurn()
-		if entGame.TimeRanOut(onTurn) {
-			log.Debug().Str("gid", g.Id).Msg("time-ran-out")
-			err = gameplay.TimedOut(ctx, b.gameStore, b.userStore,
-				b.listStatStore, entGame.Game.PlayerIDOnTurn(), g.Id)
-			log.Err(err).Msg("gameplay-timed-out")
+			log.Err(err).Str("reqID", id).Msg("broadcasting-seek")
 		}
 	}
-	return nil
+	if len(expired) > 0 {
+		log.Info().Interface("reqIDs", expired).Msg("expired-sought-games")
+	}
+}
+
+func (b *Bus) checkAbandonedGames(ctx context.Context) {
+	ended, err := b.abandonWatcher.Check(ctx)
+	if err != nil {
+		log.Err(err).Msg("abandon-check-error")
+		return
+	}
+	if len(ended) > 0 {
+		log.Info().Interface("games", ended).Msg("abandoned-games")
+	}
 }
 
 func (b *Bus) gameRefresher(ctx context.Context, gameID string) (*entity.EventWrapper, error) {
@@ -0,0 +1,69 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+// SetTurnNotifier replaces the default turn notifier for correspondence
+// games, which only tells the player's open sockets about it.
+func (b *Bus) SetTurnNotifier(n gameplay.TurnNotifier) {
+	b.turnNotifier = n
+}
+
+// NotifyTurn sends a message to every open socket of the player on turn.
+func (b *Bus) NotifyTurn(ctx context.Context, g *entity.Game) error {
+	opp := g.History().Players[1-g.PlayerOnTurn()].Nickname
+	evt := entity.WrapEvent(&pb.ServerMessage{
+		Message: fmt.Sprintf("It's your turn in your correspondence game against %v.", opp),
+	}, pb.MessageType_SERVER_MESSAGE)
+	return b.pubToUser(g.PlayerIDOnTurn(), evt, "")
+}
+
+// notifyCorresTurn lets the player on turn know it's their move, if this is
+// a correspondence game that's still going. The caller must hold at least
+// a read lock on the game.
+func (b *Bus) notifyCorresTurn(ctx context.Context, g *entity.Game) {
+	if !g.IsCorrespondence() || g.Playing() == macondopb.PlayState_GAME_OVER {
+		return
+	}
+	err := b.turnNotifier.NotifyTurn(ctx, g)
+	if err != nil {
+		log.Err(err).Str("gameID", g.GameID()).Msg("notify-corres-turn")
+	}
+}
+
+// startCorresGame starts a correspondence game as soon as it's created. The
+// requester is the human in a bot game.
+func (b *Bus) startCorresGame(ctx context.Context, g *entity.Game, requester string) {
+	g.Lock()
+	defer g.Unlock()
+	err := gameplay.StartGame(ctx, b.gameStore, b.gameEventChan, g.GameID())
+	if err != nil {
+		log.Err(err).Msg("starting-corres-game")
+		return
+	}
+	b.adjudicator.Track(g)
+	if g.GameReq.PlayerVsBot && g.PlayerIDOnTurn() != requester {
+		go b.handleBotMove(ctx, g)
+		return
+	}
+	b.notifyCorresTurn(ctx, g)
+}
+
+// unloadIdleCorresGames unloads the correspondence games that nobody has
+// touched in a while. They stay tracked by the adjudicator, which loads
+// them again when their time runs out.
+func (b *Bus) unloadIdleCorresGames(ctx context.Context) {
+	ids := b.gameStore.UnloadIdleCorrespondence(ctx, CorresIdleTimeout)
+	if len(ids) > 0 {
+		log.Debug().Int("num-unloaded", len(ids)).Msg("unloaded-idle-corres-games")
+	}
+}
@@ -0,0 +1,159 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+var errOwnerChanged = errors.New("this game is moving to another server; please try again")
+
+// nodeTopic is the prefix of the topic that only this node subscribes to.
+// Messages about games that this node owns are forwarded there.
+func nodeTopic(nodeID string) string {
+	return "node." + nodeID + ".pb"
+}
+
+// routedGameID returns the ID of the game a published message changes or
+// needs an up-to-date copy of, if any. Only the node that owns that game may
+// handle the message.
+func routedGameID(msgType string, data []byte) (string, error) {
+	switch msgType {
+	case "gameplayEvent":
+		evt := &pb.ClientGameplayEvent{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		return evt.GameId, nil
+	case "timedOut":
+		evt := &pb.TimedOut{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		return evt.GameId, nil
+	case "readyForGame":
+		evt := &pb.ReadyForGame{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		return evt.GameId, nil
+	case "adjournOffer":
+		evt := &pb.AdjournOffer{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		return evt.GameId, nil
+	case "adjournResponse":
+		evt := &pb.AdjournResponse{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		return evt.GameId, nil
+	case "resumeOffer":
+		evt := &pb.ResumeOffer{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		return evt.GameId, nil
+	case "takebackRequest":
+		evt := &pb.TakebackRequest{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		return evt.GameId, nil
+	case "takebackResponse":
+		evt := &pb.TakebackResponse{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		return evt.GameId, nil
+	case "initRealmInfo":
+		evt := &pb.InitRealmInfo{}
+		if err := proto.Unmarshal(data, evt); err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(evt.Realm, "game-") || strings.HasPrefix(evt.Realm, "gametv-") {
+			return strings.SplitN(evt.Realm, "-", 2)[1], nil
+		}
+	}
+	return "", nil
+}
+
+// routeNatsPublish handles a published message, unless it's about a game
+// that another node owns; then it forwards the message to that node. A
+// forwarded message is never forwarded again.
+func (b *Bus) routeNatsPublish(ctx context.Context, subtopics []string, data []byte,
+	forwarded bool) error {
+
+	gameID, err := routedGameID(subtopics[0], data)
+	if err != nil {
+		return err
+	}
+	if gameID != "" {
+		owner, err := b.ownership.Owner(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		if owner != b.ownership.NodeID() {
+			if forwarded {
+				// It changed hands while the message was on its way.
+				return errOwnerChanged
+			}
+			log.Debug().Str("gameID", gameID).Str("owner", owner).Msg("forwarding-to-owner")
+			return b.natsconn.Publish(nodeTopic(owner)+"."+strings.Join(subtopics, "."), data)
+		}
+	}
+	return b.handleNatsPublish(ctx, subtopics, data)
+}
+
+// adoptGame starts keeping time in a game that this node just took over.
+func (b *Bus) adoptGame(ctx context.Context, gameID string) {
+	g, err := b.gameStore.Get(ctx, gameID)
+	if err != nil {
+		log.Err(err).Str("gameID", gameID).Msg("adopt-game")
+		return
+	}
+	g.RLock()
+	b.adjudicator.Track(g)
+	g.RUnlock()
+}
+
+// Handoff gives up all of this node's games, and asks another node to take
+// them over. It should be called when the node shuts down, once it has
+// stopped processing messages.
+func (b *Bus) Handoff(ctx context.Context) error {
+	// Stop taking messages first, or we could be handed our own games.
+	for _, sub := range b.subscriptions {
+		if err := sub.Unsubscribe(); err != nil {
+			log.Err(err).Str("subject", sub.Subject).Msg("unsubscribe")
+		}
+	}
+	ids := b.ownership.ReleaseAll(ctx)
+	if len(ids) == 0 {
+		return nil
+	}
+	data, err := proto.Marshal(&pb.GameHandoff{GameIds: ids})
+	if err != nil {
+		return err
+	}
+	log.Info().Int("num-games", len(ids)).Msg("handing-off-games")
+	err = b.natsconn.Publish("ipc.pb.gameHandoff", data)
+	if err != nil {
+		return err
+	}
+	return b.natsconn.Flush()
+}
+
+// takeOverGames takes over the games of a node that's shutting down.
+func (b *Bus) takeOverGames(ctx context.Context, evt *pb.GameHandoff) {
+	for _, id := range evt.GameIds {
+		// Whoever gets there first owns the game; if it's us, we'll adopt
+		// it right away.
+		b.ownership.Owns(ctx, id)
+	}
+}
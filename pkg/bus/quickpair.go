@@ -0,0 +1,141 @@
+package bus
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+func (b *Bus) quickPairRequest(ctx context.Context, auth, userID, connID string, data []byte) error {
+	if auth == "anon" {
+		return errors.New("please log in to start a game")
+	}
+	req := &pb.QuickPairRequest{}
+	err := proto.Unmarshal(data, req)
+	if err != nil {
+		return err
+	}
+	if req.GameRequest == nil {
+		return errors.New("no game request was found")
+	}
+	err = gameplay.ValidateSoughtGame(ctx, b.catalog, req.GameRequest)
+	if err != nil {
+		return err
+	}
+	timefmt, variant, err := entity.VariantFromGameReq(req.GameRequest)
+	if err != nil {
+		return err
+	}
+	u, err := b.userStore.GetByUUID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	rating, err := u.GetRating(entity.ToVariantKey(req.GameRequest.Lexicon, variant, timefmt))
+	if err != nil {
+		return err
+	}
+	// You can't wait for a quick pairing with a seek open at the same time.
+	err = b.deleteSoughtForUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	err = b.quickPairer.Join(ctx, userID, connID, int(rating.Rating), int(req.MinimumRating),
+		int(req.MaximumRating), req.GameRequest)
+	if err != nil {
+		return err
+	}
+	log.Debug().Str("userID", userID).Interface("req", req).Msg("joined-quick-pair-pool")
+	return b.broadcastQuickPairPools(ctx)
+}
+
+func (b *Bus) quickPairCancel(ctx context.Context, userID string) error {
+	left, err := b.quickPairer.Leave(ctx, userID)
+	if err != nil || !left {
+		return err
+	}
+	return b.broadcastQuickPairPools(ctx)
+}
+
+// leaveQuickPairPool takes the user out of their quick-pair pool, if they
+// were in one, and lets the lobby know.
+func (b *Bus) leaveQuickPairPool(ctx context.Context, userID string) {
+	left, err := b.quickPairer.Leave(ctx, userID)
+	if err != nil {
+		log.Err(err).Str("userID", userID).Msg("leaving-quick-pair-pool")
+		return
+	}
+	if !left {
+		return
+	}
+	err = b.broadcastQuickPairPools(ctx)
+	if err != nil {
+		log.Err(err).Msg("broadcasting-quick-pair-pools")
+	}
+}
+
+// quickPair pairs up everyone waiting in the quick-pair pools, and starts
+// the games of whoever got paired. Every node tries, but only one of them
+// pairs the pools each time.
+func (b *Bus) quickPair(ctx context.Context) {
+	matches, err := b.quickPairer.Pair(ctx)
+	if err != nil {
+		log.Err(err).Msg("quick-pair-error")
+		return
+	}
+	if matches == nil {
+		// Another node got to it first.
+		return
+	}
+	for _, m := range matches {
+		err := b.startQuickPairGame(ctx, m)
+		if err != nil {
+			log.Err(err).Interface("users", m.UserIDs).Msg("starting-quick-pair-game")
+			for _, u := range m.UserIDs {
+				b.pubToUser(u, entity.WrapEvent(&pb.ErrorMessage{Message: err.Error()},
+					pb.MessageType_ERROR_MESSAGE), "")
+			}
+		}
+	}
+	// The misses went up even if nobody got paired, so always send the
+	// pool sizes.
+	err = b.broadcastQuickPairPools(ctx)
+	if err != nil {
+		log.Err(err).Msg("broadcasting-quick-pair-pools")
+	}
+}
+
+// startQuickPairGame starts a game for a quick-pair match. The user who has
+// been waiting the longest is treated as the one who made the request.
+func (b *Bus) startQuickPairGame(ctx context.Context, m *gameplay.QuickPairMatch) error {
+	accUser, err := b.userStore.GetByUUID(ctx, m.UserIDs[1])
+	if err != nil {
+		return err
+	}
+	sg := &entity.SoughtGame{SeekRequest: &pb.SeekRequest{
+		GameRequest:  m.GameRequest,
+		User:         &pb.MatchUser{UserId: m.UserIDs[0]},
+		ConnectionId: m.ConnIDs[0],
+	}}
+	_, err = b.instantiateAndStartGame(ctx, accUser, m.UserIDs[0], m.GameRequest, sg,
+		QuickPairRequestID, m.ConnIDs[1])
+	return err
+}
+
+func (b *Bus) broadcastQuickPairPools(ctx context.Context) error {
+	pools, err := b.quickPairer.Pools(ctx)
+	if err != nil {
+		return err
+	}
+	toSend := entity.WrapEvent(pools, pb.MessageType_QUICK_PAIR_POOLS)
+	data, err := toSend.Serialize()
+	if err != nil {
+		return err
+	}
+	return b.natsconn.Publish("lobby.quickPairPools", data)
+}
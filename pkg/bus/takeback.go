@@ -0,0 +1,16 @@
+package bus
+
+import (
+	"github.com/domino14/liwords/pkg/entity"
+)
+
+// trackTakeback reschedules the flag-fall of a game after a takeback
+// request or response, as the clocks may have been restored.
+func (b *Bus) trackTakeback(entGame *entity.Game) {
+	if entGame == nil {
+		return
+	}
+	entGame.RLock()
+	b.adjudicator.Track(entGame)
+	entGame.RUnlock()
+}
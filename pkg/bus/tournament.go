@@ -0,0 +1,46 @@
+package bus
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/tournament"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// StartTournamentGame starts a game between two players of a tournament.
+// Both players get redirected to the game, just as if one had accepted the
+// other's match request.
+func (b *Bus) StartTournamentGame(ctx context.Context, t *entity.Tournament,
+	first, second string) (string, error) {
+
+	gameReq := proto.Clone(t.GameReq).(*pb.GameRequest)
+	gameReq.TournamentId = t.UUID
+
+	accUser, err := b.userStore.GetByUUID(ctx, second)
+	if err != nil {
+		return "", err
+	}
+	sg := &entity.SoughtGame{MatchRequest: &pb.MatchRequest{
+		User:          &pb.MatchUser{UserId: first},
+		ReceivingUser: &pb.MatchUser{UserId: second},
+		GameRequest:   gameReq,
+	}}
+	g, err := b.instantiateAndStartGame(ctx, accUser, first, gameReq, sg,
+		TournamentRequestID, "")
+	if err != nil {
+		return "", err
+	}
+	return g.GameID(), nil
+}
+
+func (b *Bus) recordTournamentResult(ctx context.Context, evt *pb.GameEndedEvent) {
+	err := tournament.RecordResult(ctx, b.tournamentStore, evt)
+	if err != nil {
+		log.Err(err).Str("tournamentID", evt.TournamentId).Str("gameID", evt.GameId).
+			Msg("recording-tournament-result")
+	}
+}
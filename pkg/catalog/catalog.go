@@ -0,0 +1,165 @@
+// Package catalog keeps track of the lexica and letter distributions that
+// games can be played with on this server.
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/macondo/alphabet"
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/gaddag"
+)
+
+// displayNames are the full names of the lexica we know about. A lexicon
+// that isn't in here is displayed by its file name.
+var displayNames = map[string]string{
+	"NWL18":  "NASPA Word List 2018",
+	"NWL20":  "NASPA Word List 2020",
+	"CSW19":  "Collins Scrabble Words 2019",
+	"CSW21":  "Collins Scrabble Words 2021",
+	"ECWL":   "English Common Word List",
+	"FISE2":  "Fichero Internacional de Scrabble en Español",
+	"OSPS42": "Oficjalny Słownik Polskiego Scrabblisty",
+}
+
+// Lexicon describes a lexicon in the catalog.
+type Lexicon struct {
+	Name        string
+	DisplayName string
+	// Language is the name of the letter distribution the lexicon's words
+	// are spelled with.
+	Language string
+}
+
+// LetterDistribution describes a letter distribution in the catalog.
+type LetterDistribution struct {
+	Name        string
+	DisplayName string
+}
+
+// Catalog is the list of lexica and letter distributions found in the
+// directories Macondo loads them from. It doesn't change after it's
+// created, so it is safe for concurrent use.
+type Catalog struct {
+	cfg           *macondoconfig.Config
+	lexica        map[string]Lexicon
+	distributions map[string]LetterDistribution
+}
+
+// Language returns the letter distribution a lexicon's words are spelled
+// with. This mirrors how Macondo picks the distribution for a history.
+func Language(lexiconName string) string {
+	lexiconName = strings.ToUpper(lexiconName)
+	switch {
+	case strings.HasPrefix(lexiconName, "OSPS"):
+		return "polish"
+	case strings.HasPrefix(lexiconName, "FISE"):
+		return "spanish"
+	}
+	return "english"
+}
+
+// New scans the config's lexicon and letter distribution paths. Variant
+// distributions (english_super, etc) are named after the distribution they
+// are based on, and are not listed on their own.
+func New(cfg *macondoconfig.Config) (*Catalog, error) {
+	c := &Catalog{
+		cfg:           cfg,
+		lexica:        map[string]Lexicon{},
+		distributions: map[string]LetterDistribution{},
+	}
+	gaddags, err := filepath.Glob(filepath.Join(cfg.LexiconPath, "gaddag", "*.gaddag"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range gaddags {
+		name := strings.TrimSuffix(filepath.Base(f), ".gaddag")
+		displayName, ok := displayNames[name]
+		if !ok {
+			displayName = name
+		}
+		c.lexica[name] = Lexicon{Name: name, DisplayName: displayName, Language: Language(name)}
+	}
+
+	dists, err := filepath.Glob(filepath.Join(cfg.LetterDistributionPath, "*.csv"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range dists {
+		name := strings.TrimSuffix(filepath.Base(f), ".csv")
+		if strings.Contains(name, "_") {
+			continue
+		}
+		c.distributions[name] = LetterDistribution{Name: name, DisplayName: strings.Title(name)}
+	}
+	if len(c.lexica) == 0 || len(c.distributions) == 0 {
+		log.Warn().Str("lexicon-path", cfg.LexiconPath).
+			Str("letter-distribution-path", cfg.LetterDistributionPath).
+			Int("lexica", len(c.lexica)).Int("distributions", len(c.distributions)).
+			Msg("catalog-incomplete")
+	}
+	return c, nil
+}
+
+// Lexica returns the lexica in the catalog, sorted by name.
+func (c *Catalog) Lexica() []Lexicon {
+	lexica := make([]Lexicon, 0, len(c.lexica))
+	for _, l := range c.lexica {
+		lexica = append(lexica, l)
+	}
+	sort.Slice(lexica, func(i, j int) bool { return lexica[i].Name < lexica[j].Name })
+	return lexica
+}
+
+// LetterDistributions returns the letter distributions in the catalog,
+// sorted by name.
+func (c *Catalog) LetterDistributions() []LetterDistribution {
+	dists := make([]LetterDistribution, 0, len(c.distributions))
+	for _, d := range c.distributions {
+		dists = append(dists, d)
+	}
+	sort.Slice(dists, func(i, j int) bool { return dists[i].Name < dists[j].Name })
+	return dists
+}
+
+// Validate returns an error if a game can't be played with the lexicon and
+// letter distribution. Lexicon names are case-sensitive, like their files.
+func (c *Catalog) Validate(lexiconName, distributionName string) error {
+	lex, ok := c.lexica[lexiconName]
+	if !ok {
+		return fmt.Errorf("unsupported lexicon: %v", lexiconName)
+	}
+	dist := strings.ToLower(distributionName)
+	if _, ok := c.distributions[dist]; !ok {
+		return fmt.Errorf("unsupported letter distribution: %v", distributionName)
+	}
+	if lex.Language != dist {
+		return errors.New("the letter distribution does not match the lexicon")
+	}
+	return nil
+}
+
+// Preload loads every lexicon and letter distribution in the catalog into
+// Macondo's caches, so that the first game with each doesn't have to wait
+// for it.
+func (c *Catalog) Preload() error {
+	for _, l := range c.Lexica() {
+		if _, err := gaddag.LoadFromCache(c.cfg, l.Name); err != nil {
+			return fmt.Errorf("loading %v: %w", l.Name, err)
+		}
+	}
+	for _, d := range c.LetterDistributions() {
+		if _, err := alphabet.LoadLetterDistribution(c.cfg, d.Name); err != nil {
+			return fmt.Errorf("loading %v: %w", d.Name, err)
+		}
+	}
+	log.Info().Int("lexica", len(c.lexica)).Int("distributions", len(c.distributions)).
+		Msg("catalog-preloaded")
+	return nil
+}
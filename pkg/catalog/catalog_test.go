@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/gaddagmaker"
+)
+
+func TestCatalog(t *testing.T) {
+	is := is.New(t)
+	dir, err := ioutil.TempDir("", "catalog")
+	is.NoErr(err)
+	defer os.RemoveAll(dir)
+	is.NoErr(os.Mkdir(filepath.Join(dir, "gaddag"), 0755))
+	for _, name := range []string{"NWL18", "FISE2"} {
+		gd := gaddagmaker.GenerateGaddagFromStream(strings.NewReader("DOG\nWORM\n"), name)
+		gd.Save(filepath.Join(dir, "gaddag", name+".gaddag"), gaddagmaker.GaddagMagicNumber)
+	}
+	cfg := &macondoconfig.Config{
+		LexiconPath:            dir,
+		LetterDistributionPath: "../../data/letterdistributions",
+	}
+
+	c, err := New(cfg)
+	is.NoErr(err)
+	is.Equal(c.Lexica(), []Lexicon{
+		{Name: "FISE2", DisplayName: "Fichero Internacional de Scrabble en Español", Language: "spanish"},
+		{Name: "NWL18", DisplayName: "NASPA Word List 2018", Language: "english"},
+	})
+	// The variant distributions aren't listed.
+	is.Equal(c.LetterDistributions(), []LetterDistribution{
+		{Name: "english", DisplayName: "English"},
+		{Name: "polish", DisplayName: "Polish"},
+		{Name: "spanish", DisplayName: "Spanish"},
+	})
+
+	is.NoErr(c.Validate("NWL18", "English"))
+	is.NoErr(c.Validate("FISE2", "spanish"))
+	is.True(c.Validate("CSW19", "english") != nil)
+	is.True(c.Validate("nwl18", "english") != nil)
+	is.True(c.Validate("NWL18", "klingon") != nil)
+	is.True(c.Validate("NWL18", "spanish") != nil)
+
+	is.NoErr(c.Preload())
+}
@@ -14,6 +14,20 @@ type Config struct {
 	NatsURL      string
 	MailgunKey   string
 	RedisURL     string
+
+	// AbandonGraceSeconds is how long a player may be away from their game
+	// while on turn before the game is ended as abandoned.
+	AbandonGraceSeconds int
+	// AbandonPolicyRated and AbandonPolicyCasual determine what happens to
+	// abandoned games of each rating mode. See gameplay.AbandonPolicy.
+	AbandonPolicyRated  string
+	AbandonPolicyCasual string
+
+	// SeekExpirationMinutes is how long a seek or match request stays open.
+	SeekExpirationMinutes int
+	// ReadyTimeoutSeconds is how long both players have to show up to a new
+	// game before it is aborted.
+	ReadyTimeoutSeconds int
 }
 
 // Load loads the configs from the given arguments
@@ -33,6 +47,11 @@ func (c *Config) Load(args []string) error {
 	fs.StringVar(&c.NatsURL, "nats-url", "nats://localhost:4222", "the NATS server URL")
 	fs.StringVar(&c.MailgunKey, "mailgun-key", "", "the Mailgun secret key")
 	fs.StringVar(&c.RedisURL, "redis-url", "", "the Redis URL")
+	fs.IntVar(&c.AbandonGraceSeconds, "abandon-grace-seconds", 120, "seconds a player on turn may be away from their game before it is abandoned")
+	fs.StringVar(&c.AbandonPolicyRated, "abandon-policy-rated", "rated", "what to do with abandoned rated games: rated, unrated, or warn")
+	fs.StringVar(&c.AbandonPolicyCasual, "abandon-policy-casual", "unrated", "what to do with abandoned casual games: rated, unrated, or warn")
+	fs.IntVar(&c.SeekExpirationMinutes, "seek-expiration-minutes", 60, "minutes a seek or match request stays open")
+	fs.IntVar(&c.ReadyTimeoutSeconds, "ready-timeout-seconds", 60, "seconds both players have to show up to a new game before it is aborted")
 	err := fs.Parse(args)
 	return err
 }
@@ -11,7 +11,8 @@ import (
 )
 
 const (
-	CrosswordGame string = "CrosswordGame"
+	CrosswordGame      string = "CrosswordGame"
+	SuperCrosswordGame string = "SuperCrosswordGame"
 )
 
 type Timers struct {
@@ -68,12 +69,29 @@ type Game struct {
 	// is true.
 	Started bool
 	Timers  Timers
+	// Adjourned is set while the players have agreed to pause the game. Both
+	// clocks are frozen until it is resumed.
+	Adjourned bool
+	// AdjournOfferedBy and ResumeOfferedBy are the IDs of the players who
+	// proposed to adjourn or resume the game, if anyone has. They are not
+	// saved; an offer doesn't outlive the node that received it.
+	AdjournOfferedBy string
+	ResumeOfferedBy  string
+	// TakebackRequestedBy is the ID of the player who asked to take back
+	// their last turn, if anyone has. Like the offers above, it isn't saved.
+	TakebackRequestedBy string
 
 	GameEndReason pb.GameEndReason
 	// if 0 or 1, that player won
 	// if -1, it was a tie!
 	WinnerIdx int
 	LoserIdx  int
+	// RatingDeltas is how much each player's rating changed when the game
+	// was rated. It stays zero for games that aren't.
+	RatingDeltas [2]int
+	// Unrated is set when a game that was requested as rated ended without
+	// counting towards its players' ratings, like an abandoned game can.
+	Unrated bool
 
 	Stats *Stats
 
@@ -81,6 +99,28 @@ type Game struct {
 	nower      Nower
 }
 
+// A GameEndCommit is how the players of a game change when it ends. Apply
+// works out their new ratings and profile stats in the game's variant, by
+// user UUID, from the players as they are, and updates the game to match.
+// The players' profiles are locked while it runs, so that nothing else can
+// change them in the meantime; only what it returns is saved.
+type GameEndCommit struct {
+	VariantKey VariantKey
+	Apply      func(players map[string]*User) (map[string]SingleRating, map[string]*Stats, error)
+}
+
+// A GameNoteCommit is how a finished game and the profile stats of one of
+// its players change when that player annotates it. Apply updates the game
+// as it is saved, and works out the player's new profile stats in the game's
+// variant from the player as they are; it returns nil stats if they don't
+// change. As with a GameEndCommit, the game and the player's profile are
+// locked while it runs.
+type GameNoteCommit struct {
+	VariantKey VariantKey
+	UserID     string
+	Apply      func(g *Game, player *User) (*Stats, error)
+}
+
 // GameTimer uses the standard library's `time` package to determine how much time
 // has elapsed in a game.
 type GameTimer struct{}
@@ -121,6 +161,35 @@ func (g *Game) ResetTimersAndStart() {
 	g.Started = true
 }
 
+// Adjourn pauses the game. The time used by the player on turn so far is
+// taken off their clock, and both clocks stay frozen until the game is
+// resumed.
+func (g *Game) Adjourn() {
+	now := g.nower.Now()
+	g.calculateAndSetTimeRemaining(g.Game.PlayerOnTurn(), now, false)
+	g.Adjourned = true
+	g.AdjournOfferedBy = ""
+	g.ResumeOfferedBy = ""
+	g.PlayersReady = [2]bool{}
+}
+
+// Resume restarts the clocks of an adjourned game, from where they were
+// frozen.
+func (g *Game) Resume() {
+	log.Debug().Msg("resume-timers")
+	g.Timers.TimeOfLastUpdate = g.nower.Now()
+	g.Adjourned = false
+	g.ResumeOfferedBy = ""
+	g.Started = true
+}
+
+// RestoreTimers sets both players' clocks, e.g. after a takeback. The clock
+// of the player on turn starts running from now.
+func (g *Game) RestoreTimers(remaining [2]int) {
+	g.Timers.TimeRemaining = []int{remaining[0], remaining[1]}
+	g.Timers.TimeOfLastUpdate = g.nower.Now()
+}
+
 func (g *Game) RatingKey() (VariantKey, error) {
 	req := g.CreationRequest()
 	timefmt, variant, err := VariantFromGameReq(req)
@@ -132,7 +201,7 @@ func (g *Game) RatingKey() (VariantKey, error) {
 
 // TimeRemaining calculates the time remaining, but does NOT update it.
 func (g *Game) TimeRemaining(idx int) int {
-	if g.Game.PlayerOnTurn() == idx {
+	if g.Game.PlayerOnTurn() == idx && !g.Adjourned {
 		now := g.nower.Now()
 		return g.Timers.TimeRemaining[idx] - int(now-g.Timers.TimeOfLastUpdate)
 	}
@@ -145,14 +214,21 @@ func (g *Game) CachedTimeRemaining(idx int) int {
 }
 
 // TimeRanOut calculates if time ran out for the given player. Assumes player is
-// on turn, otherwise it always returns false.
+// on turn, otherwise it always returns false. Nobody runs out of time in an
+// adjourned game.
 func (g *Game) TimeRanOut(idx int) bool {
-	if g.Game.PlayerOnTurn() != idx {
+	if g.Game.PlayerOnTurn() != idx || g.Adjourned {
 		return false
 	}
-	now := g.nower.Now()
-	tr := g.Timers.TimeRemaining[idx] - int(now-g.Timers.TimeOfLastUpdate)
-	return tr < (-g.Timers.MaxOvertime * 60000)
+	return g.nower.Now() > g.FlagFallTime()
+}
+
+// FlagFallTime returns the timestamp, in milliseconds, after which the player
+// currently on turn will have run out of time, including any overtime.
+func (g *Game) FlagFallTime() int64 {
+	onTurn := g.Game.PlayerOnTurn()
+	return g.Timers.TimeOfLastUpdate + int64(g.Timers.TimeRemaining[onTurn]) +
+		int64(g.Timers.MaxOvertime*60000)
 }
 
 func (g *Game) TimeStarted() int64 {
@@ -177,18 +253,23 @@ func (g *Game) calculateAndSetTimeRemaining(pidx int, now int64, accountForIncre
 		Int("remaining", g.Timers.TimeRemaining[pidx]).
 		Msg("calculate-and-set-remaining")
 
-	if g.Game.PlayerOnTurn() == pidx {
+	if g.Game.PlayerOnTurn() == pidx && !g.Adjourned {
 		// Time has passed since this was calculated.
 		g.Timers.TimeRemaining[pidx] -= int(now - g.Timers.TimeOfLastUpdate)
 		if accountForIncrement {
-			g.Timers.TimeRemaining[pidx] += (int(g.GameReq.IncrementSeconds) * 1000)
+			if g.IsCorrespondence() {
+				// Every move gets a fresh budget.
+				g.Timers.TimeRemaining[pidx] = int(g.GameReq.InitialTimeSeconds) * 1000
+			} else {
+				g.Timers.TimeRemaining[pidx] += (int(g.GameReq.IncrementSeconds) * 1000)
+			}
 		}
 		g.Timers.TimeOfLastUpdate = now
 		log.Debug().Int("actual-remaining", g.Timers.TimeRemaining[pidx]).
 			Msg("player-on-turn")
 	}
-	// Otherwise, the player is not on turn, so their time should not
-	// have changed. Do nothing.
+	// Otherwise, the player is not on turn (or the clocks are frozen), so
+	// their time should not have changed. Do nothing.
 
 }
 
@@ -219,6 +300,11 @@ func (g *Game) RatingMode() pb.RatingMode {
 	return g.GameReq.RatingMode
 }
 
+// IsCorrespondence returns whether this is a correspondence game.
+func (g *Game) IsCorrespondence() bool {
+	return g.GameReq.GameMode == pb.GameMode_CORRESPONDENCE
+}
+
 func (g *Game) CreationRequest() *pb.GameRequest {
 	return g.GameReq
 }
@@ -138,3 +138,31 @@ func TestTimeCalcWithMultipleSleepIncrement(t *testing.T) {
 	is.Equal(g.TimeRemaining(0), 10000-2233-755+5000)
 	is.Equal(g.TimeRemaining(1), 10000-1520-1122+5000+5000)
 }
+
+func TestTimeCalcCorrespondence(t *testing.T) {
+	is := is.New(t)
+
+	mcg := newMacondoGame()
+	g := NewGame(mcg, &pb.GameRequest{InitialTimeSeconds: 3 * SecondsPerDay,
+		GameMode: pb.GameMode_CORRESPONDENCE})
+	nower := NewFakeNower(1234)
+	g.SetTimerModule(nower)
+
+	g.ResetTimersAndStart()
+	// Player 1 takes two days to move, which resets their budget.
+	g.SetPlayerOnTurn(1)
+	nower.Sleep(2 * SecondsPerDay * 1000)
+	g.RecordTimeOfMove(1)
+
+	g.SetPlayerOnTurn(0)
+	nower.Sleep(5000)
+	now := nower.Now()
+	g.calculateAndSetTimeRemaining(0, now, false)
+
+	is.Equal(g.TimeRemaining(0), 3*SecondsPerDay*1000-5000)
+	is.Equal(g.TimeRemaining(1), 3*SecondsPerDay*1000)
+	is.True(!g.TimeRanOut(0))
+
+	nower.Sleep(3 * SecondsPerDay * 1000)
+	is.True(g.TimeRanOut(0))
+}
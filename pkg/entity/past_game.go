@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// PastGamesQuery is a search of a user's finished games. Empty fields don't
+// filter anything.
+type PastGamesQuery struct {
+	UserID string
+	// OpponentID is the UUID of the opponent.
+	OpponentID string
+	Lexicon    string
+	VariantKey VariantKey
+	// RatingMode is nil to list both rated and casual games.
+	RatingMode *pb.RatingMode
+	// Games that ended for any of the ExcludeEndReasons aren't listed.
+	ExcludeEndReasons []pb.GameEndReason
+	// The games must have ended at or after After, and before Before.
+	After  time.Time
+	Before time.Time
+
+	Limit  int
+	Offset int
+}
+
+// A PastGame is a finished game, from the point of view of one of its
+// players.
+type PastGame struct {
+	GameID        string
+	Opponent      string
+	Score         int
+	OpponentScore int
+	// Won and Lost are both false if the game was a tie.
+	Won           bool
+	Lost          bool
+	GameEndReason pb.GameEndReason
+	Lexicon       string
+	VariantKey    VariantKey
+	Rated         bool
+	RatingDelta   int
+	EndedAt       time.Time
+}
+
+// HeadToHead is the record of a user against one opponent, from the user's
+// point of view. Aborted games don't count.
+type HeadToHead struct {
+	Games  int
+	Wins   int
+	Losses int
+	Draws  int
+	// Spread is the user's total spread over all of the games.
+	Spread int
+	// Stats are the stats of each game that has them.
+	Stats []*Stats
+}
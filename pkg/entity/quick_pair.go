@@ -0,0 +1,27 @@
+package entity
+
+import (
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// A QuickPairEntrant is a user waiting to be quick-paired. Entrants are only
+// paired with others in the same pool, which is made of everyone who asked
+// for the same kind of game.
+type QuickPairEntrant struct {
+	UserID string
+	ConnID string
+	Pool   string
+	// GameRequest is the game the entrant asked for, without a request ID.
+	GameRequest *pb.GameRequest
+	// Rating is the entrant's rating. They'd like their opponent to be
+	// rated between MinRating and MaxRating.
+	Rating    int
+	MinRating int
+	MaxRating int
+	// Seq goes up every time anyone joins a pool, so entrants joined in the
+	// order of their Seq. It's set by the store.
+	Seq int64
+	// Misses is how many times the entrant could have been paired, but
+	// wasn't.
+	Misses int
+}
@@ -9,6 +9,14 @@ type SingleRating struct {
 	LastGameTimestamp int64 `json:"ts"`
 }
 
+// A LeaderboardEntry is a user's place on the leaderboard of a variant.
+type LeaderboardEntry struct {
+	Rank     int
+	UUID     string
+	Username string
+	Rating   SingleRating
+}
+
 // Ratings gets stored into a PostgreSQL database.
 type Ratings struct {
 	Data map[VariantKey]SingleRating
@@ -18,8 +18,9 @@ type ListDatum struct {
 	MistakeSize int `json:"z,omitempty"`
 
 	// Used for ratings:
-	Rating  int    `json:"r,omitempty"`
-	Variant string `json:"v,omitempty"`
+	Rating          int    `json:"r,omitempty"`
+	RatingDeviation int    `json:"d,omitempty"`
+	Variant         string `json:"v,omitempty"`
 }
 
 type ListItem struct {
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"sync"
+
+	"github.com/lithammer/shortuuid"
+
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	tpb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// TournamentPlayer is a player registered in a tournament.
+type TournamentPlayer struct {
+	UserID   string `json:"id"`
+	Username string `json:"u"`
+}
+
+// A TournamentPairing is a single game in a round of a tournament. If the
+// second player is blank, the first player has a bye.
+type TournamentPairing struct {
+	Players [2]string `json:"p"`
+	GameID  string    `json:"g"`
+	Scores  [2]int    `json:"s"`
+	// Winner is 0 or 1 for the winning player, or -1 for a tie.
+	Winner    int              `json:"w"`
+	Done      bool             `json:"d"`
+	EndReason pb.GameEndReason `json:"e"`
+}
+
+// IsBye returns whether this pairing is a bye.
+func (p *TournamentPairing) IsBye() bool {
+	return p.Players[1] == ""
+}
+
+// A Tournament is run by a director, over a fixed number of rounds. Every
+// round pairs up all of its registered players.
+type Tournament struct {
+	sync.RWMutex
+
+	UUID          string
+	Name          string
+	DirectorID    string
+	GameReq       *pb.GameRequest
+	NumRounds     int
+	PairingMethod tpb.PairingMethod
+
+	Players []*TournamentPlayer
+	Rounds  [][]*TournamentPairing
+	// CurrentRound is zero-indexed, and is -1 before the first round starts.
+	CurrentRound int
+}
+
+// NewTournament creates a new tournament that hasn't started yet.
+func NewTournament(name, directorID string, req *pb.GameRequest, numRounds int,
+	method tpb.PairingMethod) *Tournament {
+
+	return &Tournament{
+		UUID:          shortuuid.New(),
+		Name:          name,
+		DirectorID:    directorID,
+		GameReq:       req,
+		NumRounds:     numRounds,
+		PairingMethod: method,
+		Players:       []*TournamentPlayer{},
+		Rounds:        [][]*TournamentPairing{},
+		CurrentRound:  -1,
+	}
+}
+
+// Started returns whether the first round has started.
+func (t *Tournament) Started() bool {
+	return t.CurrentRound >= 0
+}
+
+// Player returns the registered player with the given user ID, or nil.
+func (t *Tournament) Player(userID string) *TournamentPlayer {
+	for _, p := range t.Players {
+		if p.UserID == userID {
+			return p
+		}
+	}
+	return nil
+}
+
+// RoundDone returns whether every game in the current round is over. It
+// is trivially true before the tournament starts.
+func (t *Tournament) RoundDone() bool {
+	if !t.Started() {
+		return true
+	}
+	for _, p := range t.Rounds[t.CurrentRound] {
+		if !p.Done {
+			return false
+		}
+	}
+	return true
+}
+
+// UnstartedPairings returns the games of the current round that haven't
+// started yet, because something went wrong when the round started.
+func (t *Tournament) UnstartedPairings() []*TournamentPairing {
+	unstarted := []*TournamentPairing{}
+	if !t.Started() {
+		return unstarted
+	}
+	for _, p := range t.Rounds[t.CurrentRound] {
+		if !p.IsBye() && !p.Done && p.GameID == "" {
+			unstarted = append(unstarted, p)
+		}
+	}
+	return unstarted
+}
+
+// PairingForGame returns the pairing that was played with the given game ID,
+// or nil if there is none.
+func (t *Tournament) PairingForGame(gameID string) *TournamentPairing {
+	for _, round := range t.Rounds {
+		for _, p := range round {
+			if p.GameID == gameID {
+				return p
+			}
+		}
+	}
+	return nil
+}
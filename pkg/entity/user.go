@@ -29,6 +29,8 @@ type User struct {
 	// CurrentChannel tracks presence; where is the user currently?
 	CurrentChannel string
 	IsBot          bool
+	// BotTier is the difficulty tier of a bot; higher is harder.
+	BotTier int
 }
 
 // Session - The db specific-details are in the store package.
@@ -13,9 +13,9 @@ type TimeControl string
 
 const (
 	VarClassic   Variant = "classic"
-	VarAWorth100         = "a-is-worth-100"
-	VarDogworms          = "dogworms" // OMGWords scrambled = dogworms?
-	VarSuper             = "superomg"
+	VarAWorth100 Variant = "a-is-worth-100"
+	VarDogworms  Variant = "dogworms" // OMGWords scrambled = dogworms?
+	VarSuper     Variant = "superomg"
 )
 
 const (
@@ -33,6 +33,15 @@ const (
 	CutoffRapid      = 14 * 60
 )
 
+const (
+	// Correspondence games give each player a budget of whole days for
+	// every move. The budget is stored in the request's InitialTimeSeconds,
+	// and is reset after every move.
+	CorresMinDaysPerMove = 1
+	CorresMaxDaysPerMove = 14
+	SecondsPerDay        = 24 * 60 * 60
+)
+
 // Calculate "total" time assuming there are 16 turns in a game per player.
 const turnsPerGame = 16 // just an estimate.
 
@@ -49,7 +58,9 @@ func VariantFromGameReq(gamereq *pb.GameRequest) (TimeControl, Variant, error) {
 
 	totalTime := TotalTimeEstimate(gamereq)
 
-	if totalTime <= CutoffUltraBlitz {
+	if gamereq.GameMode == pb.GameMode_CORRESPONDENCE {
+		timefmt = TCCorres
+	} else if totalTime <= CutoffUltraBlitz {
 		timefmt = TCUltraBlitz
 	} else if totalTime <= CutoffBlitz {
 		timefmt = TCBlitz
@@ -58,12 +69,37 @@ func VariantFromGameReq(gamereq *pb.GameRequest) (TimeControl, Variant, error) {
 	} else {
 		timefmt = TCRegular
 	}
+	variant, err := VariantFromRules(gamereq.Rules)
+	if err != nil {
+		return "", "", err
+	}
+
+	return timefmt, variant, nil
+}
+
+// VariantFromRules returns the variant the rules are for. An empty variant
+// name is the classic game. Super OMGWords is played on the super board,
+// and every other variant on the regular one.
+func VariantFromRules(rules *pb.GameRules) (Variant, error) {
+	if rules == nil {
+		return "", errors.New("no rules")
+	}
 	var variant Variant
-	if gamereq.Rules.BoardLayoutName == CrosswordGame {
+	switch Variant(rules.VariantName) {
+	case "", VarClassic:
 		variant = VarClassic
-	} else {
-		return "", "", errors.New("unsupported game type")
+	case VarAWorth100, VarDogworms, VarSuper:
+		variant = Variant(rules.VariantName)
+	default:
+		return "", errors.New("unsupported variant")
 	}
 
-	return timefmt, variant, nil
+	board := CrosswordGame
+	if variant == VarSuper {
+		board = SuperCrosswordGame
+	}
+	if rules.BoardLayoutName != board {
+		return "", errors.New("unsupported game type")
+	}
+	return variant, nil
 }
@@ -0,0 +1,280 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/config"
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stats"
+	"github.com/domino14/liwords/pkg/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+// AbandonPolicy determines what happens to a game whose player on turn has
+// left it for longer than the grace period.
+type AbandonPolicy string
+
+const (
+	// AbandonEndRated ends the game, and rates it (if it was a rated game)
+	// as a loss by the maximum spread for the player who left.
+	AbandonEndRated AbandonPolicy = "rated"
+	// AbandonEndUnrated ends the game without rating it.
+	AbandonEndUnrated AbandonPolicy = "unrated"
+	// AbandonWarnOnly only warns the opponent; the game is left alone.
+	AbandonWarnOnly AbandonPolicy = "warn"
+)
+
+var errUnknownAbandonPolicy = errors.New("unknown abandon policy")
+
+type skipRatingCtxKey struct{}
+
+func parseAbandonPolicy(p string) (AbandonPolicy, error) {
+	switch AbandonPolicy(p) {
+	case AbandonEndRated, AbandonEndUnrated, AbandonWarnOnly:
+		return AbandonPolicy(p), nil
+	}
+	return "", fmt.Errorf("%w: %v", errUnknownAbandonPolicy, p)
+}
+
+// AbandonPoliciesFromConfig returns the abandon policy for every rating mode.
+func AbandonPoliciesFromConfig(cfg *config.Config) (map[pb.RatingMode]AbandonPolicy, error) {
+	rated, err := parseAbandonPolicy(cfg.AbandonPolicyRated)
+	if err != nil {
+		return nil, err
+	}
+	casual, err := parseAbandonPolicy(cfg.AbandonPolicyCasual)
+	if err != nil {
+		return nil, err
+	}
+	return map[pb.RatingMode]AbandonPolicy{
+		pb.RatingMode_RATED:  rated,
+		pb.RatingMode_CASUAL: casual,
+	}, nil
+}
+
+// absence keeps track of when a player on turn left their game.
+type absence struct {
+	userID string
+	since  int64
+}
+
+// An AbandonWatcher notices when the player on turn in a game has had no
+// presence in that game's channel for longer than a grace period. It warns
+// their opponent as soon as they leave, and ends the game as abandoned
+// (according to the game's AbandonPolicy) once the grace period is over.
+type AbandonWatcher struct {
+	sync.Mutex
+	absences map[string]absence
+	// checking is set while a check is in progress.
+	checking bool
+
+	// grace is in milliseconds.
+	grace    int64
+	policies map[pb.RatingMode]AbandonPolicy
+
+	nower         entity.Nower
+	gameStore     GameStore
+	userStore     user.Store
+	presenceStore user.PresenceStore
+	listStatStore stats.ListStatStore
+	// owner, if set, limits the watcher to the games this node owns.
+	owner GameOwner
+}
+
+// NewAbandonWatcher creates a new AbandonWatcher. The grace period is in
+// milliseconds.
+func NewAbandonWatcher(gameStore GameStore, userStore user.Store,
+	presenceStore user.PresenceStore, listStatStore stats.ListStatStore,
+	grace int64, policies map[pb.RatingMode]AbandonPolicy, nower entity.Nower) *AbandonWatcher {
+
+	return &AbandonWatcher{
+		absences:      make(map[string]absence),
+		grace:         grace,
+		policies:      policies,
+		nower:         nower,
+		gameStore:     gameStore,
+		userStore:     userStore,
+		presenceStore: presenceStore,
+		listStatStore: listStatStore,
+	}
+}
+
+// SetOwner limits the watcher to the games that the given owner owns.
+func (w *AbandonWatcher) SetOwner(o GameOwner) {
+	w.owner = o
+}
+
+// Check goes through all the active games, and warns about or ends the ones
+// whose player on turn is not around. It returns the IDs of the games that
+// it ended.
+func (w *AbandonWatcher) Check(ctx context.Context) ([]string, error) {
+	w.Lock()
+	if w.checking {
+		w.Unlock()
+		return nil, nil
+	}
+	w.checking = true
+	w.Unlock()
+	defer func() {
+		w.Lock()
+		w.checking = false
+		w.Unlock()
+	}()
+
+	gs, err := w.gameStore.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[string]bool)
+	ended := []string{}
+	for _, gm := range gs {
+		if gm.GameRequest.GameMode == pb.GameMode_CORRESPONDENCE {
+			// Nobody is expected to sit and wait in these.
+			continue
+		}
+		if w.owner != nil && !w.owner.Holds(gm.Id) {
+			// Another node watches this one. If that node died, whichever
+			// node adopts the game (see Adjudicator.Rebuild) takes over.
+			continue
+		}
+		active[gm.Id] = true
+		done, err := w.checkGame(ctx, gm.Id)
+		if err != nil {
+			log.Err(err).Str("gameID", gm.Id).Msg("abandon-check")
+			continue
+		}
+		if done {
+			ended = append(ended, gm.Id)
+		}
+	}
+	// Forget about games that are no longer active.
+	w.Lock()
+	for id := range w.absences {
+		if !active[id] {
+			delete(w.absences, id)
+		}
+	}
+	w.Unlock()
+	return ended, nil
+}
+
+func (w *AbandonWatcher) checkGame(ctx context.Context, gameID string) (bool, error) {
+	entGame, err := w.gameStore.Get(ctx, gameID)
+	if err != nil {
+		return false, err
+	}
+	entGame.RLock()
+	if !entGame.Started || entGame.Playing() == macondopb.PlayState_GAME_OVER {
+		entGame.RUnlock()
+		w.forget(gameID)
+		return false, nil
+	}
+	onTurnID := entGame.PlayerIDOnTurn()
+	onTurnNick := entGame.NickOnTurn()
+	vsBot := entGame.GameReq.PlayerVsBot
+	ratingMode := entGame.RatingMode()
+	entGame.RUnlock()
+
+	u, err := w.userStore.GetByUUID(ctx, onTurnID)
+	if err != nil {
+		return false, err
+	}
+	if u.IsBot && vsBot {
+		// Bots don't leave.
+		w.forget(gameID)
+		return false, nil
+	}
+	channel, err := w.presenceStore.GetPresence(ctx, onTurnID)
+	if err != nil {
+		return false, err
+	}
+	now := w.nower.Now()
+
+	w.Lock()
+	prev, wasAbsent := w.absences[gameID]
+	if channel == "game."+gameID {
+		delete(w.absences, gameID)
+		w.Unlock()
+		if wasAbsent && prev.userID == onTurnID {
+			w.notifyOpponent(entGame, onTurnID, onTurnNick+" has returned to the game.")
+		}
+		return false, nil
+	}
+	if !wasAbsent || prev.userID != onTurnID {
+		// They just left (or the turn changed while their opponent was
+		// away, and now it's the opponent that's missing).
+		w.absences[gameID] = absence{userID: onTurnID, since: now}
+		w.Unlock()
+		msg := onTurnNick + " has left the game."
+		if w.policies[ratingMode] != AbandonWarnOnly {
+			msg += fmt.Sprintf(" If they do not return within %d seconds, the game will end"+
+				" and you will be awarded the win.", w.grace/1000)
+		}
+		w.notifyOpponent(entGame, onTurnID, msg)
+		return false, nil
+	}
+	w.Unlock()
+	if now-prev.since < w.grace || w.policies[ratingMode] == AbandonWarnOnly {
+		return false, nil
+	}
+	err = w.setAbandoned(ctx, entGame, onTurnID)
+	if err != nil {
+		return false, err
+	}
+	w.forget(gameID)
+	return true, nil
+}
+
+func (w *AbandonWatcher) forget(gameID string) {
+	w.Lock()
+	defer w.Unlock()
+	delete(w.absences, gameID)
+}
+
+func (w *AbandonWatcher) notifyOpponent(entGame *entity.Game, absentID, message string) {
+	entGame.RLock()
+	defer entGame.RUnlock()
+	evt := entity.WrapEvent(&pb.ServerMessage{Message: message}, pb.MessageType_SERVER_MESSAGE)
+	for _, p := range players(entGame) {
+		if p != absentID {
+			evt.AddAudience(entity.AudUser, p+".game."+entGame.GameID())
+		}
+	}
+	entGame.SendChange(evt)
+}
+
+// setAbandoned ends the game with the ABANDONED end reason. The given user
+// must still be on turn.
+func (w *AbandonWatcher) setAbandoned(ctx context.Context, entGame *entity.Game, userID string) error {
+	entGame.Lock()
+	defer entGame.Unlock()
+	if entGame.Playing() == macondopb.PlayState_GAME_OVER {
+		return nil
+	}
+	if entGame.PlayerIDOnTurn() != userID {
+		return errNotOnTurn
+	}
+	pidx := entGame.PlayerOnTurn()
+	log.Info().Str("gameID", entGame.GameID()).Str("userID", userID).Msg("game-abandoned")
+
+	if w.policies[entGame.RatingMode()] == AbandonEndUnrated {
+		ctx = context.WithValue(ctx, skipRatingCtxKey{}, true)
+	}
+	entGame.Game.SetPlaying(macondopb.PlayState_GAME_OVER)
+	entGame.SetGameEndReason(pb.GameEndReason_ABANDONED)
+	entGame.SetWinnerIdx(1 - pidx)
+	entGame.SetLoserIdx(pidx)
+	// The game is saved as it ends.
+	err := performEndgameDuties(ctx, entGame, w.gameStore, w.listStatStore)
+	if err != nil {
+		return err
+	}
+	w.gameStore.Unload(ctx, entGame.GameID())
+	return nil
+}
@@ -0,0 +1,264 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/config"
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/stats"
+	"github.com/domino14/liwords/pkg/stores/user"
+	pkguser "github.com/domino14/liwords/pkg/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+func TestAbandonPoliciesFromConfig(t *testing.T) {
+	is := is.New(t)
+	cfg := &config.Config{}
+	is.NoErr(cfg.Load([]string{}))
+
+	policies, err := AbandonPoliciesFromConfig(cfg)
+	is.NoErr(err)
+	is.Equal(policies[pb.RatingMode_RATED], AbandonEndRated)
+	is.Equal(policies[pb.RatingMode_CASUAL], AbandonEndUnrated)
+
+	cfg.AbandonPolicyCasual = "warn"
+	policies, err = AbandonPoliciesFromConfig(cfg)
+	is.NoErr(err)
+	is.Equal(policies[pb.RatingMode_CASUAL], AbandonWarnOnly)
+
+	cfg.AbandonPolicyRated = "forfeit"
+	_, err = AbandonPoliciesFromConfig(cfg)
+	is.True(errors.Is(err, errUnknownAbandonPolicy))
+}
+
+// fakePresenceStore only knows which channel every user is in.
+type fakePresenceStore struct {
+	pkguser.PresenceStore
+	channels map[string]string
+}
+
+func (f *fakePresenceStore) GetPresence(ctx context.Context, uuid string) (string, error) {
+	return f.channels[uuid], nil
+}
+
+const abandonGrace = 60000
+
+var abandonPolicies = map[pb.RatingMode]AbandonPolicy{
+	pb.RatingMode_RATED:  AbandonEndRated,
+	pb.RatingMode_CASUAL: AbandonWarnOnly,
+}
+
+// serverMessages returns the server messages that were sent to the given
+// user about the game.
+func serverMessages(consumer *evtConsumer, userID, gameID string) []string {
+	msgs := []string{}
+	for _, evt := range consumer.evts {
+		if evt.Type != pb.MessageType_SERVER_MESSAGE {
+			continue
+		}
+		aud := evt.Audience()
+		if len(aud) == 1 && aud[0] == string(entity.AudUser)+"."+userID+".game."+gameID {
+			msgs = append(msgs, evt.Event.(*pb.ServerMessage).Message)
+		}
+	}
+	return msgs
+}
+
+func TestAbandonWatcherEndsAbandonedGame(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, consumer := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	id := g.GameID()
+	jesse, cesar := "3xpEkpRAy3AizbVmDg3kdi", "xjCWug7EZtDxDHX5fRZTLo"
+	presence := &fakePresenceStore{channels: map[string]string{cesar: "game." + id}}
+
+	w := NewAbandonWatcher(gstore, ustore, presence, lstore, abandonGrace,
+		abandonPolicies, nower)
+	// jesse is on turn, and isn't in the game.
+	ended, err := w.Check(ctx)
+	is.NoErr(err)
+	is.Equal(len(ended), 0)
+
+	nower.Sleep(abandonGrace - 1)
+	ended, err = w.Check(ctx)
+	is.NoErr(err)
+	is.Equal(len(ended), 0)
+	is.Equal(g.GameEndReason, pb.GameEndReason_NONE)
+
+	nower.Sleep(1)
+	ended, err = w.Check(ctx)
+	is.NoErr(err)
+	is.Equal(ended, []string{id})
+	is.Equal(g.GameEndReason, pb.GameEndReason_ABANDONED)
+	is.Equal(g.Playing(), macondopb.PlayState_GAME_OVER)
+	is.Equal(g.WinnerIdx, 0)
+	// The game was rated, with jesse losing by the maximum spread.
+	is.True(!g.Unrated)
+	is.True(g.RatingDeltas[0] > 0)
+	is.True(g.RatingDeltas[1] < 0)
+
+	// Nothing else happens to it.
+	nower.Sleep(abandonGrace)
+	ended, err = w.Check(ctx)
+	is.NoErr(err)
+	is.Equal(len(ended), 0)
+
+	cancel()
+	<-donechan
+	is.Equal(serverMessages(consumer, cesar, id), []string{
+		"jesse has left the game. If they do not return within 60 seconds, the game" +
+			" will end and you will be awarded the win."})
+	is.Equal(len(serverMessages(consumer, jesse, id)), 0)
+
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestAbandonWatcherForgetsPlayerWhoReturns(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, consumer := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	id := g.GameID()
+	jesse, cesar := "3xpEkpRAy3AizbVmDg3kdi", "xjCWug7EZtDxDHX5fRZTLo"
+	presence := &fakePresenceStore{channels: map[string]string{cesar: "game." + id}}
+
+	w := NewAbandonWatcher(gstore, ustore, presence, lstore, abandonGrace,
+		abandonPolicies, nower)
+	_, err := w.Check(ctx)
+	is.NoErr(err)
+
+	// jesse comes back before the grace period is over, then leaves again.
+	nower.Sleep(abandonGrace / 2)
+	presence.channels[jesse] = "game." + id
+	ended, err := w.Check(ctx)
+	is.NoErr(err)
+	is.Equal(len(ended), 0)
+	nower.Sleep(10000)
+	presence.channels[jesse] = "lobby"
+	_, err = w.Check(ctx)
+	is.NoErr(err)
+
+	// The grace period starts over from when they left again.
+	nower.Sleep(abandonGrace - 1)
+	ended, err = w.Check(ctx)
+	is.NoErr(err)
+	is.Equal(len(ended), 0)
+	is.Equal(g.GameEndReason, pb.GameEndReason_NONE)
+	nower.Sleep(1)
+	ended, err = w.Check(ctx)
+	is.NoErr(err)
+	is.Equal(ended, []string{id})
+	is.Equal(g.GameEndReason, pb.GameEndReason_ABANDONED)
+
+	cancel()
+	<-donechan
+	left := "jesse has left the game. If they do not return within 60 seconds, the game" +
+		" will end and you will be awarded the win."
+	is.Equal(serverMessages(consumer, cesar, id), []string{
+		left, "jesse has returned to the game.", left})
+
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestAbandonWatcherUnratedPolicy(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	id := g.GameID()
+	variant, err := g.RatingKey()
+	is.NoErr(err)
+
+	w := NewAbandonWatcher(gstore, ustore, &fakePresenceStore{}, lstore, abandonGrace,
+		map[pb.RatingMode]AbandonPolicy{pb.RatingMode_RATED: AbandonEndUnrated}, nower)
+	_, err = w.Check(ctx)
+	is.NoErr(err)
+	nower.Sleep(abandonGrace)
+	ended, err := w.Check(ctx)
+	is.NoErr(err)
+	is.Equal(ended, []string{id})
+	is.Equal(g.GameEndReason, pb.GameEndReason_ABANDONED)
+
+	// The rated game ended, but nobody's rating changed.
+	is.True(g.Unrated)
+	is.Equal(g.RatingDeltas, [2]int{})
+	for _, username := range []string{"jesse", "cesar4"} {
+		u, err := ustore.Get(ctx, username)
+		is.NoErr(err)
+		_, ok := u.Profile.Ratings.Data[variant]
+		is.True(!ok)
+	}
+	saved, err := gstore.Get(ctx, id)
+	is.NoErr(err)
+	is.True(saved.Unrated)
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestAbandonWatcherWarnOnlyPolicy(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, consumer := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	id := g.GameID()
+	g.GameReq.RatingMode = pb.RatingMode_CASUAL
+
+	w := NewAbandonWatcher(gstore, ustore, &fakePresenceStore{}, lstore, abandonGrace,
+		abandonPolicies, nower)
+	for i := 0; i < 3; i++ {
+		ended, err := w.Check(ctx)
+		is.NoErr(err)
+		is.Equal(len(ended), 0)
+		nower.Sleep(abandonGrace)
+	}
+	is.Equal(g.GameEndReason, pb.GameEndReason_NONE)
+
+	cancel()
+	<-donechan
+	// The opponent is only warned once.
+	is.Equal(serverMessages(consumer, "xjCWug7EZtDxDHX5fRZTLo", id),
+		[]string{"jesse has left the game."})
+
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
@@ -0,0 +1,70 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stats"
+	"github.com/domino14/liwords/pkg/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+var (
+	errCantAbort           = errors.New("a game can only be aborted until both players have made a move")
+	errCantAbortTournament = errors.New("tournament games can't be aborted")
+	errGameStarted         = errors.New("game has already started")
+)
+
+// abortable returns whether the game can still be aborted by one of its
+// players, which it can until both of them have made a move.
+func abortable(entGame *entity.Game) bool {
+	moved := map[string]bool{}
+	for _, evt := range entGame.History().Events {
+		moved[evt.Nickname] = true
+	}
+	return len(moved) < 2
+}
+
+// setAborted ends the game with the ABORTED end reason. Nobody wins, and the
+// game is neither rated nor counted in anyone's stats. The caller must hold
+// the game's lock.
+func setAborted(ctx context.Context, entGame *entity.Game, gameStore GameStore,
+	userStore user.Store, listStatStore stats.ListStatStore) error {
+
+	log.Info().Str("gameID", entGame.GameID()).Msg("game-aborted")
+	entGame.Game.SetPlaying(macondopb.PlayState_GAME_OVER)
+	entGame.SetGameEndReason(pb.GameEndReason_ABORTED)
+	entGame.SetWinnerIdx(-1)
+	entGame.SetLoserIdx(-1)
+	// The game is saved as it ends.
+	err := performEndgameDuties(ctx, entGame, gameStore, listStatStore)
+	if err != nil {
+		return err
+	}
+	gameStore.Unload(ctx, entGame.GameID())
+	return nil
+}
+
+// AbortUnstarted aborts a game whose players never both got ready for it. It
+// returns errGameStarted if the game started after all.
+func AbortUnstarted(ctx context.Context, gameStore GameStore, userStore user.Store,
+	listStatStore stats.ListStatStore, gameID string) error {
+
+	entGame, err := gameStore.Get(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	entGame.Lock()
+	defer entGame.Unlock()
+	if entGame.Game.Playing() == macondopb.PlayState_GAME_OVER {
+		return nil
+	}
+	if entGame.Started {
+		return errGameStarted
+	}
+	return setAborted(ctx, entGame, gameStore, userStore, listStatStore)
+}
@@ -0,0 +1,156 @@
+package gameplay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/stats"
+	"github.com/domino14/liwords/pkg/stores/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+func TestAbortBeforeBothMoved(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, _, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	jesse, cesar := "3xpEkpRAy3AizbVmDg3kdi", "xjCWug7EZtDxDHX5fRZTLo"
+
+	// jesse moves first; cesar, who hasn't, can still abort.
+	_, err := HandleEvent(ctx, gstore, ustore, lstore, jesse,
+		&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_PASS, GameId: g.GameID()})
+	is.NoErr(err)
+	_, err = HandleEvent(ctx, gstore, ustore, lstore, cesar,
+		&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_ABORT, GameId: g.GameID()})
+	is.NoErr(err)
+	is.Equal(g.GameEndReason, pb.GameEndReason_ABORTED)
+	is.Equal(g.Playing(), macondopb.PlayState_GAME_OVER)
+	is.Equal(g.WinnerIdx, -1)
+
+	// Nobody's rating changed.
+	u, err := ustore.GetByUUID(ctx, cesar)
+	is.NoErr(err)
+	is.Equal(u.GetRelevantRating(variantKey(g.GameReq)), "1500?")
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestCantAbortAfterBothMoved(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, _, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	jesse, cesar := "3xpEkpRAy3AizbVmDg3kdi", "xjCWug7EZtDxDHX5fRZTLo"
+
+	for _, p := range []string{jesse, cesar} {
+		_, err := HandleEvent(ctx, gstore, ustore, lstore, p,
+			&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_PASS, GameId: g.GameID()})
+		is.NoErr(err)
+	}
+	_, err := HandleEvent(ctx, gstore, ustore, lstore, jesse,
+		&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_ABORT, GameId: g.GameID()})
+	is.Equal(err, errCantAbort)
+	is.Equal(g.GameEndReason, pb.GameEndReason_NONE)
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestCantAbortTournamentGame(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, _, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	g.GameReq.TournamentId = "test-tournament"
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	jesse := "3xpEkpRAy3AizbVmDg3kdi"
+
+	_, err := HandleEvent(ctx, gstore, ustore, lstore, jesse,
+		&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_ABORT, GameId: g.GameID()})
+	is.Equal(err, errCantAbortTournament)
+	is.Equal(g.GameEndReason, pb.GameEndReason_NONE)
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestAdjudicatorAbortsUnreadyGame(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+
+	cesar, _ := ustore.Get(ctx, "cesar4")
+	jesse, _ := ustore.Get(ctx, "jesse")
+	g, err := InstantiateNewGame(ctx, gstore, cfg, [2]*entity.User{cesar, jesse},
+		1, proto.Clone(gameReq).(*pb.GameRequest))
+	is.NoErr(err)
+	ch := make(chan *entity.EventWrapper)
+	donechan := make(chan bool)
+	cctx, cancel := context.WithCancel(ctx)
+	go (&evtConsumer{}).consumeEventChan(cctx, ch, donechan)
+	is.NoErr(g.RegisterChangeHook(ch))
+
+	nower := entity.NewFakeNower(1234)
+	adj := NewAdjudicator(gstore, ustore, lstore, nower)
+	adj.SetReadyTimeout(60000)
+	adj.Track(g)
+	deadline, ok := adj.Deadline(g.GameID())
+	is.True(ok)
+	is.Equal(deadline, int64(1234+60000))
+
+	// Tracking it again doesn't push the deadline back.
+	nower.Sleep(30000)
+	adj.Track(g)
+	deadline, _ = adj.Deadline(g.GameID())
+	is.Equal(deadline, int64(1234+60000))
+
+	nower.Sleep(30001)
+	is.Equal(adj.Adjudicate(ctx), []string{g.GameID()})
+	is.Equal(g.GameEndReason, pb.GameEndReason_ABORTED)
+	_, ok = adj.Deadline(g.GameID())
+	is.True(!ok)
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
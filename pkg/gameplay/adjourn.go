@@ -0,0 +1,184 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+var (
+	errGameAdjourned    = errors.New("game is adjourned")
+	errGameNotAdjourned = errors.New("game is not adjourned")
+	errCantAdjourn      = errors.New("only live games between two people can be adjourned")
+	errNoAdjournOffer   = errors.New("your opponent has not offered to adjourn the game")
+	errNotInGame        = errors.New("you are not playing in this game")
+)
+
+// playerIndex returns the index of the user in the game, or -1 if they are
+// not playing in it.
+func playerIndex(entGame *entity.Game, userID string) int {
+	for idx, p := range players(entGame) {
+		if p == userID {
+			return idx
+		}
+	}
+	return -1
+}
+
+// OfferAdjournment proposes to adjourn a live game, on behalf of one of its
+// players. Their opponent has to accept before anything changes.
+func OfferAdjournment(ctx context.Context, gameStore GameStore, userID, gameID string) error {
+	entGame, err := gameStore.Get(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	entGame.Lock()
+	defer entGame.Unlock()
+	if entGame.Playing() == macondopb.PlayState_GAME_OVER {
+		return errGameNotActive
+	}
+	if entGame.Adjourned {
+		return errGameAdjourned
+	}
+	if !entGame.Started || entGame.IsCorrespondence() || entGame.GameReq.PlayerVsBot {
+		return errCantAdjourn
+	}
+	pidx := playerIndex(entGame, userID)
+	if pidx == -1 {
+		return errNotInGame
+	}
+	entGame.AdjournOfferedBy = userID
+	log.Debug().Str("gameID", gameID).Str("userID", userID).Msg("adjourn-offered")
+
+	evt := entity.WrapEvent(&pb.AdjournOffer{GameId: gameID}, pb.MessageType_ADJOURN_OFFER)
+	evt.AddAudience(entity.AudUser, players(entGame)[1-pidx]+".game."+gameID)
+	entGame.SendChange(evt)
+	return nil
+}
+
+// RespondToAdjournment accepts or declines the opponent's offer to adjourn
+// the game. If the offer is accepted, both clocks are frozen, and the game is
+// saved as adjourned and unloaded from the store. The game is returned
+// unless there was an error loading it.
+func RespondToAdjournment(ctx context.Context, gameStore GameStore, userID, gameID string,
+	accept bool) (*entity.Game, error) {
+
+	entGame, err := gameStore.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	entGame.Lock()
+	defer entGame.Unlock()
+	if entGame.Playing() == macondopb.PlayState_GAME_OVER {
+		return entGame, errGameNotActive
+	}
+	if entGame.Adjourned {
+		return entGame, errGameAdjourned
+	}
+	pidx := playerIndex(entGame, userID)
+	if pidx == -1 {
+		return entGame, errNotInGame
+	}
+	offeredBy := entGame.AdjournOfferedBy
+	if offeredBy == "" || offeredBy == userID {
+		return entGame, errNoAdjournOffer
+	}
+
+	if !accept {
+		entGame.AdjournOfferedBy = ""
+		evt := entity.WrapEvent(&pb.AdjournResponse{GameId: gameID, Accept: false},
+			pb.MessageType_ADJOURN_RESPONSE)
+		evt.AddAudience(entity.AudUser, offeredBy+".game."+gameID)
+		entGame.SendChange(evt)
+		return entGame, nil
+	}
+
+	entGame.Adjourn()
+	err = gameStore.Set(ctx, entGame)
+	if err != nil {
+		return entGame, err
+	}
+	log.Info().Str("gameID", gameID).Msg("game-adjourned")
+
+	evt := entity.WrapEvent(&pb.GameAdjourned{
+		GameId:      gameID,
+		TimePlayer1: int32(entGame.CachedTimeRemaining(0)),
+		TimePlayer2: int32(entGame.CachedTimeRemaining(1)),
+	}, pb.MessageType_GAME_ADJOURNED)
+	evt.AddAudience(entity.AudGame, gameID)
+	evt.AddAudience(entity.AudGameTV, gameID)
+	entGame.SendChange(evt)
+	// Take it off the lobby's list of live games until it's resumed.
+	evt = entity.WrapEvent(&pb.GameDeletion{Id: gameID}, pb.MessageType_GAME_DELETION)
+	evt.AddAudience(entity.AudLobby, "gameAdjourned")
+	entGame.SendChange(evt)
+
+	// It may be a long time before anyone looks at this game again.
+	gameStore.Unload(ctx, gameID)
+	return entGame, nil
+}
+
+// OfferResumption proposes to resume an adjourned game, on behalf of one of
+// its players. They are taken to be ready to play; the clocks start again
+// once their opponent sends a ReadyForGame as well (see ResumeGame).
+func OfferResumption(ctx context.Context, gameStore GameStore, userID, gameID string) error {
+	entGame, err := gameStore.Get(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	entGame.Lock()
+	defer entGame.Unlock()
+	if !entGame.Adjourned {
+		return errGameNotAdjourned
+	}
+	pidx := playerIndex(entGame, userID)
+	if pidx == -1 {
+		return errNotInGame
+	}
+	entGame.ResumeOfferedBy = userID
+	entGame.PlayersReady = [2]bool{}
+	entGame.PlayersReady[pidx] = true
+	log.Debug().Str("gameID", gameID).Str("userID", userID).Msg("resume-offered")
+
+	// The opponent is most likely not looking at the game, so send this
+	// to wherever they are.
+	evt := entity.WrapEvent(&pb.ResumeOffer{GameId: gameID}, pb.MessageType_RESUME_OFFER)
+	evt.AddAudience(entity.AudUser, players(entGame)[1-pidx])
+	entGame.SendChange(evt)
+	return nil
+}
+
+// ResumeGame restarts the clocks of an adjourned game once both players are
+// ready. Like StartGame, it sends the game to both players.
+func ResumeGame(ctx context.Context, gameStore GameStore, eventChan chan<- *entity.EventWrapper, id string) error {
+	entGame, err := gameStore.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !entGame.Adjourned {
+		return errGameNotAdjourned
+	}
+	entGame.Resume()
+	if err := gameStore.Set(ctx, entGame); err != nil {
+		log.Err(err).Msg("error-saving")
+		return err
+	}
+	if err := entGame.RegisterChangeHook(eventChan); err != nil {
+		return err
+	}
+	log.Info().Str("gameID", id).Msg("game-resumed")
+
+	evt := entGame.HistoryRefresherEvent()
+	wrapped := entity.WrapEvent(evt, pb.MessageType_GAME_HISTORY_REFRESHER)
+	wrapped.AddAudience(entity.AudGameTV, entGame.GameID())
+	for _, p := range players(entGame) {
+		wrapped.AddAudience(entity.AudUser, p+".game."+id)
+	}
+	entGame.SendChange(wrapped)
+	return nil
+}
@@ -0,0 +1,78 @@
+package gameplay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/stats"
+	"github.com/domino14/liwords/pkg/stores/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+func TestAdjournAndResume(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	id := g.GameID()
+	jesse, cesar := "3xpEkpRAy3AizbVmDg3kdi", "xjCWug7EZtDxDHX5fRZTLo"
+
+	// Nobody can accept an offer that wasn't made.
+	_, err := RespondToAdjournment(ctx, gstore, cesar, id, true)
+	is.Equal(err, errNoAdjournOffer)
+
+	nower.Sleep(10000)
+	is.NoErr(OfferAdjournment(ctx, gstore, jesse, id))
+	// jesse can't accept their own offer.
+	_, err = RespondToAdjournment(ctx, gstore, jesse, id, true)
+	is.Equal(err, errNoAdjournOffer)
+	_, err = RespondToAdjournment(ctx, gstore, cesar, id, true)
+	is.NoErr(err)
+
+	// jesse was on turn; their clock stopped when the game was adjourned.
+	is.True(g.Adjourned)
+	is.Equal(g.CachedTimeRemaining(1), 25*60000-10000)
+	nower.Sleep(60000)
+	is.Equal(g.TimeRemaining(1), 25*60000-10000)
+	is.True(!g.TimeRanOut(1))
+
+	// It was saved as adjourned, and is no longer active.
+	saved, err := gstore.Get(ctx, id)
+	is.NoErr(err)
+	is.True(saved.Adjourned)
+	is.NoErr(saved.RegisterChangeHook(g.ChangeHook))
+	is.Equal(saved.CachedTimeRemaining(1), 25*60000-10000)
+	active, err := gstore.ListActive(ctx)
+	is.NoErr(err)
+	is.Equal(len(active), 0)
+
+	_, err = HandleEvent(ctx, gstore, ustore, lstore, jesse,
+		&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_PASS, GameId: id})
+	is.Equal(err, errGameAdjourned)
+
+	is.NoErr(OfferResumption(ctx, gstore, cesar, id))
+	is.Equal(saved.PlayersReady, [2]bool{true, false})
+	is.NoErr(ResumeGame(ctx, gstore, g.ChangeHook, id))
+	is.True(!saved.Adjourned)
+	is.Equal(saved.CachedTimeRemaining(1), 25*60000-10000)
+
+	// The game can go on now.
+	_, err = HandleEvent(ctx, gstore, ustore, lstore, jesse,
+		&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_PASS, GameId: id})
+	is.NoErr(err)
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
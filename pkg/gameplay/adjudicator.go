@@ -0,0 +1,238 @@
+package gameplay
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stats"
+	"github.com/domino14/liwords/pkg/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+// An Adjudicator keeps track of the flag-fall deadline of every game in
+// progress, and ends a game on time once its deadline has passed. We can't
+// rely on the clients for this; if both players close their tabs nobody
+// will ever tell us that the player on turn ran out of time. It also aborts
+// new games that their players never both get ready for.
+type Adjudicator struct {
+	sync.Mutex
+	// deadlines maps a game ID to the timestamp (in milliseconds) after which
+	// the player on turn in that game has run out of time, or, if the game
+	// hasn't started, after which it should be aborted.
+	deadlines map[string]int64
+	// readyTimeout is how long (in milliseconds) the players of a new game
+	// have to get ready for it. If zero, games are never aborted.
+	readyTimeout int64
+	// adjudicating is set while an adjudication pass is in progress, so that
+	// passes don't pile up on top of each other.
+	adjudicating bool
+
+	nower         entity.Nower
+	gameStore     GameStore
+	userStore     user.Store
+	listStatStore stats.ListStatStore
+	// owner, if set, limits the adjudicator to the games this node owns.
+	owner GameOwner
+}
+
+// NewAdjudicator creates a new adjudicator. The nower is used to determine
+// which deadlines have passed.
+func NewAdjudicator(gameStore GameStore, userStore user.Store,
+	listStatStore stats.ListStatStore, nower entity.Nower) *Adjudicator {
+
+	return &Adjudicator{
+		deadlines:     make(map[string]int64),
+		nower:         nower,
+		gameStore:     gameStore,
+		userStore:     userStore,
+		listStatStore: listStatStore,
+	}
+}
+
+// SetOwner limits the adjudicator to the games that the given owner owns.
+func (a *Adjudicator) SetOwner(o GameOwner) {
+	a.owner = o
+}
+
+// SetReadyTimeout sets how long, in milliseconds, the players of a new game
+// have to get ready for it before it's aborted.
+func (a *Adjudicator) SetReadyTimeout(t int64) {
+	a.readyTimeout = t
+}
+
+// Track computes the flag-fall deadline for the given game and schedules it.
+// It should be called every time the clocks of a game change (when it's
+// created, when it starts, and after every move). A game that has not started
+// is scheduled to be aborted once the ready timeout has passed since it was
+// first tracked. Games that are adjourned or that are over are removed from
+// the schedule. The caller must hold at least a read lock on the game.
+func (a *Adjudicator) Track(g *entity.Game) {
+	id := g.GameID()
+	if g.Adjourned || g.Playing() == macondopb.PlayState_GAME_OVER ||
+		g.GameEndReason != pb.GameEndReason_NONE {
+		a.Untrack(id)
+		return
+	}
+	if !g.Started {
+		if a.readyTimeout == 0 {
+			a.Untrack(id)
+			return
+		}
+		a.Lock()
+		defer a.Unlock()
+		if _, ok := a.deadlines[id]; !ok {
+			a.deadlines[id] = a.nower.Now() + a.readyTimeout
+		}
+		return
+	}
+	deadline := g.FlagFallTime()
+	a.Lock()
+	defer a.Unlock()
+	a.deadlines[id] = deadline
+}
+
+// Untrack removes the game with the given ID from the schedule.
+func (a *Adjudicator) Untrack(id string) {
+	a.Lock()
+	defer a.Unlock()
+	delete(a.deadlines, id)
+}
+
+// Deadline returns the tracked flag-fall deadline for the given game, and
+// whether the game is being tracked at all.
+func (a *Adjudicator) Deadline(id string) (int64, bool) {
+	a.Lock()
+	defer a.Unlock()
+	d, ok := a.deadlines[id]
+	return d, ok
+}
+
+// Rebuild rebuilds the schedule from the active games in the game store.
+// It should be called on startup, so that games that were in progress
+// when the server went down still end on time, and then periodically. With
+// an owner, only the games this node owns are tracked; any game that no live
+// node holds the lease on (because its owner crashed without handing it off)
+// is acquired along the way, so that it still gets timed out even if nobody
+// ever sends another message about it.
+func (a *Adjudicator) Rebuild(ctx context.Context) error {
+	gs, err := a.gameStore.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+	for _, gm := range gs {
+		if a.owner != nil && !a.owner.Holds(gm.Id) && !a.owner.Owns(ctx, gm.Id) {
+			// Another node is keeping time in this one.
+			continue
+		}
+		g, err := a.gameStore.Get(ctx, gm.Id)
+		if err != nil {
+			log.Err(err).Str("gameID", gm.Id).Msg("adjudicator-rebuild-get")
+			continue
+		}
+		g.RLock()
+		a.Track(g)
+		g.RUnlock()
+	}
+	log.Info().Int("num-active", len(gs)).Msg("adjudicator-rebuilt")
+	return nil
+}
+
+// Adjudicate ends every tracked game whose deadline has passed, and returns
+// the IDs of the games that were timed out or aborted. Every game is ended at
+// most once; games that are already over are simply dropped from the
+// schedule.
+func (a *Adjudicator) Adjudicate(ctx context.Context) []string {
+	now := a.nower.Now()
+	a.Lock()
+	if a.adjudicating {
+		a.Unlock()
+		return nil
+	}
+	a.adjudicating = true
+	due := []string{}
+	for id, deadline := range a.deadlines {
+		if now > deadline {
+			due = append(due, id)
+		}
+	}
+	a.Unlock()
+
+	defer func() {
+		a.Lock()
+		a.adjudicating = false
+		a.Unlock()
+	}()
+
+	timedOut := []string{}
+	for _, id := range due {
+		if a.owner != nil && !a.owner.Holds(id) {
+			// It moved to another node, which will time it out.
+			a.Untrack(id)
+			continue
+		}
+		g, err := a.gameStore.Get(ctx, id)
+		if err != nil {
+			log.Err(err).Str("gameID", id).Msg("adjudicator-get")
+			a.Untrack(id)
+			continue
+		}
+		g.RLock()
+		over := g.Playing() == macondopb.PlayState_GAME_OVER
+		started := g.Started
+		g.RUnlock()
+		if !over && !started {
+			log.Info().Str("gameID", id).Msg("adjudicating-players-not-ready")
+			err = AbortUnstarted(ctx, a.gameStore, a.userStore, a.listStatStore, id)
+			if err == errGameStarted {
+				g.RLock()
+				a.Track(g)
+				g.RUnlock()
+				continue
+			}
+			a.Untrack(id)
+			if err != nil {
+				log.Err(err).Str("gameID", id).Msg("adjudicator-abort")
+				continue
+			}
+			timedOut = append(timedOut, id)
+			continue
+		}
+		g.RLock()
+		onTurn := g.PlayerOnTurn()
+		userID := g.PlayerIDOnTurn()
+		ranOut := g.TimeRanOut(onTurn)
+		if !ranOut {
+			// Someone moved since the deadline was computed.
+			a.Track(g)
+		}
+		g.RUnlock()
+		if over {
+			a.Untrack(id)
+			continue
+		}
+		if !ranOut {
+			continue
+		}
+		log.Info().Str("gameID", id).Str("userID", userID).Msg("adjudicating-time-ran-out")
+		err = TimedOut(ctx, a.gameStore, a.userStore, a.listStatStore, userID, id)
+		if err == errNotOnTurn || err == errTimeDidntRunOut {
+			// A move sneaked in between our check and the timeout; the
+			// clocks have changed, so just reschedule.
+			g.RLock()
+			a.Track(g)
+			g.RUnlock()
+			continue
+		}
+		a.Untrack(id)
+		if err != nil {
+			log.Err(err).Str("gameID", id).Msg("adjudicator-timed-out")
+			continue
+		}
+		timedOut = append(timedOut, id)
+	}
+	return timedOut
+}
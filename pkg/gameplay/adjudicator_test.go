@@ -0,0 +1,140 @@
+package gameplay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/stats"
+	"github.com/domino14/liwords/pkg/stores/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+func TestAdjudicateTimesOutOnce(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+
+	adj := NewAdjudicator(gstore, ustore, lstore, nower)
+	adj.Track(g)
+	deadline, ok := adj.Deadline(g.GameID())
+	is.True(ok)
+	// 25 minutes, no overtime.
+	is.Equal(deadline, int64(1234+25*60000))
+
+	nower.Sleep(25 * 60000)
+	is.Equal(len(adj.Adjudicate(ctx)), 0)
+	is.Equal(g.GameEndReason, pb.GameEndReason_NONE)
+
+	nower.Sleep(1)
+	is.Equal(adj.Adjudicate(ctx), []string{g.GameID()})
+	is.Equal(g.GameEndReason, pb.GameEndReason_TIME)
+	is.Equal(g.Playing(), macondopb.PlayState_GAME_OVER)
+
+	// The game is no longer tracked, so nothing else should happen.
+	_, ok = adj.Deadline(g.GameID())
+	is.True(!ok)
+	nower.Sleep(60000)
+	is.Equal(len(adj.Adjudicate(ctx)), 0)
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestAdjudicatorReschedulesAfterMove(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+
+	adj := NewAdjudicator(gstore, ustore, lstore, nower)
+	is.NoErr(adj.Rebuild(ctx))
+	_, ok := adj.Deadline(g.GameID())
+	is.True(ok)
+
+	// "jesse" passes right before their flag falls, without telling the
+	// adjudicator about it.
+	nower.Sleep(25*60000 - 1000)
+	_, err := HandleEvent(ctx, gstore, ustore, lstore, "3xpEkpRAy3AizbVmDg3kdi",
+		&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_PASS, GameId: g.GameID()})
+	is.NoErr(err)
+
+	// The stale deadline passes, but the game should just get rescheduled
+	// for cesar4's flag instead.
+	nower.Sleep(2000)
+	is.Equal(len(adj.Adjudicate(ctx)), 0)
+	is.Equal(g.GameEndReason, pb.GameEndReason_NONE)
+	deadline, ok := adj.Deadline(g.GameID())
+	is.True(ok)
+	is.Equal(deadline, int64(1234+25*60000-1000+25*60000))
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestAdjudicatorAdoptsGamesOfDeadNode(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+
+	// Node a creates the game. Node b's adjudicator leaves it alone, since a
+	// is keeping time in it.
+	leases := &fakeLeaseStore{owners: map[string]string{}}
+	a := NewOwnership("a", time.Minute, leases, &unloadRecorder{})
+	b := NewOwnership("b", time.Minute, leases, &unloadRecorder{})
+	is.NoErr(a.Claim(ctx, g.GameID()))
+	adj := NewAdjudicator(gstore, ustore, lstore, nower)
+	adj.SetOwner(b)
+	is.NoErr(adj.Rebuild(ctx))
+	_, ok := adj.Deadline(g.GameID())
+	is.True(!ok)
+
+	// a crashes without handing the game off, and its lease runs out. Nobody
+	// is connected to the game, so the next sweep is what adopts it.
+	delete(leases.owners, g.GameID())
+	is.NoErr(adj.Rebuild(ctx))
+	is.True(b.Holds(g.GameID()))
+	deadline, ok := adj.Deadline(g.GameID())
+	is.True(ok)
+	is.Equal(deadline, int64(1234+25*60000))
+
+	nower.Sleep(25*60000 + 1)
+	is.Equal(adj.Adjudicate(ctx), []string{g.GameID()})
+	is.Equal(g.GameEndReason, pb.GameEndReason_TIME)
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
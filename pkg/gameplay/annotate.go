@@ -0,0 +1,176 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	macondoconfig "github.com/domino14/macondo/config"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+
+	"github.com/domino14/liwords/pkg/apiserver"
+	"github.com/domino14/liwords/pkg/config"
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stats"
+	gspb "github.com/domino14/liwords/rpc/api/proto/game_service"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+var (
+	errGameNotOver = errors.New("games can only be annotated once they are over")
+	errNotYourTurn = errors.New("you can only annotate your own turns")
+	errEmptyNote   = errors.New("the note is empty")
+	errNoSuchTurn  = errors.New("there is no such turn in this game")
+)
+
+// AnnotateTurn adds a note to one of the user's turns in a finished game.
+// Like the notes in a GCG, it's added to whatever note the turn already
+// had. Whatever the note adds to the comment and mistake stats is added to
+// the game's stats and to the user's, all at once. The note is added to the
+// game as it's saved, not to the copy in memory, which could be missing
+// notes added on other nodes; it returns the saved game.
+func AnnotateTurn(ctx context.Context, gameStore GameStore, listStatStore stats.ListStatStore,
+	userID, gameID string, eventIdx int, note string) (*entity.Game, error) {
+
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return nil, errEmptyNote
+	}
+	entGame, err := gameStore.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	// Only the notes of a finished game ever change, so whether the user
+	// can annotate the turn can be checked on any copy of it.
+	entGame.RLock()
+	if entGame.Playing() != macondopb.PlayState_GAME_OVER {
+		entGame.RUnlock()
+		return nil, errGameNotOver
+	}
+	pidx := playerIndex(entGame, userID)
+	if pidx == -1 {
+		entGame.RUnlock()
+		return nil, errNotInGame
+	}
+	hist := entGame.History()
+	if eventIdx < 0 || eventIdx >= len(hist.Events) {
+		entGame.RUnlock()
+		return nil, errNoSuchTurn
+	}
+	if hist.Events[eventIdx].Nickname != hist.Players[pidx].Nickname {
+		entGame.RUnlock()
+		return nil, errNotYourTurn
+	}
+	// Aborted games don't count towards anyone's stats, and some old games
+	// never had any.
+	var variantKey entity.VariantKey
+	if entGame.GameEndReason != pb.GameEndReason_ABORTED && entGame.Stats != nil {
+		variantKey, err = entGame.RatingKey()
+	}
+	entGame.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// The list stats are only added once the rest is saved.
+	listStats := &listStatBuffer{ListStatStore: listStatStore}
+	commit := &entity.GameNoteCommit{
+		VariantKey: variantKey,
+		UserID:     userID,
+		Apply: func(g *entity.Game, player *entity.User) (*entity.Stats, error) {
+			if g.Playing() != macondopb.PlayState_GAME_OVER {
+				return nil, errGameNotOver
+			}
+			evt := g.History().Events[eventIdx]
+			hadNote := evt.Note != ""
+			evt.Note = strings.TrimSpace(evt.Note + "\n" + note)
+			if variantKey == "" {
+				return nil, nil
+			}
+			profileStats, ok := player.Profile.Stats.Data[variantKey]
+			if !ok {
+				profileStats = stats.InstantiateNewStats(userID, "")
+			}
+			err := addAnnotationStats(ctx, g, eventIdx, note, !hadNote, profileStats,
+				listStats)
+			if err != nil {
+				return nil, err
+			}
+			return profileStats, nil
+		},
+	}
+	saved, err := gameStore.AnnotateGame(ctx, gameID, commit)
+	if err != nil {
+		return nil, err
+	}
+	listStats.flush()
+	log.Info().Str("gameID", gameID).Str("userID", userID).Int("event", eventIdx).
+		Msg("annotated-turn")
+	return saved, nil
+}
+
+// addAnnotationStats adds the stats for a note added to an event to the
+// game's stats and to the given profile stats of the user who wrote it. The
+// stats are computed by adding a game to a set of annotation stats; that
+// game has just the one event, with just the new note on it.
+func addAnnotationStats(ctx context.Context, entGame *entity.Game, eventIdx int,
+	note string, newComment bool, profileStats *entity.Stats,
+	listStatStore stats.ListStatStore) error {
+
+	hist := entGame.History()
+	evt := proto.Clone(hist.Events[eventIdx]).(*macondopb.GameEvent)
+	evt.Note = note
+	noteHist := &macondopb.GameHistory{
+		Players: hist.Players,
+		Events:  []*macondopb.GameEvent{evt},
+		Uid:     hist.Uid,
+		Winner:  hist.Winner,
+	}
+
+	cfg := ctx.Value(ConfigCtxKey("config")).(*macondoconfig.Config)
+	delta := stats.InstantiateAnnotationStats(hist.Players[0].UserId, hist.Players[1].UserId,
+		newComment)
+	err := stats.AddGame(delta, listStatStore, noteHist, entGame.GameReq, cfg,
+		&pb.GameEndedEvent{Time: entGame.Timers.TimeOfLastUpdate}, entGame.GameID())
+	if err != nil {
+		return err
+	}
+
+	err = stats.AddStats(entGame.Stats, delta)
+	if err != nil {
+		return err
+	}
+	return stats.AddStats(profileStats, delta)
+}
+
+// AnnotationService is a Twirp service that lets players annotate their
+// turns once a game is over.
+type AnnotationService struct {
+	cfg           *config.Config
+	gameStore     GameStore
+	listStatStore stats.ListStatStore
+}
+
+// NewAnnotationService creates a Twirp AnnotationService
+func NewAnnotationService(cfg *config.Config, gs GameStore,
+	lss stats.ListStatStore) *AnnotationService {
+	return &AnnotationService{cfg, gs, lss}
+}
+
+// AnnotateTurn annotates one of the logged-in user's turns.
+func (as *AnnotationService) AnnotateTurn(ctx context.Context, req *gspb.AnnotationRequest) (*gspb.AnnotationResponse, error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, ConfigCtxKey("config"), &as.cfg.MacondoConfig)
+	entGame, err := AnnotateTurn(ctx, as.gameStore, as.listStatStore,
+		sess.UserUUID, req.GameId, int(req.EventIndex), req.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &gspb.AnnotationResponse{Note: entGame.History().Events[req.EventIndex].Note}, nil
+}
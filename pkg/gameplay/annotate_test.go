@@ -0,0 +1,100 @@
+package gameplay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/stats"
+	"github.com/domino14/liwords/pkg/stores/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+func TestAnnotateTurn(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, _, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	id := g.GameID()
+	jesse, cesar := "3xpEkpRAy3AizbVmDg3kdi", "xjCWug7EZtDxDHX5fRZTLo"
+
+	_, err := AnnotateTurn(ctx, gstore, lstore, jesse, id, 0, "#vision")
+	is.Equal(err, errGameNotOver)
+
+	// Six passes in a row end the game.
+	for i := 0; i < 6; i++ {
+		p := []string{jesse, cesar}[i%2]
+		_, err = HandleEvent(ctx, gstore, ustore, lstore, p,
+			&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_PASS, GameId: id})
+		is.NoErr(err)
+	}
+	is.Equal(g.Playing(), macondopb.PlayState_GAME_OVER)
+
+	_, err = AnnotateTurn(ctx, gstore, lstore, cesar, id, 0, "#vision")
+	is.Equal(err, errNotYourTurn)
+	_, err = AnnotateTurn(ctx, gstore, lstore, jesse, id, 0, "  ")
+	is.Equal(err, errEmptyNote)
+
+	_, err = AnnotateTurn(ctx, gstore, lstore, jesse, id, 0,
+		"#visionlarge I had a bingo")
+	is.NoErr(err)
+	_, err = AnnotateTurn(ctx, gstore, lstore, jesse, id, 0, "#tactics")
+	is.NoErr(err)
+
+	saved, err := gstore.Get(ctx, id)
+	is.NoErr(err)
+	is.Equal(saved.History().Events[0].Note, "#visionlarge I had a bingo\n#tactics")
+
+	// Both notes count as mistakes, but it's still just the one comment.
+	u, err := ustore.GetByUUID(ctx, jesse)
+	is.NoErr(err)
+	profile := u.Profile.Stats.Data[variantKey(g.GameReq)]
+	is.Equal(profile.PlayerOneData[entity.MISTAKES_STAT].Total, 2)
+	is.Equal(profile.PlayerOneData[entity.COMMENTS_STAT].Total, 1)
+	is.Equal(saved.Stats.PlayerOneData[entity.MISTAKES_STAT].Total, 2)
+
+	// Another node has the game cached from before the next note, but what
+	// it annotates is added to the game as it was saved.
+	_, other := gameStore(cstr, ustore)
+	_, err = other.Get(ctx, id)
+	is.NoErr(err)
+	_, err = AnnotateTurn(ctx, gstore, lstore, jesse, id, 0, "#endgame")
+	is.NoErr(err)
+	saved, err = AnnotateTurn(ctx, other, lstore, jesse, id, 2, "#strategy")
+	is.NoErr(err)
+	is.Equal(saved.History().Events[0].Note, "#visionlarge I had a bingo\n#tactics\n#endgame")
+	is.Equal(saved.History().Events[2].Note, "#strategy")
+	is.Equal(saved.Stats.PlayerOneData[entity.MISTAKES_STAT].Total, 4)
+	other.(*game.Cache).Disconnect()
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestGCGHistoryKeepsNotes(t *testing.T) {
+	is := is.New(t)
+	hist := &macondopb.GameHistory{Events: []*macondopb.GameEvent{
+		{Nickname: "cesar4", Type: macondopb.GameEvent_TILE_PLACEMENT_MOVE, Note: "nice"},
+		{Nickname: "jesse", Type: macondopb.GameEvent_PASS, Note: "#endgame oops"},
+		{Nickname: "cesar4", Type: macondopb.GameEvent_END_RACK_PTS},
+	}}
+	gcgHist := gcgHistory(hist)
+	is.Equal(gcgHist.Events[0].Note, "nice")
+	is.Equal(gcgHist.Events[1].Note, "")
+	is.Equal(gcgHist.Events[2].Note, "#endgame oops")
+	// The game's own history is left alone.
+	is.Equal(hist.Events[1].Note, "#endgame oops")
+}
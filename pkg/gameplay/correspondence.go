@@ -0,0 +1,15 @@
+package gameplay
+
+import (
+	"context"
+
+	"github.com/domino14/liwords/pkg/entity"
+)
+
+// A TurnNotifier lets a player know that it is their turn in a correspondence
+// game. They are probably not looking at the game when that happens, so
+// this is the place to send an email or a push notification.
+type TurnNotifier interface {
+	// NotifyTurn is called with at least a read lock held on the game.
+	NotifyTurn(ctx context.Context, g *entity.Game) error
+}
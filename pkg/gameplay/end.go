@@ -14,10 +14,26 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-func performEndgameDuties(ctx context.Context, g *entity.Game,
-	userStore user.Store, listStatStore stats.ListStatStore) {
+// performEndgameDuties ends the game: it works out who won, rates the game
+// and computes its stats, and saves all that along with the game in one
+// go. Nobody hears about it until then. If the game already ended, or it
+// couldn't be saved, this copy of it is unloaded and an error is returned;
+// the caller must not save it.
+func performEndgameDuties(ctx context.Context, g *entity.Game, gameStore GameStore,
+	listStatStore stats.ListStatStore) error {
 
 	log.Debug().Interface("game-end-reason", g.GameEndReason).Msg("checking-game-over")
+	// Another copy of the game, on another node or loaded before this one,
+	// could have ended it already.
+	ended, err := gameStore.EndCommitted(ctx, g.GameID())
+	if err != nil {
+		return err
+	}
+	if ended {
+		log.Info().Str("gameID", g.GameID()).Msg("game-already-ended")
+		gameStore.Unload(ctx, g.GameID())
+		return errGameAlreadyEnded
+	}
 	// The game is over already. Set an end game reason if there hasn't been
 	// one set.
 	if g.GameEndReason == pb.GameEndReason_NONE {
@@ -30,11 +46,14 @@ func performEndgameDuties(ctx context.Context, g *entity.Game,
 
 	evts := []*pb.ServerGameplayEvent{}
 
+	aborted := g.GameEndReason == pb.GameEndReason_ABORTED
+
 	var p0penalty, p1penalty int
-	if g.CachedTimeRemaining(0) < 0 {
+	// Nobody is penalized for time in a game that doesn't count.
+	if g.CachedTimeRemaining(0) < 0 && !aborted {
 		p0penalty = 10 * int(math.Ceil(float64(-g.CachedTimeRemaining(0))/60000.0))
 	}
-	if g.CachedTimeRemaining(1) < 0 {
+	if g.CachedTimeRemaining(1) < 0 && !aborted {
 		p1penalty = 10 * int(math.Ceil(float64(-g.CachedTimeRemaining(1))/60000.0))
 	}
 	// Limit time penalties to the max OT. This is so that we don't get situations
@@ -82,10 +101,6 @@ func performEndgameDuties(ctx context.Context, g *entity.Game,
 	}
 
 	for _, sge := range evts {
-		wrapped := entity.WrapEvent(sge, pb.MessageType_SERVER_GAMEPLAY_EVENT)
-		wrapped.AddAudience(entity.AudGameTV, g.GameID())
-		wrapped.AddAudience(entity.AudGame, g.GameID())
-		g.SendChange(wrapped)
 		g.History().Events = append(g.History().Events, sge.Event)
 	}
 
@@ -121,8 +136,83 @@ func performEndgameDuties(ctx context.Context, g *entity.Game,
 	// However we are possibly editing it above.
 	g.History().Winner = int32(g.WinnerIdx)
 
-	// Send a gameEndedEvent, which rates the game.
-	evt := gameEndedEvent(ctx, g, userStore)
+	evt := gameEndedEvent(g)
+	// Abandoned games might not be rated, depending on the server's policy.
+	// Aborted games never are.
+	skipRating, _ := ctx.Value(skipRatingCtxKey{}).(bool)
+	rated := g.CreationRequest().RatingMode == pb.RatingMode_RATED && !skipRating && !aborted
+	g.Unrated = g.CreationRequest().RatingMode == pb.RatingMode_RATED && !rated
+	now := time.Now().Unix()
+	// The list stats are only added once the rest is saved, so that they
+	// aren't added for a game that didn't end after all.
+	listStats := &listStatBuffer{ListStatStore: listStatStore}
+	variantKey, variantErr := g.RatingKey()
+
+	commit := &entity.GameEndCommit{
+		VariantKey: variantKey,
+		Apply: func(players map[string]*entity.User) (map[string]entity.SingleRating, map[string]*entity.Stats, error) {
+			ratings := map[string]entity.SingleRating{}
+			byUsername := map[string]*entity.User{}
+			for _, p := range g.History().Players {
+				byUsername[p.Nickname] = players[p.UserId]
+			}
+			if rated {
+				newRatings, err := rateFrom(evt.Scores, g, evt.Winner, byUsername, now)
+				if err != nil {
+					log.Err(err).Msg("rating-error")
+				}
+				for username, r := range newRatings {
+					ratings[byUsername[username].UUID] = r
+					evt.NewRatings[username] = int32(math.Round(r.Rating))
+					evt.NewRatingDeviations[username] = int32(math.Round(r.RatingDeviation))
+				}
+			}
+
+			// Compute stats for the player and for the game. Aborted games
+			// don't count towards anyone's stats.
+			if aborted {
+				log.Debug().Str("gameID", g.GameID()).Msg("skipping-stats-for-aborted-game")
+				return ratings, nil, nil
+			} else if variantErr != nil {
+				log.Err(variantErr).Msg("getting variant key")
+				return ratings, nil, nil
+			}
+			current := map[string]*entity.Stats{}
+			for uuid, u := range players {
+				current[uuid] = u.Profile.Stats.Data[variantKey]
+			}
+			gameStats, profileStats, err := gameAndProfileStats(ctx, g.History(), g.GameReq,
+				variantKey, evt, current, listStats)
+			if err != nil {
+				log.Err(err).Msg("computing stats")
+				return ratings, nil, nil
+			}
+			g.Stats = gameStats
+			return ratings, profileStats, nil
+		},
+	}
+
+	committed, err := gameStore.EndGame(ctx, g, commit)
+	if err != nil || !committed {
+		// This copy of the game is no good: either the game ended already,
+		// or it's still on as far as the store knows.
+		gameStore.Unload(ctx, g.GameID())
+		if err != nil {
+			log.Err(err).Str("gameID", g.GameID()).Msg("committing-game-end")
+			return err
+		}
+		log.Info().Str("gameID", g.GameID()).Msg("game-already-ended")
+		return errGameAlreadyEnded
+	}
+	listStats.flush()
+
+	for _, sge := range evts {
+		wrapped := entity.WrapEvent(sge, pb.MessageType_SERVER_GAMEPLAY_EVENT)
+		wrapped.AddAudience(entity.AudGameTV, g.GameID())
+		wrapped.AddAudience(entity.AudGame, g.GameID())
+		g.SendChange(wrapped)
+	}
+	log.Debug().Interface("game-ended-event", evt).Msg("game-ended")
 	wrapped := entity.WrapEvent(evt, pb.MessageType_GAME_ENDED_EVENT)
 	// Once the game ends, we do not need to "sanitize" the packets
 	// going to the users anymore. So just send the data to the right
@@ -131,31 +221,52 @@ func performEndgameDuties(ctx context.Context, g *entity.Game,
 	wrapped.AddAudience(entity.AudGameTV, g.GameID())
 	g.SendChange(wrapped)
 
-	// Compute stats for the player and for the game.
-	variantKey, err := g.RatingKey()
-	if err != nil {
-		log.Err(err).Msg("getting variant key")
-	} else {
-		gameStats, err := computeGameStats(ctx, g.History(), g.GameReq, variantKey,
-			evt, userStore, listStatStore)
-		if err != nil {
-			log.Err(err).Msg("computing stats")
-		} else {
-			g.Stats = gameStats
-		}
-	}
 	// And finally, send a notification to the lobby that this
 	// game ended. This will remove it from the list of live games.
 	wrapped = entity.WrapEvent(&pb.GameDeletion{Id: g.GameID()},
 		pb.MessageType_GAME_DELETION)
 	wrapped.AddAudience(entity.AudLobby, "gameEnded")
 	g.SendChange(wrapped)
+	return nil
 }
 
-func computeGameStats(ctx context.Context, history *macondopb.GameHistory, req *pb.GameRequest,
+// ComputeGameStats computes the stats of a game, and adds them to the
+// profile stats of its players.
+func ComputeGameStats(ctx context.Context, history *macondopb.GameHistory, req *pb.GameRequest,
 	variantKey entity.VariantKey, evt *pb.GameEndedEvent, userStore user.Store,
 	listStatStore stats.ListStatStore) (*entity.Stats, error) {
 
+	current := map[string]*entity.Stats{}
+	for _, p := range history.Players {
+		st, err := statsForUser(ctx, p.UserId, userStore, variantKey)
+		if err != nil {
+			return nil, err
+		}
+		current[p.UserId] = st
+	}
+	gameStats, profileStats, err := gameAndProfileStats(ctx, history, req, variantKey, evt,
+		current, listStatStore)
+	if err != nil {
+		return nil, err
+	}
+	// Save all stats back to the database.
+	for _, p := range history.Players {
+		err = userStore.SetStats(ctx, p.UserId, variantKey, profileStats[p.UserId])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return gameStats, nil
+}
+
+// gameAndProfileStats computes the stats of a game, and what the profile
+// stats of its players become with them, from their current profile stats.
+// Both are by user UUID; players without any stats yet can be left out. The
+// profile stats aren't saved.
+func gameAndProfileStats(ctx context.Context, history *macondopb.GameHistory, req *pb.GameRequest,
+	variantKey entity.VariantKey, evt *pb.GameEndedEvent, current map[string]*entity.Stats,
+	listStatStore stats.ListStatStore) (*entity.Stats, map[string]*entity.Stats, error) {
+
 	// stats := entity.InstantiateNewStats(1, 2)
 	p0id, p1id := history.Players[0].UserId, history.Players[1].UserId
 	if history.SecondWentFirst {
@@ -175,7 +286,7 @@ func computeGameStats(ctx context.Context, history *macondopb.GameHistory, req *
 
 	err := stats.AddGame(gameStats, listStatStore, history, req, config, evt, history.Uid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if history.SecondWentFirst {
@@ -190,43 +301,64 @@ func computeGameStats(ctx context.Context, history *macondopb.GameHistory, req *
 	p0NewProfileStats := stats.InstantiateNewStats(p0id, "")
 	p1NewProfileStats := stats.InstantiateNewStats(p1id, "")
 
-	p0ProfileStats, err := statsForUser(ctx, p0id, userStore, variantKey)
-	if err != nil {
-		return nil, err
-	}
-
-	p1ProfileStats, err := statsForUser(ctx, p1id, userStore, variantKey)
-	if err != nil {
-		return nil, err
-	}
-
-	err = stats.AddStats(p0NewProfileStats, p0ProfileStats)
-	if err != nil {
-		return nil, err
+	if p0ProfileStats := current[p0id]; p0ProfileStats != nil {
+		err = stats.AddStats(p0NewProfileStats, p0ProfileStats)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
-	err = stats.AddStats(p1NewProfileStats, p1ProfileStats)
-	if err != nil {
-		return nil, err
+	if p1ProfileStats := current[p1id]; p1ProfileStats != nil {
+		err = stats.AddStats(p1NewProfileStats, p1ProfileStats)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 	err = stats.AddStats(p0NewProfileStats, gameStats)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	err = stats.AddStats(p1NewProfileStats, gameStats)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Save all stats back to the database.
-	err = userStore.SetStats(ctx, p0id, variantKey, p0NewProfileStats)
-	if err != nil {
-		return nil, err
-	}
-	err = userStore.SetStats(ctx, p1id, variantKey, p1NewProfileStats)
-	if err != nil {
-		return nil, err
+	return gameStats, map[string]*entity.Stats{
+		p0id: p0NewProfileStats,
+		p1id: p1NewProfileStats,
+	}, nil
+}
+
+// listStatBuffer holds on to the list stats of a game that is ending or
+// being annotated, until the rest of what changes with it has been saved.
+type listStatBuffer struct {
+	stats.ListStatStore
+	items []bufferedListItem
+}
+
+type bufferedListItem struct {
+	gameID   string
+	playerID string
+	statType int
+	time     int64
+	item     entity.ListDatum
+}
+
+func (b *listStatBuffer) AddListItem(gameID string, playerID string, statType int,
+	time int64, item entity.ListDatum) error {
+
+	b.items = append(b.items, bufferedListItem{gameID, playerID, statType, time, item})
+	return nil
+}
+
+// flush adds the list stats to the store.
+func (b *listStatBuffer) flush() {
+	for _, i := range b.items {
+		err := b.ListStatStore.AddListItem(i.gameID, i.playerID, i.statType, i.time, i.item)
+		if err != nil {
+			log.Err(err).Str("gameID", i.gameID).Msg("adding-list-stat")
+		}
 	}
-	return gameStats, nil
+	b.items = nil
 }
 
 func setTimedOut(ctx context.Context, entGame *entity.Game, pidx int, gameStore GameStore,
@@ -238,10 +370,8 @@ func setTimedOut(ctx context.Context, entGame *entity.Game, pidx int, gameStore
 	entGame.SetGameEndReason(pb.GameEndReason_TIME)
 	entGame.SetWinnerIdx(1 - pidx)
 	entGame.SetLoserIdx(pidx)
-	performEndgameDuties(ctx, entGame, userStore, listStatStore)
-
-	// Store the game back into the store
-	err := gameStore.Set(ctx, entGame)
+	// The game is saved as it ends.
+	err := performEndgameDuties(ctx, entGame, gameStore, listStatStore)
 	if err != nil {
 		return err
 	}
@@ -252,7 +382,9 @@ func setTimedOut(ctx context.Context, entGame *entity.Game, pidx int, gameStore
 	return nil
 }
 
-func gameEndedEvent(ctx context.Context, g *entity.Game, userStore user.Store) *pb.GameEndedEvent {
+// gameEndedEvent returns the event that tells everyone the game ended. The
+// new ratings are added to it once the game is rated.
+func gameEndedEvent(g *entity.Game) *pb.GameEndedEvent {
 	var winner, loser string
 	var tie bool
 	winnerIdx := g.GetWinnerIdx()
@@ -271,25 +403,16 @@ func gameEndedEvent(ctx context.Context, g *entity.Game, userStore user.Store) *
 		g.History().Players[0].Nickname: int32(g.PointsFor(0)),
 		g.History().Players[1].Nickname: int32(g.PointsFor(1))}
 
-	ratings := map[string]int32{}
-	var err error
-	var now = time.Now().Unix()
-	if g.CreationRequest().RatingMode == pb.RatingMode_RATED {
-		ratings, err = Rate(ctx, scores, g, winner, userStore, now)
-		if err != nil {
-			log.Err(err).Msg("rating-error")
-		}
-	}
-	evt := &pb.GameEndedEvent{
-		Scores:     scores,
-		NewRatings: ratings,
-		EndReason:  g.GameEndReason,
-		Winner:     winner,
-		Loser:      loser,
-		Tie:        tie,
-		Time:       g.Timers.TimeOfLastUpdate,
+	return &pb.GameEndedEvent{
+		Scores:              scores,
+		NewRatings:          map[string]int32{},
+		NewRatingDeviations: map[string]int32{},
+		EndReason:           g.GameEndReason,
+		Winner:              winner,
+		Loser:               loser,
+		Tie:                 tie,
+		Time:                g.Timers.TimeOfLastUpdate,
+		GameId:              g.GameID(),
+		TournamentId:        g.GameReq.TournamentId,
 	}
-
-	log.Debug().Interface("game-ended-event", evt).Msg("game-ended")
-	return evt
 }
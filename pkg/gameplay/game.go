@@ -8,14 +8,13 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/domino14/macondo/alphabet"
-	"github.com/domino14/macondo/board"
-	"github.com/domino14/macondo/cross_set"
-	"github.com/domino14/macondo/gaddag"
+	macondoconfig "github.com/domino14/macondo/config"
 	"github.com/domino14/macondo/game"
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 	"github.com/domino14/macondo/move"
@@ -25,13 +24,15 @@ import (
 	"github.com/domino14/liwords/pkg/entity"
 	"github.com/domino14/liwords/pkg/stats"
 	"github.com/domino14/liwords/pkg/user"
+	"github.com/domino14/liwords/pkg/variants"
 	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
 )
 
 var (
-	errGameNotActive   = errors.New("game is not currently active")
-	errNotOnTurn       = errors.New("player not on turn")
-	errTimeDidntRunOut = errors.New("got time ran out, but it did not actually")
+	errGameNotActive    = errors.New("game is not currently active")
+	errGameAlreadyEnded = errors.New("game already ended")
+	errNotOnTurn        = errors.New("player not on turn")
+	errTimeDidntRunOut  = errors.New("got time ran out, but it did not actually")
 )
 
 // GameStore is an interface for getting a full game.
@@ -39,9 +40,18 @@ type GameStore interface {
 	Get(ctx context.Context, id string) (*entity.Game, error)
 	Set(context.Context, *entity.Game) error
 	Create(context.Context, *entity.Game) error
+	EndGame(ctx context.Context, g *entity.Game, commit *entity.GameEndCommit) (bool, error)
+	AnnotateGame(ctx context.Context, id string, commit *entity.GameNoteCommit) (*entity.Game, error)
+	EndCommitted(ctx context.Context, id string) (bool, error)
 	ListActive(context.Context) ([]*pb.GameMeta, error)
+	ListActiveCorrespondence(ctx context.Context, userID string) ([]*pb.GameMeta, error)
+	ListPastGames(ctx context.Context, q *entity.PastGamesQuery) ([]*entity.PastGame, int, error)
+	GetHeadToHead(ctx context.Context, userID, opponentID string, variant entity.VariantKey) (*entity.HeadToHead, error)
 	SetGameEventChan(c chan<- *entity.EventWrapper)
 	Unload(context.Context, string)
+	// UnloadIdleCorrespondence unloads the correspondence games that have
+	// been idle for a while, and returns their IDs.
+	UnloadIdleCorrespondence(ctx context.Context, idleFor time.Duration) []string
 }
 
 type ConfigCtxKey string
@@ -62,38 +72,16 @@ func InstantiateNewGame(ctx context.Context, gameStore GameStore, cfg *config.Co
 		dbids[idx] = u.ID
 	}
 
-	if req.Rules == nil {
-		return nil, errors.New("no rules")
-	}
-
-	var bd []string
-	switch req.Rules.BoardLayoutName {
-	case entity.CrosswordGame:
-		bd = board.CrosswordGameBoard
-	default:
-		return nil, errors.New("unsupported board layout")
-	}
-
 	firstAssigned := false
 	if assignedFirst != -1 {
 		firstAssigned = true
 	}
 
-	dist, err := alphabet.LoadLetterDistribution(&cfg.MacondoConfig, req.Rules.LetterDistributionName)
+	rules, err := gameRules(&cfg.MacondoConfig, req)
 	if err != nil {
 		return nil, err
 	}
 
-	gd, err := gaddag.LoadFromCache(&cfg.MacondoConfig, req.Lexicon)
-	if err != nil {
-		return nil, err
-	}
-
-	rules := game.NewGameRules(
-		&cfg.MacondoConfig, dist, board.MakeBoard(bd),
-		&gaddag.Lexicon{GenericDawg: gd},
-		cross_set.CrossScoreOnlyGenerator{Dist: dist})
-
 	runner, err := runner.NewGameRunnerFromRules(&runner.GameOptions{
 		FirstIsAssigned: firstAssigned,
 		GoesFirst:       assignedFirst,
@@ -115,6 +103,14 @@ func InstantiateNewGame(ctx context.Context, gameStore GameStore, cfg *config.Co
 	// and forward game events to the right channels.
 }
 
+// gameRules returns the Macondo rules for a game created with the request.
+func gameRules(cfg *macondoconfig.Config, req *pb.GameRequest) (*game.GameRules, error) {
+	if req.Rules == nil {
+		return nil, errors.New("no rules")
+	}
+	return variants.GameRules(cfg, req.Rules, req.Lexicon)
+}
+
 func clientEventToMove(cge *pb.ClientGameplayEvent, g *game.Game) (*move.Move, error) {
 	playerid := g.PlayerOnTurn()
 	rack := g.RackFor(playerid)
@@ -198,7 +194,7 @@ func players(entGame *entity.Game) []string {
 	return ps
 }
 
-func handleChallenge(ctx context.Context, entGame *entity.Game,
+func handleChallenge(ctx context.Context, entGame *entity.Game, gameStore GameStore,
 	userStore user.Store, listStatStore stats.ListStatStore,
 	timeRemaining int, challengerID string) error {
 	if entGame.ChallengeRule() == macondopb.ChallengeRule_VOID {
@@ -264,14 +260,14 @@ func handleChallenge(ctx context.Context, entGame *entity.Game,
 			entGame.SetWinnerIdx(winner)
 			entGame.SetLoserIdx(1 - winner)
 		}
-		performEndgameDuties(ctx, entGame, userStore, listStatStore)
+		return performEndgameDuties(ctx, entGame, gameStore, listStatStore)
 	}
 
 	return nil
 }
 
-func PlayMove(ctx context.Context, entGame *entity.Game, userStore user.Store,
-	listStatStore stats.ListStatStore, userID string, onTurn, timeRemaining int, m *move.Move) error {
+func PlayMove(ctx context.Context, entGame *entity.Game, gameStore GameStore,
+	userStore user.Store, listStatStore stats.ListStatStore, userID string, onTurn, timeRemaining int, m *move.Move) error {
 
 	log.Debug().Msg("validating")
 
@@ -282,7 +278,7 @@ func PlayMove(ctx context.Context, entGame *entity.Game, userStore user.Store,
 
 	if m.Action() == move.MoveTypeChallenge {
 		// Handle in another way
-		return handleChallenge(ctx, entGame, userStore, listStatStore, timeRemaining, userID)
+		return handleChallenge(ctx, entGame, gameStore, userStore, listStatStore, timeRemaining, userID)
 	}
 
 	oldTurnLength := len(entGame.Game.History().Events)
@@ -332,7 +328,7 @@ func PlayMove(ctx context.Context, entGame *entity.Game, userStore user.Store,
 		entGame.SendChange(wrapped)
 	}
 	if playing == macondopb.PlayState_GAME_OVER {
-		performEndgameDuties(ctx, entGame, userStore, listStatStore)
+		return performEndgameDuties(ctx, entGame, gameStore, listStatStore)
 	}
 	return nil
 }
@@ -352,6 +348,23 @@ func HandleEvent(ctx context.Context, gameStore GameStore, userStore user.Store,
 	if entGame.Game.Playing() == macondopb.PlayState_GAME_OVER {
 		return entGame, errGameNotActive
 	}
+	if entGame.Adjourned {
+		return entGame, errGameAdjourned
+	}
+	if cge.Type == pb.ClientGameplayEvent_ABORT {
+		// Either player can abort, whether or not they're on turn.
+		if playerIndex(entGame, userID) == -1 {
+			return entGame, errNotInGame
+		}
+		// A tournament game is part of a round, and has to be played.
+		if entGame.GameReq.TournamentId != "" {
+			return entGame, errCantAbortTournament
+		}
+		if !abortable(entGame) {
+			return entGame, errCantAbort
+		}
+		return entGame, setAborted(ctx, entGame, gameStore, userStore, listStatStore)
+	}
 	onTurn := entGame.Game.PlayerOnTurn()
 
 	// Ensure that it is actually the correct player's turn
@@ -378,17 +391,22 @@ func HandleEvent(ctx context.Context, gameStore GameStore, userStore user.Store,
 		entGame.History().Winner = int32(winner)
 		entGame.SetWinnerIdx(winner)
 		entGame.SetLoserIdx(1 - winner)
-		performEndgameDuties(ctx, entGame, userStore, listStatStore)
+		err = performEndgameDuties(ctx, entGame, gameStore, listStatStore)
+		if err != nil {
+			return entGame, err
+		}
 	} else {
 		m, err := clientEventToMove(cge, &entGame.Game)
 		if err != nil {
 			return entGame, err
 		}
 
-		err = PlayMove(ctx, entGame, userStore, listStatStore, userID, onTurn, timeRemaining, m)
+		err = PlayMove(ctx, entGame, gameStore, userStore, listStatStore, userID, onTurn, timeRemaining, m)
 		if err != nil {
 			return entGame, err
 		}
+		// A pending takeback request was about an earlier position.
+		entGame.TakebackRequestedBy = ""
 	}
 
 	err = gameStore.Set(ctx, entGame)
@@ -397,7 +415,7 @@ func HandleEvent(ctx context.Context, gameStore GameStore, userStore user.Store,
 	}
 
 	if entGame.GameEndReason != pb.GameEndReason_NONE {
-		// Game is over; unload
+		// Game is over; unload.
 		gameStore.Unload(ctx, entGame.GameID())
 	}
 	return entGame, nil
@@ -421,6 +439,10 @@ func TimedOut(ctx context.Context, gameStore GameStore, userStore user.Store,
 		log.Debug().Msg("game not active anymore.")
 		return nil
 	}
+	if entGame.Adjourned {
+		// The clocks are frozen.
+		return errGameAdjourned
+	}
 	onTurn := entGame.Game.PlayerOnTurn()
 
 	// Ensure that it is actually the correct player's turn
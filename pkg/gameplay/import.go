@@ -0,0 +1,170 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/game"
+	"github.com/domino14/macondo/gcgio"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+
+	"github.com/domino14/liwords/pkg/apiserver"
+	"github.com/domino14/liwords/pkg/catalog"
+	"github.com/domino14/liwords/pkg/config"
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/user"
+	gspb "github.com/domino14/liwords/rpc/api/proto/game_service"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+var (
+	errIncompleteGCG = errors.New("the GCG must have two players and at least one move")
+	errNoLexicon     = errors.New("please pick the lexicon the game was played with")
+	errGCGNotOver    = errors.New("only finished games can be imported")
+)
+
+// ImportGCG creates a finished game from a GCG. Every move is replayed, so
+// it must be a legal move; with the VOID challenge rule, every word must
+// also be in the lexicon. The game is unrated, and none of the players'
+// stats change. The players in the GCG aren't our users, so the game is
+// stored as being between the user who imported it and themselves.
+func ImportGCG(ctx context.Context, gameStore GameStore, userStore user.Store,
+	cfg *config.Config, cat *catalog.Catalog, userID, gcg, lexicon string,
+	challengeRule macondopb.ChallengeRule) (*entity.Game, error) {
+
+	u, err := userStore.GetByUUID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The parser falls back on the default lexicon if the GCG doesn't have
+	// one; it picks the letter distribution from it.
+	parseCfg := cfg.MacondoConfig
+	parseCfg.DefaultLexicon = lexicon
+	hist, err := parseGCG(&parseCfg, gcg)
+	if err != nil {
+		return nil, err
+	}
+	if lexicon == "" {
+		lexicon = hist.Lexicon
+	}
+	if lexicon == "" {
+		return nil, errNoLexicon
+	}
+	if cat != nil {
+		if err = cat.Validate(lexicon, catalog.Language(lexicon)); err != nil {
+			return nil, err
+		}
+	}
+
+	req := &pb.GameRequest{
+		Lexicon: lexicon,
+		Rules: &pb.GameRules{
+			BoardLayoutName:        entity.CrosswordGame,
+			LetterDistributionName: catalog.Language(lexicon),
+			VariantName:            string(entity.VarClassic),
+		},
+		ChallengeRule: challengeRule,
+		GameMode:      pb.GameMode_REAL_TIME,
+		RatingMode:    pb.RatingMode_CASUAL,
+		ImportedBy:    u.UUID,
+	}
+	rules, err := gameRules(&cfg.MacondoConfig, req)
+	if err != nil {
+		return nil, err
+	}
+
+	hist.Lexicon = lexicon
+	hist.ChallengeRule = challengeRule
+	// The game gets an ID of our own, whatever the GCG's was.
+	hist.Uid = ""
+	hist.IdAuth = ""
+	// Replaying the game resets the play state; the parser already worked
+	// out whether the game is over.
+	playState := hist.PlayState
+	mcg, err := game.NewFromHistory(hist, rules, len(hist.Events))
+	if err != nil {
+		return nil, err
+	}
+	if playState != macondopb.PlayState_GAME_OVER {
+		return nil, errGCGNotOver
+	}
+	mcg.SetPlaying(playState)
+	hist.PlayState = playState
+
+	entGame := entity.NewGame(mcg, req)
+	entGame.PlayerDBIDs = [2]uint{u.ID, u.ID}
+	entGame.ResetTimersAndStart()
+	entGame.SetGameEndReason(importedEndReason(hist))
+	switch hist.Winner {
+	case 0, 1:
+		entGame.SetWinnerIdx(int(hist.Winner))
+		entGame.SetLoserIdx(1 - int(hist.Winner))
+	default:
+		entGame.SetWinnerIdx(-1)
+		entGame.SetLoserIdx(-1)
+	}
+
+	if err = gameStore.Create(ctx, entGame); err != nil {
+		return nil, err
+	}
+	log.Info().Str("gameID", entGame.GameID()).Str("userID", userID).Str("lexicon", lexicon).
+		Int("events", len(hist.Events)).Msg("imported-gcg")
+	return entGame, nil
+}
+
+// parseGCG parses a GCG into a game history. Macondo's parser panics on a
+// GCG without any moves, so that is turned into an error.
+func parseGCG(cfg *macondoconfig.Config, gcg string) (hist *macondopb.GameHistory, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Debug().Interface("panic", r).Msg("parse-gcg-panic")
+			hist, err = nil, errIncompleteGCG
+		}
+	}()
+	return gcgio.ParseGCGFromReader(cfg, strings.NewReader(gcg))
+}
+
+// importedEndReason returns how an imported game ended. A GCG only records
+// penalties for the tiles left on both racks if the game ended on scoreless
+// turns; otherwise someone went out.
+func importedEndReason(hist *macondopb.GameHistory) pb.GameEndReason {
+	evts := hist.Events
+	if len(evts) > 0 && evts[len(evts)-1].Type == macondopb.GameEvent_END_RACK_PENALTY {
+		return pb.GameEndReason_CONSECUTIVE_ZEROES
+	}
+	return pb.GameEndReason_STANDARD
+}
+
+// ImportService is a Twirp service that imports finished games from GCGs,
+// so that they can be reviewed like any other game.
+type ImportService struct {
+	cfg       *config.Config
+	userStore user.Store
+	gameStore GameStore
+	catalog   *catalog.Catalog
+}
+
+// NewImportService creates a Twirp ImportService
+func NewImportService(cfg *config.Config, u user.Store, gs GameStore,
+	cat *catalog.Catalog) *ImportService {
+	return &ImportService{cfg, u, gs, cat}
+}
+
+// ImportGCG imports a game for the logged-in user.
+func (imp *ImportService) ImportGCG(ctx context.Context, req *gspb.ImportGCGRequest) (*gspb.ImportGCGResponse, error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entGame, err := ImportGCG(ctx, imp.gameStore, imp.userStore, imp.cfg, imp.catalog,
+		sess.UserUUID, req.Gcg, req.Lexicon, req.ChallengeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &gspb.ImportGCGResponse{GameId: entGame.GameID()}, nil
+}
@@ -0,0 +1,67 @@
+package gameplay
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/user"
+	gspb "github.com/domino14/liwords/rpc/api/proto/game_service"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+)
+
+func TestImportGCG(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+	ctx := context.Background()
+	jesse := "3xpEkpRAy3AizbVmDg3kdi"
+
+	gcg, err := ioutil.ReadFile("./testdata/import/doug_v_emely.gcg")
+	is.NoErr(err)
+
+	// Emely's TIL.. is a phony that was challenged off, so the game can't
+	// have been played with the void rule.
+	_, err = ImportGCG(ctx, gstore, ustore, cfg, nil, jesse, string(gcg), "CSW19",
+		macondopb.ChallengeRule_VOID)
+	is.True(err != nil)
+
+	g, err := ImportGCG(ctx, gstore, ustore, cfg, nil, jesse, string(gcg), "CSW19",
+		macondopb.ChallengeRule_DOUBLE)
+	is.NoErr(err)
+	gstore.Unload(ctx, g.GameID())
+
+	saved, err := gstore.Get(ctx, g.GameID())
+	is.NoErr(err)
+	is.Equal(saved.Playing(), macondopb.PlayState_GAME_OVER)
+	is.Equal(saved.GameEndReason, pb.GameEndReason_STANDARD)
+	is.Equal(saved.WinnerIdx, 0)
+	is.Equal(saved.PointsFor(0), 451)
+	is.Equal(saved.PointsFor(1), 345)
+	is.Equal(saved.CreationRequest().RatingMode, pb.RatingMode_CASUAL)
+	is.Equal(saved.CreationRequest().ImportedBy, jesse)
+
+	gs := NewGameService(ustore, gstore, nil, nil)
+	meta, err := gs.GetMetadata(ctx, &gspb.GameInfoRequest{GameId: g.GameID()})
+	is.NoErr(err)
+	is.True(meta.Done)
+	is.Equal(meta.ImportedBy, jesse)
+	is.Equal(meta.Players[0].Nickname, "doug")
+	is.Equal(meta.Players[1].Nickname, "emely")
+
+	ustore.(*user.DBStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
+
+func TestParseGCGWithoutMoves(t *testing.T) {
+	is := is.New(t)
+	_, err := parseGCG(&DefaultConfig, "#player1 doug doug\n#player2 emely emely\n")
+	is.Equal(err, errIncompleteGCG)
+}
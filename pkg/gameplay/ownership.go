@@ -0,0 +1,185 @@
+package gameplay
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// A GameLeaseStore hands out leases on games to API nodes, so that only one
+// node at a time holds any given game in memory and changes it.
+type GameLeaseStore interface {
+	// Acquire acquires (or extends) the lease on the game for the node,
+	// unless another node holds it. It returns the node holding the lease.
+	Acquire(ctx context.Context, gameID, nodeID string, ttl time.Duration) (string, error)
+	// Release gives up the node's lease on the game, if it holds it.
+	Release(ctx context.Context, gameID, nodeID string) error
+}
+
+// A GameOwner says whether this node is the one responsible for a game.
+type GameOwner interface {
+	// Holds returns whether this node holds the lease on the game. It never
+	// acquires it.
+	Holds(gameID string) bool
+	// Owns returns whether this node owns the game, acquiring it if no live
+	// node does.
+	Owns(ctx context.Context, gameID string) bool
+}
+
+// Ownership keeps track of the games that this node holds the lease on. The
+// node that owns a game is the only one that should handle any event that
+// changes it; other nodes must forward those events to the owner.
+type Ownership struct {
+	sync.Mutex
+	nodeID string
+	ttl    time.Duration
+	owned  map[string]bool
+
+	leases    GameLeaseStore
+	gameStore GameStore
+	// onAcquire is called whenever this node acquires a game that it
+	// didn't own before.
+	onAcquire func(ctx context.Context, gameID string)
+}
+
+// NewOwnership creates the game ownership of the given node. Leases last for
+// the given TTL unless they're renewed.
+func NewOwnership(nodeID string, ttl time.Duration, leases GameLeaseStore,
+	gameStore GameStore) *Ownership {
+
+	return &Ownership{
+		nodeID:    nodeID,
+		ttl:       ttl,
+		owned:     make(map[string]bool),
+		leases:    leases,
+		gameStore: gameStore,
+	}
+}
+
+// NodeID is the ID of this node.
+func (o *Ownership) NodeID() string {
+	return o.nodeID
+}
+
+// OnAcquire sets a function to be called every time this node acquires a
+// game it didn't own before.
+func (o *Ownership) OnAcquire(f func(ctx context.Context, gameID string)) {
+	o.onAcquire = f
+}
+
+// Owner returns the node that owns the game. If nobody does, this node
+// acquires it.
+func (o *Ownership) Owner(ctx context.Context, gameID string) (string, error) {
+	owner, err := o.leases.Acquire(ctx, gameID, o.nodeID, o.ttl)
+	if err != nil {
+		// We can't tell whether we still own it; assume the worst.
+		o.Lock()
+		delete(o.owned, gameID)
+		o.Unlock()
+		o.gameStore.Unload(ctx, gameID)
+		return "", err
+	}
+	mine := owner == o.nodeID
+	o.Lock()
+	had := o.owned[gameID]
+	if mine {
+		o.owned[gameID] = true
+	} else {
+		delete(o.owned, gameID)
+	}
+	o.Unlock()
+
+	if !mine || !had {
+		// Unless we've owned it all along, whatever copy of the game we
+		// have may be stale, since another node has been changing it.
+		o.gameStore.Unload(ctx, gameID)
+	}
+	if mine && !had {
+		log.Debug().Str("gameID", gameID).Str("nodeID", o.nodeID).Msg("acquired-game")
+		if o.onAcquire != nil {
+			o.onAcquire(ctx, gameID)
+		}
+	}
+	return owner, nil
+}
+
+// Claim acquires a game that this node just created. Nobody else can have
+// heard of it yet, so unlike Owner, it doesn't reload the game.
+func (o *Ownership) Claim(ctx context.Context, gameID string) error {
+	owner, err := o.leases.Acquire(ctx, gameID, o.nodeID, o.ttl)
+	if err != nil {
+		return err
+	}
+	if owner != o.nodeID {
+		return fmt.Errorf("new game %v is already owned by node %v", gameID, owner)
+	}
+	o.Lock()
+	o.owned[gameID] = true
+	o.Unlock()
+	return nil
+}
+
+// Owns returns whether this node owns the game, acquiring it if nobody
+// does. If the lease store can't be reached, it assumes not.
+func (o *Ownership) Owns(ctx context.Context, gameID string) bool {
+	owner, err := o.Owner(ctx, gameID)
+	if err != nil {
+		log.Err(err).Str("gameID", gameID).Msg("game-owner")
+		return false
+	}
+	return owner == o.nodeID
+}
+
+// Holds returns whether this node holds the lease on the game, as of the last
+// time it acquired or renewed it. Unlike Owns, it doesn't acquire games that
+// nobody owns; those are acquired by whichever node gets the next message
+// about them, or sweeps them up first (see Adjudicator.Rebuild).
+func (o *Ownership) Holds(gameID string) bool {
+	o.Lock()
+	defer o.Unlock()
+	return o.owned[gameID]
+}
+
+// Release gives up this node's lease on the game.
+func (o *Ownership) Release(ctx context.Context, gameID string) error {
+	o.Lock()
+	delete(o.owned, gameID)
+	o.Unlock()
+	o.gameStore.Unload(ctx, gameID)
+	return o.leases.Release(ctx, gameID, o.nodeID)
+}
+
+// Renew extends the leases on all the games this node owns. Games whose
+// lease was lost (because it had expired and another node took it) are
+// dropped.
+func (o *Ownership) Renew(ctx context.Context) {
+	for _, id := range o.ownedIDs() {
+		o.Owns(ctx, id)
+	}
+}
+
+// ReleaseAll gives up every lease this node holds, and returns the IDs of the
+// games it owned.
+func (o *Ownership) ReleaseAll(ctx context.Context) []string {
+	ids := o.ownedIDs()
+	for _, id := range ids {
+		err := o.Release(ctx, id)
+		if err != nil {
+			log.Err(err).Str("gameID", id).Msg("release-game")
+		}
+	}
+	return ids
+}
+
+func (o *Ownership) ownedIDs() []string {
+	o.Lock()
+	defer o.Unlock()
+	ids := make([]string, 0, len(o.owned))
+	for id := range o.owned {
+		ids = append(ids, id)
+	}
+	return ids
+}
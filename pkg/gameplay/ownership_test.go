@@ -0,0 +1,85 @@
+package gameplay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+type fakeLeaseStore struct {
+	owners map[string]string
+}
+
+func (f *fakeLeaseStore) Acquire(ctx context.Context, gameID, nodeID string,
+	ttl time.Duration) (string, error) {
+
+	if owner, ok := f.owners[gameID]; ok {
+		return owner, nil
+	}
+	f.owners[gameID] = nodeID
+	return nodeID, nil
+}
+
+func (f *fakeLeaseStore) Release(ctx context.Context, gameID, nodeID string) error {
+	if f.owners[gameID] == nodeID {
+		delete(f.owners, gameID)
+	}
+	return nil
+}
+
+// unloadRecorder is a GameStore that only keeps track of what was unloaded.
+type unloadRecorder struct {
+	GameStore
+	unloaded []string
+}
+
+func (u *unloadRecorder) Unload(ctx context.Context, id string) {
+	u.unloaded = append(u.unloaded, id)
+}
+
+func TestOwnershipAcquiresAndReloads(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	leases := &fakeLeaseStore{owners: map[string]string{}}
+	gs := &unloadRecorder{}
+	a := NewOwnership("a", time.Minute, leases, gs)
+	b := NewOwnership("b", time.Minute, leases, gs)
+	adopted := []string{}
+	a.OnAcquire(func(ctx context.Context, id string) { adopted = append(adopted, id) })
+
+	is.NoErr(b.Claim(ctx, "new"))
+	is.True(a.Claim(ctx, "new") != nil)
+	is.True(!a.Owns(ctx, "new"))
+	is.True(b.Holds("new"))
+
+	// Asking whether it holds a game doesn't acquire it.
+	is.True(!a.Holds("old"))
+	is.Equal(len(adopted), 0)
+	_, ok := leases.owners["old"]
+	is.True(!ok)
+
+	// Nobody owns this one, so a takes it, and throws away whatever copy it
+	// had in case it's stale.
+	gs.unloaded = nil
+	is.True(a.Owns(ctx, "old"))
+	is.True(a.Holds("old"))
+	is.Equal(adopted, []string{"old"})
+	is.Equal(gs.unloaded, []string{"old"})
+
+	// Now that a owns it, it keeps its copy.
+	gs.unloaded = nil
+	is.True(a.Owns(ctx, "old"))
+	is.Equal(adopted, []string{"old"})
+	is.Equal(len(gs.unloaded), 0)
+	owner, err := b.Owner(ctx, "old")
+	is.NoErr(err)
+	is.Equal(owner, "a")
+
+	// a shuts down, and b takes over.
+	is.Equal(a.ReleaseAll(ctx), []string{"old"})
+	is.True(!a.Holds("old"))
+	is.True(b.Owns(ctx, "old"))
+	is.True(!a.Owns(ctx, "old"))
+}
@@ -0,0 +1,214 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/autopair"
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// QuickPairUnlimitedRating is the maximum preferred rating of a user who
+// didn't ask for one.
+const QuickPairUnlimitedRating = 100000
+
+// A QuickPairMatch is a pair of users matched up by the quick pairer. The
+// first user is the one that has been waiting the longest.
+type QuickPairMatch struct {
+	GameRequest *pb.GameRequest
+	UserIDs     [2]string
+	ConnIDs     [2]string
+}
+
+// A QuickPairStore keeps the quick-pair pools, so that every API node sees
+// the same ones.
+type QuickPairStore interface {
+	// Join puts the entrant in their pool, taking them out of any other pool
+	// they were in. The store sets their Seq, and they start with no misses.
+	Join(ctx context.Context, e *entity.QuickPairEntrant) error
+	// Leave takes the user out of whatever pool they were in. It returns
+	// whether they were in one.
+	Leave(ctx context.Context, userID string) (bool, error)
+	// LeaveConn takes whoever joined a pool from the given connection out of
+	// it. It returns whether anyone was.
+	LeaveConn(ctx context.Context, connID string) (bool, error)
+	// Entrants returns everyone waiting, in the order they joined.
+	Entrants(ctx context.Context) ([]*entity.QuickPairEntrant, error)
+	// Settle takes the paired entrants out of their pools, and counts a miss
+	// for the missed ones. Entrants who left or joined again since they were
+	// listed are left alone, and so are the pairs they're in. It returns the
+	// pairs that were taken out.
+	Settle(ctx context.Context, pairs [][2]*entity.QuickPairEntrant,
+		missed []*entity.QuickPairEntrant) ([][2]*entity.QuickPairEntrant, error)
+	// LockPairing returns true to the first caller in every period, and false
+	// to everyone else, so that the pools are only paired once per period.
+	LockPairing(ctx context.Context, period time.Duration) (bool, error)
+}
+
+// A QuickPairer pairs up the users in every quick-pair pool with autopair.
+// Pair is called every interval by every API node, but the pools are only
+// paired by one of them every time.
+type QuickPairer struct {
+	store    QuickPairStore
+	interval time.Duration
+}
+
+// NewQuickPairer creates a QuickPairer for the pools in the store, which are
+// paired every interval.
+func NewQuickPairer(store QuickPairStore, interval time.Duration) *QuickPairer {
+	return &QuickPairer{store: store, interval: interval}
+}
+
+// quickPairKey is the same for game requests that only differ in things
+// that the players don't get to choose, like the request ID.
+func quickPairKey(req *pb.GameRequest) string {
+	rules := req.Rules
+	if rules == nil {
+		rules = &pb.GameRules{}
+	}
+	return fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", req.Lexicon,
+		rules.BoardLayoutName, rules.LetterDistributionName, rules.VariantName,
+		req.InitialTimeSeconds, req.IncrementSeconds, req.MaxOvertimeMinutes,
+		req.ChallengeRule, req.GameMode, req.RatingMode)
+}
+
+// Join puts the user in the pool for the given game request, taking them out
+// of any other pool they were in. The preferred ratings are those they'd
+// like their opponent to have; a zero maximum means there's no maximum.
+func (q *QuickPairer) Join(ctx context.Context, userID, connID string, rating, minRating,
+	maxRating int, req *pb.GameRequest) error {
+
+	if req.PlayerVsBot {
+		return errors.New("you can't quick-pair against a bot")
+	}
+	if req.TournamentId != "" {
+		return errors.New("tournament games are paired by the director")
+	}
+	if maxRating == 0 {
+		maxRating = QuickPairUnlimitedRating
+	}
+	if minRating > maxRating {
+		return errors.New("the minimum rating can't be higher than the maximum rating")
+	}
+	gameReq := proto.Clone(req).(*pb.GameRequest)
+	gameReq.RequestId = ""
+	return q.store.Join(ctx, &entity.QuickPairEntrant{
+		UserID:      userID,
+		ConnID:      connID,
+		Pool:        quickPairKey(req),
+		GameRequest: gameReq,
+		Rating:      rating,
+		MinRating:   minRating,
+		MaxRating:   maxRating,
+	})
+}
+
+// Leave takes the user out of whatever pool they were in. It returns whether
+// they were in one.
+func (q *QuickPairer) Leave(ctx context.Context, userID string) (bool, error) {
+	return q.store.Leave(ctx, userID)
+}
+
+// LeaveConn takes whoever joined a pool from the given connection out of it.
+// It returns whether anyone was.
+func (q *QuickPairer) LeaveConn(ctx context.Context, connID string) (bool, error) {
+	return q.store.LeaveConn(ctx, connID)
+}
+
+// pools groups the entrants by pool, keeping them in the order they joined.
+// It returns the pools' keys in order too.
+func pools(entrants []*entity.QuickPairEntrant) ([]string, map[string][]*entity.QuickPairEntrant) {
+	byPool := make(map[string][]*entity.QuickPairEntrant)
+	for _, e := range entrants {
+		byPool[e.Pool] = append(byPool[e.Pool], e)
+	}
+	keys := make([]string, 0, len(byPool))
+	for k := range byPool {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, byPool
+}
+
+// Pair pairs up the users in every pool, and takes the ones that got a match
+// out of their pool. Everyone left over has missed another chance to be
+// paired, so they're preferred next time. It returns no matches if another
+// node already paired the pools this interval.
+func (q *QuickPairer) Pair(ctx context.Context) ([]*QuickPairMatch, error) {
+	// Leave some slack, so that the nodes' tickers don't have to line up.
+	locked, err := q.store.LockPairing(ctx, q.interval-q.interval/10)
+	if err != nil || !locked {
+		return nil, err
+	}
+	entrants, err := q.store.Entrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, byPool := pools(entrants)
+	pairs := [][2]*entity.QuickPairEntrant{}
+	missed := []*entity.QuickPairEntrant{}
+	for _, key := range keys {
+		pool := byPool[key]
+		if len(pool) < 2 {
+			// Nobody to pair them with; autopair would only have counted
+			// the miss.
+			missed = append(missed, pool[0])
+			continue
+		}
+		members := make([]*entity.PoolMember, len(pool))
+		for i, e := range pool {
+			members[i] = entity.NewPoolMember(i+1, e.Rating, e.MinRating, e.MaxRating, nil)
+			members[i].Misses = e.Misses
+		}
+		mates, err := autopair.Autopair(members)
+		if err != nil {
+			return nil, err
+		}
+		for i, m := range mates {
+			if m == -1 {
+				missed = append(missed, pool[i])
+				continue
+			}
+			if m < i {
+				continue
+			}
+			pairs = append(pairs, [2]*entity.QuickPairEntrant{pool[i], pool[m]})
+		}
+	}
+	settled, err := q.store.Settle(ctx, pairs, missed)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]*QuickPairMatch, len(settled))
+	for i, p := range settled {
+		matches[i] = &QuickPairMatch{
+			GameRequest: proto.Clone(p[0].GameRequest).(*pb.GameRequest),
+			UserIDs:     [2]string{p[0].UserID, p[1].UserID},
+			ConnIDs:     [2]string{p[0].ConnID, p[1].ConnID},
+		}
+	}
+	return matches, nil
+}
+
+// Pools returns the number of users waiting in every pool.
+func (q *QuickPairer) Pools(ctx context.Context) (*pb.QuickPairPools, error) {
+	entrants, err := q.store.Entrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, byPool := pools(entrants)
+	ret := &pb.QuickPairPools{Pools: []*pb.QuickPairPools_Pool{}}
+	for _, key := range keys {
+		ret.Pools = append(ret.Pools, &pb.QuickPairPools_Pool{
+			GameRequest: byPool[key][0].GameRequest,
+			Size:        int32(len(byPool[key])),
+		})
+	}
+	return ret, nil
+}
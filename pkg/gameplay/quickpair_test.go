@@ -0,0 +1,173 @@
+package gameplay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stores/quickpair"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+func quickPairReq(initialTime int32) *pb.GameRequest {
+	return &pb.GameRequest{
+		Lexicon:            "NWL18",
+		Rules:              &pb.GameRules{BoardLayoutName: "CrosswordGame"},
+		InitialTimeSeconds: initialTime,
+		RatingMode:         pb.RatingMode_RATED,
+	}
+}
+
+// entrants returns everyone waiting in the pool for the request.
+func entrants(store QuickPairStore, req *pb.GameRequest) []*entity.QuickPairEntrant {
+	all, _ := store.Entrants(context.Background())
+	ret := []*entity.QuickPairEntrant{}
+	for _, e := range all {
+		if e.Pool == quickPairKey(req) {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+func TestQuickPairOnlyPairsWithinAPool(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	store := quickpair.NewMemoryStore()
+	q := NewQuickPairer(store, 0)
+	is.NoErr(q.Join(ctx, "a", "ca", 1500, 0, 0, quickPairReq(900)))
+	is.NoErr(q.Join(ctx, "b", "cb", 1500, 0, 0, quickPairReq(300)))
+	req := quickPairReq(900)
+	req.RequestId = "some-seek"
+	is.NoErr(q.Join(ctx, "c", "cc", 1500, 0, 0, req))
+
+	pools, err := q.Pools(ctx)
+	is.NoErr(err)
+	is.Equal(len(pools.Pools), 2)
+
+	matches, err := q.Pair(ctx)
+	is.NoErr(err)
+	is.Equal(len(matches), 1)
+	is.Equal(matches[0].UserIDs, [2]string{"a", "c"})
+	is.Equal(matches[0].ConnIDs, [2]string{"ca", "cc"})
+	is.Equal(matches[0].GameRequest.InitialTimeSeconds, int32(900))
+	is.Equal(matches[0].GameRequest.RequestId, "")
+
+	// b is still waiting, and is more likely to get paired next time.
+	pools, err = q.Pools(ctx)
+	is.NoErr(err)
+	is.Equal(len(pools.Pools), 1)
+	is.Equal(pools.Pools[0].Size, int32(1))
+	is.Equal(entrants(store, quickPairReq(300))[0].Misses, 1)
+	left, err := q.Leave(ctx, "a")
+	is.NoErr(err)
+	is.True(!left)
+	left, err = q.Leave(ctx, "b")
+	is.NoErr(err)
+	is.True(left)
+	pools, err = q.Pools(ctx)
+	is.NoErr(err)
+	is.Equal(len(pools.Pools), 0)
+}
+
+func TestQuickPairPrefersCloseRatings(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	store := quickpair.NewMemoryStore()
+	q := NewQuickPairer(store, 0)
+	is.NoErr(q.Join(ctx, "a", "ca", 1500, 0, 0, quickPairReq(900)))
+	is.NoErr(q.Join(ctx, "b", "cb", 2000, 0, 0, quickPairReq(900)))
+	is.NoErr(q.Join(ctx, "c", "cc", 1550, 0, 0, quickPairReq(900)))
+	is.NoErr(q.Join(ctx, "d", "cd", 1950, 0, 0, quickPairReq(900)))
+	is.NoErr(q.Join(ctx, "e", "ce", 1800, 0, 0, quickPairReq(900)))
+
+	matches, err := q.Pair(ctx)
+	is.NoErr(err)
+	is.Equal(len(matches), 2)
+	is.Equal(matches[0].UserIDs, [2]string{"a", "c"})
+	is.Equal(matches[1].UserIDs, [2]string{"b", "d"})
+
+	// e is the odd one out.
+	left := entrants(store, quickPairReq(900))
+	is.Equal(len(left), 1)
+	is.Equal(left[0].UserID, "e")
+	is.Equal(left[0].Misses, 1)
+}
+
+func TestQuickPairJoinMovesBetweenPools(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	q := NewQuickPairer(quickpair.NewMemoryStore(), 0)
+	is.NoErr(q.Join(ctx, "a", "ca", 1500, 0, 0, quickPairReq(900)))
+	is.NoErr(q.Join(ctx, "a", "ca", 1500, 0, 0, quickPairReq(300)))
+	pools, err := q.Pools(ctx)
+	is.NoErr(err)
+	is.Equal(len(pools.Pools), 1)
+	is.Equal(pools.Pools[0].GameRequest.InitialTimeSeconds, int32(300))
+
+	left, err := q.LeaveConn(ctx, "ca")
+	is.NoErr(err)
+	is.True(left)
+	pools, err = q.Pools(ctx)
+	is.NoErr(err)
+	is.Equal(len(pools.Pools), 0)
+
+	vsBot := quickPairReq(300)
+	vsBot.PlayerVsBot = true
+	is.True(q.Join(ctx, "a", "ca", 1500, 0, 0, vsBot) != nil)
+	is.True(q.Join(ctx, "a", "ca", 1500, 1600, 1400, quickPairReq(300)) != nil)
+}
+
+func TestQuickPairOncePerInterval(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	store := quickpair.NewMemoryStore()
+	// Two nodes share the pools.
+	q1 := NewQuickPairer(store, time.Hour)
+	q2 := NewQuickPairer(store, time.Hour)
+	is.NoErr(q1.Join(ctx, "a", "ca", 1500, 0, 0, quickPairReq(900)))
+	is.NoErr(q2.Join(ctx, "b", "cb", 1500, 0, 0, quickPairReq(900)))
+	is.NoErr(q2.Join(ctx, "c", "cc", 1500, 0, 0, quickPairReq(300)))
+
+	matches, err := q2.Pair(ctx)
+	is.NoErr(err)
+	is.Equal(len(matches), 1)
+	is.Equal(matches[0].UserIDs, [2]string{"a", "b"})
+	// The other node doesn't pair them again, or count another miss.
+	matches, err = q1.Pair(ctx)
+	is.NoErr(err)
+	is.Equal(len(matches), 0)
+	is.Equal(entrants(store, quickPairReq(300))[0].Misses, 1)
+}
+
+func TestQuickPairSkipsWhoeverRejoined(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	store := quickpair.NewMemoryStore()
+	is.NoErr(store.Join(ctx, &entity.QuickPairEntrant{UserID: "a", Pool: "p"}))
+	is.NoErr(store.Join(ctx, &entity.QuickPairEntrant{UserID: "b", Pool: "p"}))
+	is.NoErr(store.Join(ctx, &entity.QuickPairEntrant{UserID: "c", Pool: "q"}))
+	listed, err := store.Entrants(ctx)
+	is.NoErr(err)
+	is.Equal(len(listed), 3)
+
+	// b asks for another game while a and b are being paired, and c leaves.
+	is.NoErr(store.Join(ctx, &entity.QuickPairEntrant{UserID: "b", Pool: "q"}))
+	_, err = store.Leave(ctx, "c")
+	is.NoErr(err)
+	settled, err := store.Settle(ctx, [][2]*entity.QuickPairEntrant{{listed[0], listed[1]}},
+		[]*entity.QuickPairEntrant{listed[2]})
+	is.NoErr(err)
+	is.Equal(len(settled), 0)
+
+	left, err := store.Entrants(ctx)
+	is.NoErr(err)
+	is.Equal(len(left), 2)
+	is.Equal(left[0].UserID, "a")
+	is.Equal(left[1].UserID, "b")
+	is.Equal(left[1].Pool, "q")
+	is.Equal(left[1].Misses, 0)
+}
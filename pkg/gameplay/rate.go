@@ -12,17 +12,50 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// Rate rates the game, and saves the players' new ratings. They're returned
+// by username.
 func Rate(ctx context.Context, scores map[string]int32, g *entity.Game,
-	winner string, userStore user.Store, now int64) (map[string]int32, error) {
+	winner string, userStore user.Store, now int64) (map[string]entity.SingleRating, error) {
 
 	// Fetch the users from the store.
-	users := []*entity.User{}
-	usernames := []string{}
+	players := map[string]*entity.User{}
 	for username := range scores {
 		p, err := userStore.Get(ctx, username)
 		if err != nil {
 			return nil, err
 		}
+		players[username] = p
+	}
+	newRatings, err := rateFrom(scores, g, winner, players, now)
+	if err != nil {
+		return nil, err
+	}
+	ratingKey, err := g.RatingKey()
+	if err != nil {
+		return nil, err
+	}
+	for username, rating := range newRatings {
+		err = userStore.SetRating(ctx, players[username].UUID, ratingKey, rating)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newRatings, nil
+}
+
+// rateFrom rates the game from the current ratings of the players, who are
+// given by username. Nothing is saved but the rating changes in the game;
+// the new ratings are returned by username.
+func rateFrom(scores map[string]int32, g *entity.Game, winner string,
+	players map[string]*entity.User, now int64) (map[string]entity.SingleRating, error) {
+
+	users := []*entity.User{}
+	usernames := []string{}
+	for username := range scores {
+		p, ok := players[username]
+		if !ok {
+			return nil, errors.New("no such player: " + username)
+		}
 		users = append(users, p)
 		usernames = append(usernames, username)
 	}
@@ -85,29 +118,28 @@ func Rate(ctx context.Context, scores map[string]int32, g *entity.Game,
 		-spread, int(now-rat1.LastGameTimestamp),
 	)
 
-	// Save the new ratings. This should probably be in some sort of
-	// transaction, but ..
-	err = userStore.SetRating(ctx, users[0].UUID, ratingKey, entity.SingleRating{
-		Rating:            p0rat,
-		RatingDeviation:   p0rd,
-		Volatility:        p0v,
-		LastGameTimestamp: now,
-	})
-	if err != nil {
-		return nil, err
+	newRatings := map[string]entity.SingleRating{
+		usernames[0]: {
+			Rating:            p0rat,
+			RatingDeviation:   p0rd,
+			Volatility:        p0v,
+			LastGameTimestamp: now,
+		},
+		usernames[1]: {
+			Rating:            p1rat,
+			RatingDeviation:   p1rd,
+			Volatility:        p1v,
+			LastGameTimestamp: now,
+		},
 	}
-	err = userStore.SetRating(ctx, users[1].UUID, ratingKey, entity.SingleRating{
-		Rating:            p1rat,
-		RatingDeviation:   p1rd,
-		Volatility:        p1v,
-		LastGameTimestamp: now,
-	})
-	if err != nil {
-		return nil, err
+
+	deltas := map[string]int{
+		usernames[0]: int(math.Round(p0rat)) - int(math.Round(rat0.Rating)),
+		usernames[1]: int(math.Round(p1rat)) - int(math.Round(rat1.Rating)),
+	}
+	for pidx, p := range g.History().Players {
+		g.RatingDeltas[pidx] = deltas[p.Nickname]
 	}
 
-	return map[string]int32{
-		usernames[0]: int32(math.Round(p0rat)),
-		usernames[1]: int32(math.Round(p1rat)),
-	}, nil
+	return newRatings, nil
 }
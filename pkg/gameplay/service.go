@@ -2,14 +2,24 @@ package gameplay
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/domino14/macondo/gcgio"
 
+	"github.com/domino14/liwords/pkg/apiserver"
+	"github.com/domino14/liwords/pkg/catalog"
 	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/stats"
 
 	"github.com/domino14/liwords/pkg/user"
 	pb "github.com/domino14/liwords/rpc/api/proto/game_service"
+	realtime "github.com/domino14/liwords/rpc/api/proto/realtime"
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 )
 
@@ -17,13 +27,20 @@ import (
 // metadata, stats, etc. All real-time functionality is handled in
 // gameplay/game.go and related files.
 type GameService struct {
-	userStore user.Store
-	gameStore GameStore
+	userStore       user.Store
+	gameStore       GameStore
+	tournamentStore TournamentStore
+	catalog         *catalog.Catalog
+}
+
+// TournamentStore is used to look up the tournament that a game is part of.
+type TournamentStore interface {
+	Get(ctx context.Context, id string) (*entity.Tournament, error)
 }
 
 // NewGameService creates a Twirp GameService
-func NewGameService(u user.Store, gs GameStore) *GameService {
-	return &GameService{u, gs}
+func NewGameService(u user.Store, gs GameStore, ts TournamentStore, cat *catalog.Catalog) *GameService {
+	return &GameService{u, gs, ts, cat}
 }
 
 // GetMetadata gets metadata for the given game.
@@ -43,6 +60,14 @@ func (gs *GameService) GetMetadata(ctx context.Context, req *pb.GameInfoRequest)
 	ratingKey := entity.ToVariantKey(gamereq.Lexicon, variant, timefmt)
 	playerInfo := []*pb.PlayerInfo{}
 	for _, p := range players {
+		if p.UserId == "" {
+			// The players of imported games aren't necessarily our users.
+			playerInfo = append(playerInfo, &pb.PlayerInfo{
+				Nickname: p.Nickname,
+				FullName: p.RealName,
+			})
+			continue
+		}
 		u, err := gs.userStore.GetByUUID(ctx, p.UserId)
 		if err != nil {
 			return nil, err
@@ -61,6 +86,14 @@ func (gs *GameService) GetMetadata(ctx context.Context, req *pb.GameInfoRequest)
 		}
 		playerInfo = append(playerInfo, pinfo)
 	}
+	var tournamentName string
+	if gamereq.TournamentId != "" {
+		t, err := gs.tournamentStore.Get(ctx, gamereq.TournamentId)
+		if err != nil {
+			return nil, err
+		}
+		tournamentName = t.Name
+	}
 
 	resp := &pb.GameInfoResponse{
 		Players:            playerInfo,
@@ -74,6 +107,8 @@ func (gs *GameService) GetMetadata(ctx context.Context, req *pb.GameInfoRequest)
 		Done:               done,
 		GameEndReason:      entGame.GameEndReason,
 		IncrementSeconds:   gamereq.IncrementSeconds,
+		TournamentName:     tournamentName,
+		ImportedBy:         gamereq.ImportedBy,
 	}
 
 	return resp, nil
@@ -89,9 +124,212 @@ func (gs *GameService) GetGCG(ctx context.Context, req *pb.GCGRequest) (*pb.GCGR
 	if entGame.Playing() != macondopb.PlayState_GAME_OVER {
 		return nil, errors.New("please wait until the game is over to download GCG")
 	}
-	gcg, err := gcgio.GameHistoryToGCG(entGame.History(), true)
+	gcg, err := gcgio.GameHistoryToGCG(gcgHistory(entGame.History()), true)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.GCGResponse{Gcg: gcg}, nil
 }
+
+// gcgHistory returns the history to write a GCG from. The GCG leaves out the
+// pass that comes right before the end rack points, so a note on that pass
+// is moved on to the end rack points; otherwise it would be lost.
+func gcgHistory(hist *macondopb.GameHistory) *macondopb.GameHistory {
+	hist = proto.Clone(hist).(*macondopb.GameHistory)
+	for i := 0; i < len(hist.Events)-1; i++ {
+		evt, next := hist.Events[i], hist.Events[i+1]
+		if evt.Note == "" || next.Type != macondopb.GameEvent_END_RACK_PTS {
+			continue
+		}
+		if evt.Type == macondopb.GameEvent_PASS ||
+			evt.Type == macondopb.GameEvent_UNSUCCESSFUL_CHALLENGE_TURN_LOSS {
+			next.Note = strings.TrimSpace(evt.Note + "\n" + next.Note)
+			evt.Note = ""
+		}
+	}
+	return hist
+}
+
+// GetCorrespondenceGames lists the ongoing correspondence games of the
+// logged-in user.
+func (gs *GameService) GetCorrespondenceGames(ctx context.Context, req *pb.CorrespondenceGamesRequest) (*pb.CorrespondenceGamesResponse, error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	games, err := gs.gameStore.ListActiveCorrespondence(ctx, sess.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CorrespondenceGamesResponse{Games: games}, nil
+}
+
+const (
+	defaultPastGamesLimit = 20
+	maxPastGamesLimit     = 100
+)
+
+// GetPastGames searches a user's finished games.
+func (gs *GameService) GetPastGames(ctx context.Context, req *pb.PastGamesRequest) (*pb.PastGamesResponse, error) {
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, errors.New("the limit and offset can't be negative")
+	}
+	u, err := gs.userStore.Get(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	q := &entity.PastGamesQuery{
+		UserID:     u.UUID,
+		Lexicon:    req.Lexicon,
+		VariantKey: entity.VariantKey(req.VariantKey),
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset),
+	}
+	if q.Limit == 0 {
+		q.Limit = defaultPastGamesLimit
+	} else if q.Limit > maxPastGamesLimit {
+		q.Limit = maxPastGamesLimit
+	}
+	if req.Opponent != "" {
+		opp, err := gs.userStore.Get(ctx, req.Opponent)
+		if err != nil {
+			return nil, err
+		}
+		q.OpponentID = opp.UUID
+	}
+	switch req.RatingFilter {
+	case pb.PastGamesRequest_RATED:
+		mode := realtime.RatingMode_RATED
+		q.RatingMode = &mode
+	case pb.PastGamesRequest_CASUAL:
+		mode := realtime.RatingMode_CASUAL
+		q.RatingMode = &mode
+	}
+	if req.After != 0 {
+		q.After = time.Unix(req.After, 0)
+	}
+	if req.Before != 0 {
+		q.Before = time.Unix(req.Before, 0)
+	}
+
+	games, total, err := gs.gameStore.ListPastGames(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PastGamesResponse{Games: pastGamesToPB(games), Total: int32(total)}, nil
+}
+
+func pastGamesToPB(games []*entity.PastGame) []*pb.PastGame {
+	pbGames := make([]*pb.PastGame, len(games))
+	for i, g := range games {
+		pbGames[i] = &pb.PastGame{
+			GameId:        g.GameID,
+			Opponent:      g.Opponent,
+			Score:         int32(g.Score),
+			OpponentScore: int32(g.OpponentScore),
+			Won:           g.Won,
+			Lost:          g.Lost,
+			GameEndReason: g.GameEndReason,
+			Lexicon:       g.Lexicon,
+			VariantKey:    string(g.VariantKey),
+			Rated:         g.Rated,
+			RatingChange:  int32(g.RatingDelta),
+			EndedAt:       g.EndedAt.Unix(),
+		}
+	}
+	return pbGames
+}
+
+const (
+	defaultRecentGames = 10
+	maxRecentGames     = 50
+)
+
+// GetHeadToHead gets the record of a user against an opponent, along with
+// their most recent games and the sum of their games' stats.
+func (gs *GameService) GetHeadToHead(ctx context.Context, req *pb.HeadToHeadRequest) (*pb.HeadToHeadResponse, error) {
+	if req.NumRecentGames < 0 {
+		return nil, errors.New("the number of recent games can't be negative")
+	}
+	u, err := gs.userStore.Get(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	opp, err := gs.userStore.Get(ctx, req.Opponent)
+	if err != nil {
+		return nil, err
+	}
+	if u.UUID == opp.UUID {
+		return nil, errors.New("pick two different players")
+	}
+	variant := entity.VariantKey(req.VariantKey)
+	h2h, err := gs.gameStore.GetHeadToHead(ctx, u.UUID, opp.UUID, variant)
+	if err != nil {
+		return nil, err
+	}
+
+	numRecent := int(req.NumRecentGames)
+	if numRecent == 0 {
+		numRecent = defaultRecentGames
+	} else if numRecent > maxRecentGames {
+		numRecent = maxRecentGames
+	}
+	// Aborted games aren't part of the record, so they aren't listed either.
+	recent, _, err := gs.gameStore.ListPastGames(ctx, &entity.PastGamesQuery{
+		UserID:            u.UUID,
+		OpponentID:        opp.UUID,
+		VariantKey:        variant,
+		ExcludeEndReasons: []realtime.GameEndReason{realtime.GameEndReason_ABORTED},
+		Limit:             numRecent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// AddStats swaps the players of each game's stats around as needed, so
+	// that the user is always player one.
+	sum := stats.InstantiateNewStats(u.UUID, opp.UUID)
+	for _, st := range h2h.Stats {
+		err = stats.AddStats(sum, st)
+		if err != nil {
+			log.Err(err).Str("userID", u.UUID).Str("opponentID", opp.UUID).Msg("head-to-head-stats")
+		}
+	}
+	statsJSON, err := json.Marshal(sum)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.HeadToHeadResponse{
+		Games:       int32(h2h.Games),
+		Wins:        int32(h2h.Wins),
+		Losses:      int32(h2h.Losses),
+		Draws:       int32(h2h.Draws),
+		TotalSpread: int32(h2h.Spread),
+		RecentGames: pastGamesToPB(recent),
+		StatsJson:   string(statsJSON),
+	}
+	if h2h.Games > 0 {
+		resp.AverageSpread = float64(h2h.Spread) / float64(h2h.Games)
+	}
+	return resp, nil
+}
+
+// GetLexica lists the lexica and letter distributions in the catalog.
+func (gs *GameService) GetLexica(ctx context.Context, req *pb.LexicaRequest) (*pb.LexicaResponse, error) {
+	resp := &pb.LexicaResponse{}
+	for _, l := range gs.catalog.Lexica() {
+		resp.Lexica = append(resp.Lexica, &pb.LexiconInfo{
+			Name:        l.Name,
+			DisplayName: l.DisplayName,
+			Language:    l.Language,
+		})
+	}
+	for _, d := range gs.catalog.LetterDistributions() {
+		resp.LetterDistributions = append(resp.LetterDistributions, &pb.LetterDistributionInfo{
+			Name:        d.Name,
+			DisplayName: d.DisplayName,
+		})
+	}
+	return resp, nil
+}
@@ -3,10 +3,15 @@ package gameplay
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 
 	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
 
+	"github.com/domino14/liwords/pkg/catalog"
 	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/variants"
 )
 
 var errAlreadyOpenReq = errors.New("You already have an open match or seek request")
@@ -18,6 +23,13 @@ type SoughtGameStore interface {
 	GetByConnID(ctx context.Context, connID string) (*entity.SoughtGame, error)
 	Set(context.Context, *entity.SoughtGame) error
 	Delete(ctx context.Context, id string) error
+	// Claim deletes the sought game with the given ID and returns it. Only
+	// one caller can claim any given sought game; everyone else gets an
+	// error, as if it never existed.
+	Claim(ctx context.Context, id string) (*entity.SoughtGame, error)
+	// DeleteExpired deletes the sought games that have been open for too
+	// long, and returns their IDs.
+	DeleteExpired(ctx context.Context) ([]string, error)
 	ListOpenSeeks(ctx context.Context) ([]*entity.SoughtGame, error)
 	ListOpenMatches(ctx context.Context, receiverID string) ([]*entity.SoughtGame, error)
 	ExistsForUser(ctx context.Context, userID string) (bool, error)
@@ -75,8 +87,27 @@ func NewMatchRequest(ctx context.Context, gameStore SoughtGameStore,
 	return sg, nil
 }
 
-// ValidateSoughtGame validates the seek request.
-func ValidateSoughtGame(ctx context.Context, req *pb.GameRequest) error {
+// ValidateSoughtGame validates the seek request. Its lexicon and letter
+// distribution must be in the catalog.
+func ValidateSoughtGame(ctx context.Context, cat *catalog.Catalog, req *pb.GameRequest) error {
+	if req.TournamentId != "" {
+		return errors.New("tournament games are started by the tournament")
+	}
+	if req.Rules == nil {
+		return errors.New("no rules")
+	}
+	if err := cat.Validate(req.Lexicon, req.Rules.LetterDistributionName); err != nil {
+		return err
+	}
+	if err := validateVariant(req); err != nil {
+		return err
+	}
+	if req.GameMode == pb.GameMode_CORRESPONDENCE {
+		return validateCorrespondence(req)
+	}
+	if req.GameMode != pb.GameMode_REAL_TIME {
+		return errors.New("unsupported game mode")
+	}
 	if req.InitialTimeSeconds < 15 {
 		return errors.New("the initial time must be at least 15 seconds")
 	}
@@ -94,3 +125,76 @@ func ValidateSoughtGame(ctx context.Context, req *pb.GameRequest) error {
 	}
 	return nil
 }
+
+// validateVariant makes sure the variant can be played with the requested
+// lexicon and letter distribution. Our bots only know the classic game.
+func validateVariant(req *pb.GameRequest) error {
+	if err := variants.Validate(req.Lexicon, req.Rules); err != nil {
+		return err
+	}
+	variant, err := entity.VariantFromRules(req.Rules)
+	if err != nil {
+		return err
+	}
+	if req.PlayerVsBot && variant != entity.VarClassic {
+		return errors.New("bots can only play the classic game")
+	}
+	return nil
+}
+
+// ValidateRatingRange validates the range of ratings a seek asks for. Zero
+// means there's no limit.
+func ValidateRatingRange(minRating, maxRating int32) error {
+	if minRating < 0 || maxRating < 0 {
+		return errors.New("ratings can't be negative")
+	}
+	if maxRating != 0 && minRating > maxRating {
+		return errors.New("the minimum rating can't be higher than the maximum rating")
+	}
+	return nil
+}
+
+// SeekEligibility returns an error if the user can't accept the seek because
+// their rating is outside of the range the seeker asked for. Users without a
+// rating (anonymous ones) can only accept seeks that don't ask for any.
+func SeekEligibility(sr *pb.SeekRequest, u *entity.User) error {
+	if sr.MinimumRating == 0 && sr.MaximumRating == 0 {
+		return nil
+	}
+	timefmt, variant, err := entity.VariantFromGameReq(sr.GameRequest)
+	if err != nil {
+		return err
+	}
+	relevant := u.GetRelevantRating(entity.ToVariantKey(sr.GameRequest.Lexicon, variant, timefmt))
+	// Provisional ratings end in a question mark; they still count.
+	rating, err := strconv.Atoi(strings.TrimSuffix(relevant, "?"))
+	if err != nil {
+		return errors.New("this seek is only open to rated players")
+	}
+	if int32(rating) < sr.MinimumRating {
+		return fmt.Errorf("this seek is for players rated at least %d; your rating is %d",
+			sr.MinimumRating, rating)
+	}
+	if sr.MaximumRating != 0 && int32(rating) > sr.MaximumRating {
+		return fmt.Errorf("this seek is for players rated at most %d; your rating is %d",
+			sr.MaximumRating, rating)
+	}
+	return nil
+}
+
+// validateCorrespondence validates a correspondence seek. The initial time
+// is the budget for every move, in whole days.
+func validateCorrespondence(req *pb.GameRequest) error {
+	if req.InitialTimeSeconds%entity.SecondsPerDay != 0 {
+		return errors.New("correspondence games must allow a whole number of days per move")
+	}
+	days := req.InitialTimeSeconds / entity.SecondsPerDay
+	if days < entity.CorresMinDaysPerMove || days > entity.CorresMaxDaysPerMove {
+		return fmt.Errorf("correspondence games must allow between %d and %d days per move",
+			entity.CorresMinDaysPerMove, entity.CorresMaxDaysPerMove)
+	}
+	if req.MaxOvertimeMinutes != 0 || req.IncrementSeconds != 0 {
+		return errors.New("correspondence games cannot have increments or overtime")
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package gameplay
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/gaddagmaker"
+
+	"github.com/domino14/liwords/pkg/catalog"
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// testCatalog returns a catalog with a tiny NWL18 in it, and a function that
+// cleans it up.
+func testCatalog(is *is.I) (*catalog.Catalog, func()) {
+	dir, err := ioutil.TempDir("", "catalog")
+	is.NoErr(err)
+	is.NoErr(os.Mkdir(filepath.Join(dir, "gaddag"), 0755))
+	gd := gaddagmaker.GenerateGaddagFromStream(strings.NewReader("DOG\nWORM\n"), "NWL18")
+	gd.Save(filepath.Join(dir, "gaddag", "NWL18.gaddag"), gaddagmaker.GaddagMagicNumber)
+	cat, err := catalog.New(&macondoconfig.Config{
+		LexiconPath:            dir,
+		LetterDistributionPath: "../../data/letterdistributions",
+	})
+	is.NoErr(err)
+	return cat, func() { os.RemoveAll(dir) }
+}
+
+func TestValidateCorrespondenceSeek(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	cat, cleanup := testCatalog(is)
+	defer cleanup()
+	req := &pb.GameRequest{
+		Lexicon:            "NWL18",
+		Rules:              &pb.GameRules{BoardLayoutName: entity.CrosswordGame, LetterDistributionName: "english"},
+		GameMode:           pb.GameMode_CORRESPONDENCE,
+		InitialTimeSeconds: 3 * entity.SecondsPerDay,
+	}
+	is.NoErr(ValidateSoughtGame(ctx, cat, req))
+
+	req.InitialTimeSeconds = 3*entity.SecondsPerDay + 60
+	is.True(ValidateSoughtGame(ctx, cat, req) != nil)
+
+	req.InitialTimeSeconds = (entity.CorresMaxDaysPerMove + 1) * entity.SecondsPerDay
+	is.True(ValidateSoughtGame(ctx, cat, req) != nil)
+
+	req.InitialTimeSeconds = entity.SecondsPerDay
+	req.IncrementSeconds = 30
+	is.True(ValidateSoughtGame(ctx, cat, req) != nil)
+
+	// A multi-day real-time game is fine; it's just slow.
+	req.GameMode = pb.GameMode_REAL_TIME
+	is.NoErr(ValidateSoughtGame(ctx, cat, req))
+}
+
+func TestSeekEligibility(t *testing.T) {
+	is := is.New(t)
+	sr := &pb.SeekRequest{
+		GameRequest: &pb.GameRequest{
+			Lexicon:            "NWL18",
+			Rules:              &pb.GameRules{BoardLayoutName: entity.CrosswordGame},
+			InitialTimeSeconds: 900,
+		},
+		MinimumRating: 1400,
+		MaximumRating: 1600,
+	}
+	timefmt, variant, err := entity.VariantFromGameReq(sr.GameRequest)
+	is.NoErr(err)
+	key := entity.ToVariantKey("NWL18", variant, timefmt)
+	rated := func(r float64) *entity.User {
+		return &entity.User{Profile: &entity.Profile{Ratings: entity.Ratings{
+			Data: map[entity.VariantKey]entity.SingleRating{key: {Rating: r, RatingDeviation: 60}},
+		}}}
+	}
+
+	is.NoErr(SeekEligibility(sr, rated(1500)))
+	is.True(SeekEligibility(sr, rated(1399)) != nil)
+	is.True(SeekEligibility(sr, rated(1700)) != nil)
+	// Nobody has a rating in this variant yet, so they're provisional.
+	is.NoErr(SeekEligibility(sr, &entity.User{Profile: &entity.Profile{}}))
+	is.True(SeekEligibility(sr, &entity.User{Anonymous: true}) != nil)
+
+	sr.MaximumRating = 0
+	is.NoErr(SeekEligibility(sr, rated(2200)))
+	sr.MinimumRating = 0
+	is.NoErr(SeekEligibility(sr, &entity.User{Anonymous: true}))
+
+	is.True(ValidateRatingRange(1600, 1400) != nil)
+	is.NoErr(ValidateRatingRange(1400, 0))
+}
+
+func TestValidateVariantSeek(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	cat, cleanup := testCatalog(is)
+	defer cleanup()
+	req := &pb.GameRequest{
+		Lexicon: "NWL18",
+		Rules: &pb.GameRules{BoardLayoutName: entity.SuperCrosswordGame,
+			LetterDistributionName: "english", VariantName: string(entity.VarSuper)},
+		InitialTimeSeconds: 900,
+	}
+	is.NoErr(ValidateSoughtGame(ctx, cat, req))
+
+	// Variants have ratings of their own.
+	timefmt, variant, err := entity.VariantFromGameReq(req)
+	is.NoErr(err)
+	is.Equal(entity.ToVariantKey(req.Lexicon, variant, timefmt), entity.VariantKey("NWL18.superomg.regular"))
+
+	req.PlayerVsBot = true
+	is.True(ValidateSoughtGame(ctx, cat, req) != nil)
+	req.PlayerVsBot = false
+
+	req.Rules.BoardLayoutName = entity.CrosswordGame
+	is.True(ValidateSoughtGame(ctx, cat, req) != nil)
+	req.Rules.VariantName = string(entity.VarDogworms)
+	is.NoErr(ValidateSoughtGame(ctx, cat, req))
+	req.Rules.LetterDistributionName = "spanish"
+	is.True(ValidateSoughtGame(ctx, cat, req) != nil)
+}
+
+func TestValidateSeekAgainstCatalog(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	// There are no lexica in here.
+	empty, err := catalog.New(&macondoconfig.Config{
+		LexiconPath:            "../../data",
+		LetterDistributionPath: "../../data/letterdistributions",
+	})
+	is.NoErr(err)
+	req := &pb.GameRequest{
+		Lexicon:            "NWL18",
+		Rules:              &pb.GameRules{BoardLayoutName: entity.CrosswordGame, LetterDistributionName: "english"},
+		InitialTimeSeconds: 900,
+	}
+	is.True(ValidateSoughtGame(ctx, empty, req) != nil)
+	cat, cleanup := testCatalog(is)
+	defer cleanup()
+	is.NoErr(ValidateSoughtGame(ctx, cat, req))
+	req.Lexicon = "CSW19"
+	is.True(ValidateSoughtGame(ctx, cat, req) != nil)
+
+	// There have to be rules to check the lexicon against.
+	req.Lexicon = "NWL18"
+	req.Rules = nil
+	is.True(ValidateSoughtGame(ctx, cat, req) != nil)
+}
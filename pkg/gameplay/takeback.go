@@ -0,0 +1,231 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/game"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+var (
+	errCantTakeBack      = errors.New("takebacks are only allowed in casual and bot games")
+	errNothingToTakeBack = errors.New("you have no turn to take back")
+	errNoTakebackRequest = errors.New("your opponent has not asked for a takeback")
+)
+
+// isTurnEvent returns whether the event is a player's turn, as opposed to
+// something that happens as a consequence of a turn (a challenge, the final
+// rack points, etc).
+func isTurnEvent(evt *macondopb.GameEvent) bool {
+	switch evt.Type {
+	case macondopb.GameEvent_TILE_PLACEMENT_MOVE, macondopb.GameEvent_PASS,
+		macondopb.GameEvent_EXCHANGE, macondopb.GameEvent_UNSUCCESSFUL_CHALLENGE_TURN_LOSS:
+		return true
+	}
+	return false
+}
+
+// describeTurn returns a short, GCG-like description of a turn event.
+func describeTurn(evt *macondopb.GameEvent) string {
+	switch evt.Type {
+	case macondopb.GameEvent_TILE_PLACEMENT_MOVE:
+		return fmt.Sprintf("%s %s %s +%d", evt.Nickname, evt.Position, evt.PlayedTiles, evt.Score)
+	case macondopb.GameEvent_EXCHANGE:
+		return fmt.Sprintf("%s -%s", evt.Nickname, evt.Exchanged)
+	case macondopb.GameEvent_PASS:
+		return evt.Nickname + " -"
+	default:
+		return evt.Nickname + " --"
+	}
+}
+
+// checkTakeback returns an error if the user can't take back a turn in the
+// game (or accept a takeback) right now. The caller must hold the game's
+// lock.
+func checkTakeback(entGame *entity.Game, userID string) error {
+	if entGame.Playing() == macondopb.PlayState_GAME_OVER {
+		return errGameNotActive
+	}
+	if entGame.Adjourned {
+		return errGameAdjourned
+	}
+	if entGame.GameReq.RatingMode != pb.RatingMode_CASUAL && !entGame.GameReq.PlayerVsBot {
+		return errCantTakeBack
+	}
+	if !entGame.Started {
+		return errGameNotActive
+	}
+	if playerIndex(entGame, userID) == -1 {
+		return errNotInGame
+	}
+	return nil
+}
+
+// RequestTakeback asks to take back the user's last turn. In a bot game it
+// is granted right away; otherwise the request is passed on to the opponent,
+// who has to accept it (see RespondToTakeback). The game is returned unless
+// there was an error loading it.
+func RequestTakeback(ctx context.Context, gameStore GameStore, userID, gameID string) (*entity.Game, error) {
+	entGame, err := gameStore.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	entGame.Lock()
+	defer entGame.Unlock()
+	if err := checkTakeback(entGame, userID); err != nil {
+		return entGame, err
+	}
+	if entGame.GameReq.PlayerVsBot {
+		return entGame, takeBack(ctx, entGame, gameStore, userID)
+	}
+	pidx := playerIndex(entGame, userID)
+	entGame.TakebackRequestedBy = userID
+	log.Debug().Str("gameID", gameID).Str("userID", userID).Msg("takeback-requested")
+
+	evt := entity.WrapEvent(&pb.TakebackRequest{GameId: gameID}, pb.MessageType_TAKEBACK_REQUEST)
+	evt.AddAudience(entity.AudUser, players(entGame)[1-pidx]+".game."+gameID)
+	entGame.SendChange(evt)
+	return entGame, nil
+}
+
+// RespondToTakeback accepts or declines the opponent's takeback request. The
+// game is returned unless there was an error loading it.
+func RespondToTakeback(ctx context.Context, gameStore GameStore, userID, gameID string,
+	accept bool) (*entity.Game, error) {
+
+	entGame, err := gameStore.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	entGame.Lock()
+	defer entGame.Unlock()
+	if err := checkTakeback(entGame, userID); err != nil {
+		return entGame, err
+	}
+	requestedBy := entGame.TakebackRequestedBy
+	if requestedBy == "" || requestedBy == userID {
+		return entGame, errNoTakebackRequest
+	}
+	entGame.TakebackRequestedBy = ""
+
+	if !accept {
+		evt := entity.WrapEvent(&pb.TakebackResponse{GameId: gameID, Accept: false},
+			pb.MessageType_TAKEBACK_RESPONSE)
+		evt.AddAudience(entity.AudUser, requestedBy+".game."+gameID)
+		entGame.SendChange(evt)
+		return entGame, nil
+	}
+	return entGame, takeBack(ctx, entGame, gameStore, requestedBy)
+}
+
+// takeBack rolls the game back to just before the user's last turn, and
+// restores both clocks to what they were then. The turns that were taken
+// back are noted in the history, so that they still show up in its GCG. The
+// caller must hold the game's lock.
+func takeBack(ctx context.Context, entGame *entity.Game, gameStore GameStore, userID string) error {
+	pidx := playerIndex(entGame, userID)
+	hist := entGame.History()
+	nick := hist.Players[pidx].Nickname
+
+	turn := -1
+	for i := len(hist.Events) - 1; i >= 0; i-- {
+		if isTurnEvent(hist.Events[i]) && hist.Events[i].Nickname == nick {
+			turn = i
+			break
+		}
+	}
+	if turn == -1 {
+		return errNothingToTakeBack
+	}
+
+	// The user gets back the rack they had for that turn. Their opponent
+	// keeps the rack they have now, unless they've moved since; in that
+	// case they get back the rack they had for their next turn.
+	removed := hist.Events[turn:]
+	racks := make([]string, 2)
+	racks[pidx] = removed[0].Rack
+	racks[1-pidx] = entGame.Game.RackLettersFor(1 - pidx)
+	for _, evt := range removed {
+		if isTurnEvent(evt) && evt.Nickname != nick {
+			racks[1-pidx] = evt.Rack
+			break
+		}
+	}
+
+	var descs []string
+	for _, evt := range removed {
+		if isTurnEvent(evt) {
+			descs = append(descs, describeTurn(evt))
+		}
+	}
+	note := fmt.Sprintf("Takeback by %s: %s", nick, strings.Join(descs, "; "))
+
+	newHist := proto.Clone(hist).(*macondopb.GameHistory)
+	newHist.Events = newHist.Events[:turn]
+	newHist.LastKnownRacks = racks
+	if turn > 0 {
+		newHist.Events[turn-1].Note = strings.TrimSpace(newHist.Events[turn-1].Note + "\n" + note)
+	} else {
+		newHist.Description = strings.TrimSpace(newHist.Description + "\n" + note)
+	}
+
+	cfg := ctx.Value(ConfigCtxKey("config")).(*macondoconfig.Config)
+	rules, err := gameRules(cfg, entGame.GameReq)
+	if err != nil {
+		return err
+	}
+	// Replay the game from the start, rather than in place, so that nothing
+	// about the taken back turns sticks around.
+	mcg, err := game.NewFromHistory(newHist, rules, len(newHist.Events))
+	if err != nil {
+		return err
+	}
+	mcg.SetBackupMode(game.InteractiveGameplayMode)
+	if mcg.PlayerOnTurn() != pidx {
+		return errNothingToTakeBack
+	}
+	entGame.Game = *mcg
+
+	var remaining [2]int
+	initial := int(entGame.GameReq.InitialTimeSeconds) * 1000
+	for idx, p := range newHist.Players {
+		remaining[idx] = initial
+		for i := len(newHist.Events) - 1; i >= 0; i-- {
+			evt := newHist.Events[i]
+			if !isTurnEvent(evt) || evt.Nickname != p.Nickname {
+				continue
+			}
+			if !entGame.IsCorrespondence() {
+				remaining[idx] = int(evt.MillisRemaining) + int(entGame.GameReq.IncrementSeconds)*1000
+			}
+			break
+		}
+	}
+	entGame.RestoreTimers(remaining)
+
+	err = gameStore.Set(ctx, entGame)
+	if err != nil {
+		return err
+	}
+	log.Info().Str("gameID", entGame.GameID()).Str("userID", userID).
+		Int("turn", turn).Msg("took-back")
+
+	evt := entGame.HistoryRefresherEvent()
+	wrapped := entity.WrapEvent(evt, pb.MessageType_GAME_HISTORY_REFRESHER)
+	wrapped.AddAudience(entity.AudGameTV, entGame.GameID())
+	for _, p := range players(entGame) {
+		wrapped.AddAudience(entity.AudUser, p+".game."+entGame.GameID())
+	}
+	entGame.SendChange(wrapped)
+	return nil
+}
@@ -0,0 +1,94 @@
+package gameplay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/stats"
+	"github.com/domino14/liwords/pkg/stores/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	"github.com/domino14/macondo/alphabet"
+)
+
+func TestTakeback(t *testing.T) {
+	is := is.New(t)
+	recreateDB()
+	cstr := TestingDBConnStr + " dbname=liwords_test"
+
+	ustore := userStore(cstr)
+	lstore := listStatStore(cstr)
+	cfg, gstore := gameStore(cstr, ustore)
+
+	g, nower, cancel, donechan, _ := makeGame(cfg, ustore, gstore)
+	ctx := context.WithValue(context.Background(), ConfigCtxKey("config"), &cfg.MacondoConfig)
+	id := g.GameID()
+	jesse, cesar := "3xpEkpRAy3AizbVmDg3kdi", "xjCWug7EZtDxDHX5fRZTLo"
+
+	// Rated games have no takebacks.
+	_, err := RequestTakeback(ctx, gstore, jesse, id)
+	is.Equal(err, errCantTakeBack)
+	g.GameReq.RatingMode = pb.RatingMode_CASUAL
+
+	// jesse, cesar and jesse again all pass.
+	for _, p := range []string{jesse, cesar, jesse} {
+		nower.Sleep(10000)
+		_, err = HandleEvent(ctx, gstore, ustore, lstore, p,
+			&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_PASS, GameId: id})
+		is.NoErr(err)
+	}
+	cesarRack := g.History().Events[1].Rack
+
+	// A declined request changes nothing.
+	_, err = RequestTakeback(ctx, gstore, cesar, id)
+	is.NoErr(err)
+	_, err = RespondToTakeback(ctx, gstore, cesar, id, true)
+	is.Equal(err, errNoTakebackRequest)
+	_, err = RespondToTakeback(ctx, gstore, jesse, id, false)
+	is.NoErr(err)
+	is.Equal(len(g.History().Events), 3)
+	_, err = RespondToTakeback(ctx, gstore, jesse, id, true)
+	is.Equal(err, errNoTakebackRequest)
+
+	// cesar takes back their pass, which takes jesse's last pass with it.
+	nower.Sleep(10000)
+	_, err = RequestTakeback(ctx, gstore, cesar, id)
+	is.NoErr(err)
+	_, err = RespondToTakeback(ctx, gstore, jesse, id, true)
+	is.NoErr(err)
+
+	is.Equal(len(g.History().Events), 1)
+	is.Equal(g.PlayerIDOnTurn(), cesar)
+	is.Equal(g.RackLettersFor(0), alphabet.RackFromString(cesarRack, g.Alphabet()).String())
+	// cesar hasn't moved, and jesse's clock is back to what it was after
+	// their first pass, increment included.
+	is.Equal(g.CachedTimeRemaining(0), 25*60000)
+	is.Equal(g.CachedTimeRemaining(1), 25*60000-10000+5000)
+	is.Equal(g.History().Events[0].Note, "Takeback by cesar4: cesar4 -; jesse -")
+
+	// The takeback was saved.
+	gstore.Unload(ctx, id)
+	saved, err := gstore.Get(ctx, id)
+	is.NoErr(err)
+	is.NoErr(saved.RegisterChangeHook(g.ChangeHook))
+	saved.SetTimerModule(nower)
+	is.Equal(len(saved.History().Events), 1)
+	is.Equal(saved.History().Events[0].Note, g.History().Events[0].Note)
+
+	// The game goes on from there.
+	_, err = HandleEvent(ctx, gstore, ustore, lstore, cesar,
+		&pb.ClientGameplayEvent{Type: pb.ClientGameplayEvent_PASS, GameId: id})
+	is.NoErr(err)
+	gstore.Unload(ctx, id)
+	saved, err = gstore.Get(ctx, id)
+	is.NoErr(err)
+	is.Equal(len(saved.History().Events), 2)
+
+	cancel()
+	<-donechan
+	ustore.(*user.DBStore).Disconnect()
+	lstore.(*stats.ListStatStore).Disconnect()
+	gstore.(*game.Cache).Disconnect()
+}
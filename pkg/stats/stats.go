@@ -6,11 +6,11 @@ import (
 	"unicode"
 
 	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/variants"
 	realtime "github.com/domino14/liwords/rpc/api/proto/realtime"
 	"github.com/domino14/macondo/alphabet"
 	macondoconfig "github.com/domino14/macondo/config"
 	"github.com/domino14/macondo/gaddag"
-	"github.com/domino14/macondo/game"
 	pb "github.com/domino14/macondo/gen/api/proto/macondo"
 	"github.com/rs/zerolog/log"
 )
@@ -130,6 +130,29 @@ func InstantiateNewStats(playerOneId string, playerTwoId string) *entity.Stats {
 		NotableData:   instantiateNotableData()}
 }
 
+// InstantiateAnnotationStats instantiates a stats object with only the stats
+// that are computed from the notes on a game's events. Adding a game to it
+// tallies up what its notes add to the full stats. Comments count the
+// events that have a note, so leave them out for notes that were added to
+// an event that already had one.
+func InstantiateAnnotationStats(playerOneId string, playerTwoId string, comments bool) *entity.Stats {
+	noteData := func() map[string]*entity.StatItem {
+		data := instantiatePlayerData()
+		for key := range data {
+			if key != entity.MISTAKES_STAT && (key != entity.COMMENTS_STAT || !comments) {
+				delete(data, key)
+			}
+		}
+		return data
+	}
+	return &entity.Stats{
+		PlayerOneId:   playerOneId,
+		PlayerTwoId:   playerTwoId,
+		PlayerOneData: noteData(),
+		PlayerTwoData: noteData(),
+		NotableData:   map[string]*entity.StatItem{}}
+}
+
 func AddGame(stats *entity.Stats, lss ListStatStore, history *pb.GameHistory, req *realtime.GameRequest,
 	cfg *macondoconfig.Config, gameEndedEvent *realtime.GameEndedEvent, gameId string) error {
 	// Josh, plz fix these asinine calls to incrementStatItem
@@ -449,7 +472,7 @@ func addChallengedPhonies(info *IncrementInfo) error {
 func addUnchallengedPhonies(info *IncrementInfo) error {
 	events := info.History.GetEvents()
 	event := events[info.EventIndex]
-	isUnchallengedPhony, err := isUnchallengedPhonyEvent(event, info.History, info.Cfg)
+	isUnchallengedPhony, err := isUnchallengedPhonyEvent(event, info)
 	if err != nil {
 		return err
 	}
@@ -707,12 +730,12 @@ func addNoBingos(info *IncrementInfo) error {
 }
 
 func addRatings(info *IncrementInfo) error {
-	var rating int32
+	nickname := info.History.Players[1].Nickname
 	if info.IsPlayerOne {
-		rating = info.Evt.NewRatings[info.History.Players[0].Nickname]
-	} else {
-		rating = info.Evt.NewRatings[info.History.Players[1].Nickname]
+		nickname = info.History.Players[0].Nickname
 	}
+	rating := info.Evt.NewRatings[nickname]
+	deviation := info.Evt.NewRatingDeviations[nickname]
 	info.StatItem.Total++
 	timeControl, variant, err := entity.VariantFromGameReq(info.Req)
 	if err != nil {
@@ -723,7 +746,8 @@ func addRatings(info *IncrementInfo) error {
 		info.PlayerId,
 		entity.StatName_value[entity.RATINGS_STAT],
 		info.Evt.Time,
-		entity.ListDatum{Rating: int(rating), Variant: string(variantKey)})
+		entity.ListDatum{Rating: int(rating), RatingDeviation: int(deviation),
+			Variant: string(variantKey)})
 	return nil
 }
 
@@ -925,7 +949,10 @@ func countBonusSquares(info *IncrementInfo,
 	event *pb.GameEvent,
 	bonusSquare byte) int {
 	occupiedIndexes := getOccupiedIndexes(event)
-	boardLayout, _ := game.HistoryToVariant(info.History)
+	boardLayout, err := variants.BoardLayout(info.Req.GetRules().GetBoardLayoutName())
+	if err != nil {
+		return 0
+	}
 	count := 0
 	for j := 0; j < len(occupiedIndexes); j++ {
 		rowAndColumn := occupiedIndexes[j]
@@ -941,27 +968,27 @@ func isBingoNineOrAbove(event *pb.GameEvent) bool {
 }
 
 func isUnchallengedPhonyEvent(event *pb.GameEvent,
-	history *pb.GameHistory,
-	cfg *macondoconfig.Config) (bool, error) {
+	info *IncrementInfo) (bool, error) {
 	phony := false
 	var err error
 	if event.Type == pb.GameEvent_TILE_PLACEMENT_MOVE {
-		gaddag, err := gaddag.GenericDawgCache.Get(cfg, history.Lexicon)
+		gaddag, err := gaddag.GenericDawgCache.Get(info.Cfg, info.History.Lexicon)
 		if err != nil {
 			return phony, err
 		}
-		phony, err = isPhony(gaddag, event.WordsFormed[0])
+		variant := entity.Variant(info.Req.GetRules().GetVariantName())
+		phony, err = isPhony(gaddag, variant, event.WordsFormed[0])
 	}
 	return phony, err
 }
 
-func isPhony(gd gaddag.GenericDawg, word string) (bool, error) {
+func isPhony(gd gaddag.GenericDawg, variant entity.Variant, word string) (bool, error) {
 	alph := gd.GetAlphabet()
 	machineWord, err := alphabet.ToMachineWord(word, alph)
 	if err != nil {
 		return false, err
 	}
-	return !gaddag.FindMachineWord(gd, machineWord), nil
+	return !variants.Lexicon(gd, variant).HasWord(machineWord), nil
 }
 
 func instantiatePlayerData() map[string]*entity.StatItem {
@@ -3,7 +3,10 @@ package stats
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/domino14/liwords/pkg/entity"
@@ -12,6 +15,7 @@ import (
 	"github.com/domino14/macondo/alphabet"
 	macondoconfig "github.com/domino14/macondo/config"
 	"github.com/domino14/macondo/gaddag"
+	"github.com/domino14/macondo/gaddagmaker"
 	"github.com/domino14/macondo/gcgio"
 	pb "github.com/domino14/macondo/gen/api/proto/macondo"
 	"github.com/jinzhu/gorm"
@@ -386,3 +390,91 @@ func listItemToString(listStat []*entity.ListItem) string {
 	}
 	return s
 }
+
+type memoryListStatStore struct {
+	items []entity.ListDatum
+}
+
+func (m *memoryListStatStore) AddListItem(gameId string, playerId string, statType int,
+	time int64, item entity.ListDatum) error {
+	m.items = append(m.items, item)
+	return nil
+}
+
+func (m *memoryListStatStore) GetListItems(statType int, gameIds []string,
+	playerId string) ([]*entity.ListItem, error) {
+	return nil, nil
+}
+
+func TestAnnotationStats(t *testing.T) {
+	is := is.New(t)
+	history := &pb.GameHistory{
+		Players: []*pb.PlayerInfo{{Nickname: "jvc", UserId: "1"}, {Nickname: "cesar", UserId: "2"}},
+		Events: []*pb.GameEvent{{Nickname: "cesar", Type: pb.GameEvent_PASS,
+			Note: "#visionlarge #endgame should have played out"}},
+	}
+	req := &realtime.GameRequest{Lexicon: "CSW19",
+		Rules: &realtime.GameRules{BoardLayoutName: entity.CrosswordGame}}
+	lss := &memoryListStatStore{}
+
+	stats := InstantiateAnnotationStats("1", "2", true)
+	is.Equal(len(stats.PlayerOneData), 2)
+	is.NoErr(AddGame(stats, lss, history, req, &DefaultConfig,
+		&realtime.GameEndedEvent{Time: 1234}, "game"))
+	is.Equal(stats.PlayerTwoData[entity.MISTAKES_STAT].Total, 2)
+	is.Equal(stats.PlayerTwoData[entity.MISTAKES_STAT].Subitems[entity.VisionMistakeType], 1)
+	is.Equal(stats.PlayerTwoData[entity.COMMENTS_STAT].Total, 1)
+	is.Equal(stats.PlayerOneData[entity.MISTAKES_STAT].Total, 0)
+
+	// Adding to a note doesn't make for another comment.
+	stats = InstantiateAnnotationStats("1", "2", false)
+	_, ok := stats.PlayerTwoData[entity.COMMENTS_STAT]
+	is.True(!ok)
+
+	is.NoErr(AddGame(stats, lss, history, req, &DefaultConfig,
+		&realtime.GameEndedEvent{Time: 1234}, "game"))
+	profile := InstantiateNewStats("2", "")
+	is.NoErr(AddStats(profile, stats))
+	is.Equal(profile.PlayerOneData[entity.MISTAKES_STAT].Total, 2)
+	is.Equal(profile.PlayerOneData[entity.COMMENTS_STAT].Total, 0)
+}
+
+func TestDogwormsPhonies(t *testing.T) {
+	is := is.New(t)
+	dir, err := ioutil.TempDir("", "stats")
+	is.NoErr(err)
+	defer os.RemoveAll(dir)
+	is.NoErr(os.Mkdir(filepath.Join(dir, "gaddag"), 0755))
+	gd := gaddagmaker.GenerateGaddagFromStream(strings.NewReader("DOG\nWORM\n"), "DOGWORMS_TEST")
+	gd.Save(filepath.Join(dir, "gaddag", "DOGWORMS_TEST.gaddag"), gaddagmaker.GaddagMagicNumber)
+	cfg := DefaultConfig
+	cfg.LexiconPath = dir
+
+	history := &pb.GameHistory{
+		Lexicon: "DOGWORMS_TEST",
+		Players: []*pb.PlayerInfo{{Nickname: "jvc", UserId: "1"}, {Nickname: "cesar", UserId: "2"}},
+		Events: []*pb.GameEvent{{Nickname: "jvc", Type: pb.GameEvent_TILE_PLACEMENT_MOVE,
+			Rack: "DGO", Row: 7, Column: 7, Direction: pb.GameEvent_HORIZONTAL,
+			PlayedTiles: "GOD", WordsFormed: []string{"GOD"}, Score: 10, Cumulative: 10}},
+		FinalScores: []int32{10, 0},
+	}
+	lss := &memoryListStatStore{}
+	phonies := func(variant entity.Variant) int {
+		req := &realtime.GameRequest{Lexicon: "DOGWORMS_TEST",
+			Rules: &realtime.GameRules{BoardLayoutName: entity.CrosswordGame,
+				VariantName: string(variant)}}
+		stats := InstantiateNewStats("1", "2")
+		is.NoErr(AddGame(stats, lss, history, req, &cfg,
+			&realtime.GameEndedEvent{Time: 1234}, "game"))
+		return stats.PlayerOneData[entity.UNCHALLENGED_PHONIES_STAT].Total
+	}
+
+	// GOD isn't a word, but it's an anagram of one.
+	is.Equal(phonies(entity.VarClassic), 1)
+	is.Equal(phonies(entity.VarDogworms), 0)
+
+	// ROW isn't even that.
+	history.Events[0].PlayedTiles = "ROW"
+	history.Events[0].WordsFormed = []string{"ROW"}
+	is.Equal(phonies(entity.VarDogworms), 1)
+}
@@ -2,6 +2,7 @@ package game
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/domino14/liwords/pkg/entity"
@@ -16,7 +17,13 @@ type backingStore interface {
 	Get(ctx context.Context, id string) (*entity.Game, error)
 	Set(context.Context, *entity.Game) error
 	Create(context.Context, *entity.Game) error
+	EndGame(ctx context.Context, g *entity.Game, commit *entity.GameEndCommit) (bool, error)
+	AnnotateGame(ctx context.Context, id string, commit *entity.GameNoteCommit) (*entity.Game, error)
+	EndCommitted(ctx context.Context, id string) (bool, error)
 	ListActive(ctx context.Context) ([]*pb.GameMeta, error)
+	ListActiveCorrespondence(ctx context.Context, userID string) ([]*pb.GameMeta, error)
+	ListPastGames(ctx context.Context, q *entity.PastGamesQuery) ([]*entity.PastGame, int, error)
+	GetHeadToHead(ctx context.Context, userID, opponentID string, variant entity.VariantKey) (*entity.HeadToHead, error)
 	SetGameEventChan(ch chan<- *entity.EventWrapper)
 	Disconnect()
 }
@@ -37,6 +44,13 @@ type Cache struct {
 	activeGamesTTL         time.Duration
 	activeGamesLastUpdated time.Time
 
+	// lastUsed is when every cached game was last gotten or set, in
+	// milliseconds, so that idle games can be unloaded. The mutex covers
+	// adding and removing games from the cache along with it.
+	lastUsedMutex sync.Mutex
+	lastUsed      map[string]int64
+	nower         entity.Nower
+
 	backing backingStore
 }
 
@@ -48,8 +62,10 @@ func NewCache(backing backingStore) *Cache {
 	}
 
 	return &Cache{
-		backing: backing,
-		cache:   lrucache,
+		backing:  backing,
+		cache:    lrucache,
+		lastUsed: make(map[string]int64),
+		nower:    entity.GameTimer{},
 
 		// games:   make(map[string]*entity.Game),
 		// Have a non-trivial TTL for the cache of active games.
@@ -67,9 +83,53 @@ func NewCache(backing backingStore) *Cache {
 	}
 }
 
+// SetNower sets the clock that idle games are timed with.
+func (c *Cache) SetNower(n entity.Nower) {
+	c.nower = n
+}
+
 // Unload unloads the game from the cache
 func (c *Cache) Unload(ctx context.Context, id string) {
+	c.lastUsedMutex.Lock()
+	defer c.lastUsedMutex.Unlock()
 	c.cache.Remove(id)
+	delete(c.lastUsed, id)
+}
+
+// UnloadIdleCorrespondence unloads the correspondence games that nobody got
+// or set for at least idleFor, and returns their IDs. These games can go
+// days between moves, so there's no point keeping them around, but they're
+// only unloaded once they've been idle for long enough that nobody can
+// still be holding on to them.
+func (c *Cache) UnloadIdleCorrespondence(ctx context.Context, idleFor time.Duration) []string {
+	cutoff := c.nower.Now() - idleFor.Milliseconds()
+	unloaded := []string{}
+	// Getting a game touches it while holding the same lock, so a game can't
+	// be unloaded between being gotten and being touched.
+	c.lastUsedMutex.Lock()
+	defer c.lastUsedMutex.Unlock()
+	for id, used := range c.lastUsed {
+		g, ok := c.cache.Peek(id)
+		if !ok {
+			// It was pushed out of the cache.
+			delete(c.lastUsed, id)
+			continue
+		}
+		if used <= cutoff && g.(*entity.Game).IsCorrespondence() {
+			c.cache.Remove(id)
+			delete(c.lastUsed, id)
+			unloaded = append(unloaded, id)
+		}
+	}
+	return unloaded
+}
+
+// add adds a game to the cache, and records that it was just used.
+func (c *Cache) add(id string, g *entity.Game) {
+	c.lastUsedMutex.Lock()
+	defer c.lastUsedMutex.Unlock()
+	c.cache.Add(id, g)
+	c.lastUsed[id] = c.nower.Now()
 }
 
 // SetGameEventChan sets the game event channel to the passed in channel.
@@ -79,14 +139,18 @@ func (c *Cache) SetGameEventChan(ch chan<- *entity.EventWrapper) {
 
 // Get gets a game from the cache.. it loads it into the cache if it's not there.
 func (c *Cache) Get(ctx context.Context, id string) (*entity.Game, error) {
+	c.lastUsedMutex.Lock()
 	g, ok := c.cache.Get(id)
 	if ok && g != nil {
+		c.lastUsed[id] = c.nower.Now()
+		c.lastUsedMutex.Unlock()
 		return g.(*entity.Game), nil
 	}
+	c.lastUsedMutex.Unlock()
 	log.Info().Str("gameid", id).Msg("not-in-cache")
 	uncachedGame, err := c.backing.Get(ctx, id)
 	if err == nil {
-		c.cache.Add(id, uncachedGame)
+		c.add(id, uncachedGame)
 	}
 	return uncachedGame, err
 
@@ -117,10 +181,44 @@ func (c *Cache) setOrCreate(ctx context.Context, game *entity.Game, isNew bool)
 	if err != nil {
 		return err
 	}
-	c.cache.Add(gameID, game)
+	c.add(gameID, game)
 	return nil
 }
 
+// EndGame saves a game that just ended, along with its players' new ratings
+// and stats, in the backing store. It returns whether anything was saved; a
+// game can only be ended once. The cached game is kept as it is; if nothing
+// was saved, it's up to the caller to unload it.
+func (c *Cache) EndGame(ctx context.Context, game *entity.Game,
+	commit *entity.GameEndCommit) (bool, error) {
+
+	if game.History().Uid == "" {
+		return false, errNoID
+	}
+	return c.backing.EndGame(ctx, game, commit)
+}
+
+// AnnotateGame saves a note added to a finished game, along with its
+// author's new stats, in the backing store. The cached game doesn't have the
+// note, so it's unloaded; it's loaded again as it was saved the next time
+// it's needed.
+func (c *Cache) AnnotateGame(ctx context.Context, id string,
+	commit *entity.GameNoteCommit) (*entity.Game, error) {
+
+	saved, err := c.backing.AnnotateGame(ctx, id, commit)
+	if err != nil {
+		return nil, err
+	}
+	c.Unload(ctx, id)
+	return saved, nil
+}
+
+// EndCommitted returns whether the game was already ended. It always asks the
+// backing store, since another copy of the game could have ended it.
+func (c *Cache) EndCommitted(ctx context.Context, id string) (bool, error) {
+	return c.backing.EndCommitted(ctx, id)
+}
+
 func (c *Cache) ListActive(ctx context.Context) ([]*pb.GameMeta, error) {
 
 	if time.Now().Sub(c.activeGamesLastUpdated) < c.activeGamesTTL {
@@ -137,6 +235,24 @@ func (c *Cache) ListActive(ctx context.Context) ([]*pb.GameMeta, error) {
 	return games, err
 }
 
+// ListActiveCorrespondence lists a user's ongoing correspondence games. These
+// are not cached; they change rarely and are not requested often.
+func (c *Cache) ListActiveCorrespondence(ctx context.Context, userID string) ([]*pb.GameMeta, error) {
+	return c.backing.ListActiveCorrespondence(ctx, userID)
+}
+
+// ListPastGames lists a user's finished games. These are not cached.
+func (c *Cache) ListPastGames(ctx context.Context, q *entity.PastGamesQuery) ([]*entity.PastGame, int, error) {
+	return c.backing.ListPastGames(ctx, q)
+}
+
+// GetHeadToHead gets the record of a user against an opponent. It is not
+// cached.
+func (c *Cache) GetHeadToHead(ctx context.Context, userID, opponentID string,
+	variant entity.VariantKey) (*entity.HeadToHead, error) {
+	return c.backing.GetHeadToHead(ctx, userID, opponentID, variant)
+}
+
 func (c *Cache) Disconnect() {
 	c.backing.Disconnect()
 }
@@ -0,0 +1,61 @@
+package game
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// gamesBacking is a backing store that only gets games.
+type gamesBacking struct {
+	backingStore
+	games map[string]*entity.Game
+}
+
+func (b *gamesBacking) Get(ctx context.Context, id string) (*entity.Game, error) {
+	g, ok := b.games[id]
+	if !ok {
+		return nil, errNotFound
+	}
+	return g, nil
+}
+
+func TestCacheUnloadsIdleCorrespondenceGames(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	backing := &gamesBacking{games: map[string]*entity.Game{
+		"corres1":  {GameReq: &pb.GameRequest{GameMode: pb.GameMode_CORRESPONDENCE}},
+		"corres2":  {GameReq: &pb.GameRequest{GameMode: pb.GameMode_CORRESPONDENCE}},
+		"realtime": {GameReq: &pb.GameRequest{GameMode: pb.GameMode_REAL_TIME}},
+	}}
+	c := NewCache(backing)
+	nower := entity.NewFakeNower(1000)
+	c.SetNower(nower)
+
+	for _, id := range []string{"corres1", "corres2", "realtime"} {
+		_, err := c.Get(ctx, id)
+		is.NoErr(err)
+	}
+	nower.Sleep(int64(20 * time.Minute / time.Millisecond))
+	// Someone is still using this one.
+	_, err := c.Get(ctx, "corres2")
+	is.NoErr(err)
+	nower.Sleep(int64(10 * time.Minute / time.Millisecond))
+
+	is.Equal(c.UnloadIdleCorrespondence(ctx, 30*time.Minute), []string{"corres1"})
+	is.True(!c.cache.Contains("corres1"))
+	is.True(c.cache.Contains("corres2"))
+	// Real-time games are never unloaded for being idle.
+	is.True(c.cache.Contains("realtime"))
+
+	// Getting it again loads it again.
+	g, err := c.Get(ctx, "corres1")
+	is.NoErr(err)
+	is.Equal(g, backing.games["corres1"])
+	is.Equal(len(c.UnloadIdleCorrespondence(ctx, 30*time.Minute)), 0)
+}
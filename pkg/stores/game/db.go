@@ -3,7 +3,9 @@ package game
 import (
 	"context"
 	"encoding/json"
-	"errors"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
@@ -16,11 +18,8 @@ import (
 	"github.com/domino14/liwords/pkg/entity"
 	"github.com/domino14/liwords/pkg/stores/user"
 	pkguser "github.com/domino14/liwords/pkg/user"
+	"github.com/domino14/liwords/pkg/variants"
 	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
-	"github.com/domino14/macondo/alphabet"
-	"github.com/domino14/macondo/board"
-	"github.com/domino14/macondo/cross_set"
-	"github.com/domino14/macondo/gaddag"
 	macondogame "github.com/domino14/macondo/game"
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 )
@@ -42,15 +41,16 @@ type game struct {
 	gorm.Model
 	UUID string `gorm:"type:varchar(24);index"`
 
-	Player0ID uint
+	Player0ID uint `gorm:"index:idx_game_player0_ended"`
 	Player0   user.User
 
-	Player1ID uint
+	Player1ID uint `gorm:"index:idx_game_player1_ended"`
 	Player1   user.User
 
 	Timers postgres.Jsonb // A JSON blob containing the game timers.
 
 	Started       bool
+	Adjourned     bool
 	GameEndReason int `gorm:"index"`
 	WinnerIdx     int
 	LoserIdx      int
@@ -60,6 +60,46 @@ type game struct {
 	History []byte
 
 	Stats postgres.Jsonb
+
+	// These are copied out of the request, the history and the timers, so
+	// that a player's finished games can be searched.
+	Lexicon    string `gorm:"type:varchar(32)"`
+	VariantKey string `gorm:"type:varchar(64)"`
+	// Rated is whether the game counts towards its players' ratings.
+	Rated              bool
+	EndedAt            *time.Time `gorm:"index:idx_game_player0_ended,idx_game_player1_ended"`
+	Player0Score       int
+	Player1Score       int
+	Player0RatingDelta int
+	Player1RatingDelta int
+
+	// EndCommitted is set once the game has ended and its players' ratings
+	// and stats were saved along with it, so that it's only done once.
+	EndCommitted bool
+}
+
+// A gameEvent is an entry in the append-only log of the events that the
+// server accepted in a game. While a game is going on, the history in the
+// games table is not rewritten; the events are logged here instead, and the
+// full history is only saved once the game is over (or when events are
+// taken back).
+type gameEvent struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+
+	GameUUID string `gorm:"type:varchar(24);index:idx_game_event_order"`
+	// Idx is the index of the event in the game history.
+	Idx int `gorm:"index:idx_game_event_order"`
+	// Protobuf representation of the macondo GameEvent.
+	Event []byte
+	// TakenBack is set on events that were taken back. They stay in the log
+	// for the record, but are no longer part of the game.
+	TakenBack bool
+
+	// The last known racks and the play state of the game when the event
+	// was logged.
+	Racks     postgres.Jsonb
+	PlayState int
 }
 
 // NewDBStore creates a new DB store for games.
@@ -68,7 +108,7 @@ func NewDBStore(config *config.Config, userStore pkguser.Store) (*DBStore, error
 	if err != nil {
 		return nil, err
 	}
-	db.AutoMigrate(&game{})
+	db.AutoMigrate(&game{}, &gameEvent{})
 	db.Model(&game{}).AddForeignKey("player0_id", "users(id)", "RESTRICT", "RESTRICT")
 	db.Model(&game{}).AddForeignKey("player1_id", "users(id)", "RESTRICT", "RESTRICT")
 	return &DBStore{db: db, cfg: config, userStore: userStore}, nil
@@ -90,6 +130,51 @@ func (s *DBStore) Get(ctx context.Context, id string) (*entity.Game, error) {
 		return nil, result.Error
 	}
 
+	hist := &macondopb.GameHistory{}
+	err := proto.Unmarshal(g.History, hist)
+	if err != nil {
+		return nil, err
+	}
+	if g.GameEndReason == int(pb.GameEndReason_NONE) {
+		// The saved history is only complete once the game is over; until
+		// then, the rest of it is in the event log.
+		err = loadEvents(s.db, id, hist, len(hist.Events))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.fromDBObj(g, hist)
+}
+
+// Replay creates an instantiated entity.Game from the event log alone,
+// ignoring whatever events are in the saved history. It can be used to audit
+// the saved game against what the server accepted.
+func (s *DBStore) Replay(ctx context.Context, id string) (*entity.Game, error) {
+	g := &game{}
+
+	if result := s.db.Where("uuid = ?", id).First(g); result.Error != nil {
+		return nil, result.Error
+	}
+	hist := &macondopb.GameHistory{}
+	err := proto.Unmarshal(g.History, hist)
+	if err != nil {
+		return nil, err
+	}
+	// A game can end without a move (if someone times out, for example), so
+	// the log doesn't know how it ended.
+	playState := hist.PlayState
+	hist.Events = nil
+	err = loadEvents(s.db, id, hist, 0)
+	if err != nil {
+		return nil, err
+	}
+	if g.GameEndReason != int(pb.GameEndReason_NONE) {
+		hist.PlayState = playState
+	}
+	return s.fromDBObj(g, hist)
+}
+
+func (s *DBStore) fromDBObj(g *game, hist *macondopb.GameHistory) (*entity.Game, error) {
 	var tdata entity.Timers
 	err := json.Unmarshal(g.Timers.RawMessage, &tdata)
 	if err != nil {
@@ -97,13 +182,96 @@ func (s *DBStore) Get(ctx context.Context, id string) (*entity.Game, error) {
 	}
 
 	var sdata *entity.Stats
-	err = json.Unmarshal(g.Stats.RawMessage, sdata)
+	err = json.Unmarshal(g.Stats.RawMessage, &sdata)
 	if err != nil {
 		// it could be that the stats are empty, so don't worry.
 	}
 
-	return FromState(tdata, g.Started, g.GameEndReason, g.Player0ID, g.Player1ID,
-		g.WinnerIdx, g.LoserIdx, g.Request, g.History, sdata, s.gameEventChan, s.cfg)
+	entGame, err := fromHistory(tdata, g.Started, g.GameEndReason, g.Player0ID, g.Player1ID,
+		g.WinnerIdx, g.LoserIdx, g.Request, hist, sdata, s.gameEventChan, s.cfg)
+	if err != nil {
+		return nil, err
+	}
+	entGame.Adjourned = g.Adjourned
+	entGame.RatingDeltas = [2]int{g.Player0RatingDelta, g.Player1RatingDelta}
+	entGame.Unrated = entGame.GameReq.RatingMode == pb.RatingMode_RATED && !g.Rated
+	return entGame, nil
+}
+
+// loadEvents appends the logged events of the game, starting from the one
+// with the given index, to the history. The racks and play state of the
+// history are set to those of the last event.
+func loadEvents(db *gorm.DB, id string, hist *macondopb.GameHistory, from int) error {
+	var evts []gameEvent
+	result := db.Where("game_uuid = ? AND idx >= ? AND taken_back = ?", id, from, false).
+		Order("idx").Find(&evts)
+	if result.Error != nil {
+		return result.Error
+	}
+	for _, e := range evts {
+		if e.Idx != len(hist.Events) {
+			return fmt.Errorf("event log of game %v is missing event %v", id, len(hist.Events))
+		}
+		evt := &macondopb.GameEvent{}
+		err := proto.Unmarshal(e.Event, evt)
+		if err != nil {
+			return err
+		}
+		hist.Events = append(hist.Events, evt)
+	}
+	if len(evts) > 0 {
+		last := evts[len(evts)-1]
+		var racks []string
+		err := json.Unmarshal(last.Racks.RawMessage, &racks)
+		if err != nil {
+			return err
+		}
+		hist.LastKnownRacks = racks
+		hist.PlayState = macondopb.PlayState(last.PlayState)
+	}
+	return nil
+}
+
+// logEvents appends the events of the history that aren't in the event log
+// yet to it. If the history is shorter than the log, events were taken back;
+// they're marked as such, and logEvents returns true.
+func logEvents(db *gorm.DB, id string, hist *macondopb.GameHistory) (bool, error) {
+	var logged int
+	result := db.Model(&gameEvent{}).Where("game_uuid = ? AND taken_back = ?", id, false).
+		Count(&logged)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if logged > len(hist.Events) {
+		result = db.Model(&gameEvent{}).
+			Where("game_uuid = ? AND idx >= ? AND taken_back = ?", id, len(hist.Events), false).
+			Update("taken_back", true)
+		return true, result.Error
+	}
+	if logged == len(hist.Events) {
+		return false, nil
+	}
+	racks, err := json.Marshal(hist.LastKnownRacks)
+	if err != nil {
+		return false, err
+	}
+	for idx := logged; idx < len(hist.Events); idx++ {
+		evt, err := proto.Marshal(hist.Events[idx])
+		if err != nil {
+			return false, err
+		}
+		result = db.Create(&gameEvent{
+			GameUUID:  id,
+			Idx:       idx,
+			Event:     evt,
+			Racks:     postgres.Jsonb{RawMessage: racks},
+			PlayState: int(hist.PlayState),
+		})
+		if result.Error != nil {
+			return false, result.Error
+		}
+	}
+	return false, nil
 }
 
 // FromState returns an entity.Game from a DB State.
@@ -112,6 +280,20 @@ func FromState(timers entity.Timers, Started bool,
 	stats *entity.Stats,
 	gameEventChan chan<- *entity.EventWrapper, cfg *config.Config) (*entity.Game, error) {
 
+	hist := &macondopb.GameHistory{}
+	err := proto.Unmarshal(histBytes, hist)
+	if err != nil {
+		return nil, err
+	}
+	return fromHistory(timers, Started, GameEndReason, p0id, p1id, WinnerIdx, LoserIdx,
+		reqBytes, hist, stats, gameEventChan, cfg)
+}
+
+func fromHistory(timers entity.Timers, Started bool,
+	GameEndReason int, p0id, p1id uint, WinnerIdx, LoserIdx int, reqBytes []byte,
+	hist *macondopb.GameHistory, stats *entity.Stats,
+	gameEventChan chan<- *entity.EventWrapper, cfg *config.Config) (*entity.Game, error) {
+
 	g := &entity.Game{
 		Started:       Started,
 		Timers:        timers,
@@ -132,43 +314,16 @@ func FromState(timers entity.Timers, Started bool,
 	}
 	g.GameReq = req
 	log.Debug().Interface("req", req).Msg("req-unmarshal")
-	// Then unmarshal the history and start a game from it.
-	hist := &macondopb.GameHistory{}
-	err = proto.Unmarshal(histBytes, hist)
-	if err != nil {
-		return nil, err
-	}
+	// Then start a game from the history.
 	log.Info().Interface("hist", hist).Msg("hist-unmarshal")
 
-	var bd []string
-	switch req.Rules.BoardLayoutName {
-	case entity.CrosswordGame:
-		bd = board.CrosswordGameBoard
-	default:
-		return nil, errors.New("unsupported board layout")
-	}
-
-	dist, err := alphabet.LoadLetterDistribution(&cfg.MacondoConfig, req.Rules.LetterDistributionName)
-	if err != nil {
-		return nil, err
-	}
-
 	lexicon := hist.Lexicon
 	if lexicon == "" {
 		// This can happen for some early games where we didn't migrate this.
 		lexicon = req.Lexicon
 	}
 
-	gd, err := gaddag.LoadFromCache(&cfg.MacondoConfig, lexicon)
-	if err != nil {
-		return nil, err
-	}
-
-	rules := macondogame.NewGameRules(
-		&cfg.MacondoConfig, dist, board.MakeBoard(bd),
-		&gaddag.Lexicon{GenericDawg: gd},
-		cross_set.CrossScoreOnlyGenerator{Dist: dist})
-
+	rules, err := variants.GameRules(&cfg.MacondoConfig, req.Rules, lexicon)
 	if err != nil {
 		return nil, err
 	}
@@ -198,24 +353,190 @@ func FromState(timers entity.Timers, Started bool,
 }
 
 // Set takes in a game entity that _already exists_ in the DB, and writes it to
-// the database.
+// the database. While the game is going on, only its new events and its
+// timers are written; the full game is saved once it's over, or if events
+// were taken back.
 func (s *DBStore) Set(ctx context.Context, g *entity.Game) error {
 	// s.db.LogMode(true)
 	dbg, err := s.toDBObj(ctx, g)
 	if err != nil {
 		return err
 	}
-	th := &macondopb.GameHistory{}
-	err = proto.Unmarshal(dbg.History, th)
+	id := g.GameID()
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the game so that nobody else logs the same events.
+		locked := &game{}
+		result := tx.Set("gorm:query_option", "FOR UPDATE").Select("id").
+			Where("uuid = ?", id).First(locked)
+		if result.Error != nil {
+			return result.Error
+		}
+		tookBack, err := logEvents(tx, id, g.History())
+		if err != nil {
+			return err
+		}
+		if g.GameEndReason != pb.GameEndReason_NONE || tookBack {
+			// The log no longer tells the whole story, so save the full
+			// game.
+			return tx.Model(&game{}).Where("uuid = ?", id).Update(dbg).Error
+		}
+		return tx.Model(&game{}).Where("uuid = ?", id).Updates(map[string]interface{}{
+			"timers":    dbg.Timers,
+			"started":   dbg.Started,
+			"adjourned": dbg.Adjourned,
+		}).Error
+	})
+}
+
+// EndGame saves a game that just ended, along with the new ratings and
+// profile stats of its players, in one transaction. They're worked out by
+// the commit while the game and the players' profiles are locked. The game
+// is marked as ended, and ending it again does nothing; it returns whether
+// anything was saved.
+func (s *DBStore) EndGame(ctx context.Context, g *entity.Game,
+	commit *entity.GameEndCommit) (bool, error) {
+
+	id := g.GameID()
+	// Users are locked in the same order by every game, so that two games
+	// with a player in common that end at once can't deadlock.
+	uuids := []string{}
+	for _, p := range g.History().Players {
+		if len(uuids) == 0 || uuids[0] != p.UserId {
+			uuids = append(uuids, p.UserId)
+		}
+	}
+	sort.Strings(uuids)
+
+	committed := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		locked := &game{}
+		result := tx.Set("gorm:query_option", "FOR UPDATE").Select("id, end_committed").
+			Where("uuid = ?", id).First(locked)
+		if result.Error != nil {
+			return result.Error
+		}
+		if locked.EndCommitted {
+			return nil
+		}
+		players := map[string]*entity.User{}
+		for _, uuid := range uuids {
+			u, err := user.LockUserInTx(tx, uuid)
+			if err != nil {
+				return err
+			}
+			players[uuid] = u
+		}
+		ratings, profileStats, err := commit.Apply(players)
+		if err != nil {
+			return err
+		}
+
+		dbg, err := s.toDBObj(ctx, g)
+		if err != nil {
+			return err
+		}
+		dbg.EndCommitted = true
+		if _, err := logEvents(tx, id, g.History()); err != nil {
+			return err
+		}
+		if err := tx.Model(&game{}).Where("uuid = ?", id).Update(dbg).Error; err != nil {
+			return err
+		}
+		for _, uuid := range uuids {
+			if rating, ok := ratings[uuid]; ok {
+				if err := user.SetRatingInTx(tx, uuid, commit.VariantKey, rating); err != nil {
+					return err
+				}
+			}
+			if st, ok := profileStats[uuid]; ok {
+				if err := user.SetStatsInTx(tx, uuid, commit.VariantKey, st); err != nil {
+					return err
+				}
+			}
+		}
+		committed = true
+		return nil
+	})
 	if err != nil {
-		return err
+		return false, err
+	}
+	return committed, nil
+}
+
+// AnnotateGame saves a note added to a finished game, along with the new
+// profile stats of the player who wrote it, in one transaction. The commit
+// adds the note to a copy of the game loaded from its locked row, rather than
+// to whatever copy the caller has, so that notes that other nodes added in
+// the meantime aren't lost. It returns the saved game.
+func (s *DBStore) AnnotateGame(ctx context.Context, id string,
+	commit *entity.GameNoteCommit) (*entity.Game, error) {
+
+	var saved *entity.Game
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		locked := &game{}
+		result := tx.Set("gorm:query_option", "FOR UPDATE").Where("uuid = ?", id).First(locked)
+		if result.Error != nil {
+			return result.Error
+		}
+		hist := &macondopb.GameHistory{}
+		err := proto.Unmarshal(locked.History, hist)
+		if err != nil {
+			return err
+		}
+		g, err := s.fromDBObj(locked, hist)
+		if err != nil {
+			return err
+		}
+		u, err := user.LockUserInTx(tx, commit.UserID)
+		if err != nil {
+			return err
+		}
+		st, err := commit.Apply(g, u)
+		if err != nil {
+			return err
+		}
+		dbg, err := s.toDBObj(ctx, g)
+		if err != nil {
+			return err
+		}
+		// Nothing but the notes and the stats can change.
+		err = tx.Model(&game{}).Where("uuid = ?", id).Updates(map[string]interface{}{
+			"history": dbg.History,
+			"stats":   dbg.Stats,
+		}).Error
+		if err != nil {
+			return err
+		}
+		saved = g
+		if st == nil {
+			return nil
+		}
+		return user.SetStatsInTx(tx, commit.UserID, commit.VariantKey, st)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return saved, nil
+}
 
-	result := s.db.Model(&game{}).Set("gorm:query_option", "FOR UPDATE").
-		Where("uuid = ?", g.GameID()).Update(dbg)
-	// s.db.LogMode(false)
+// EndCommitted returns whether the game was already ended with EndGame.
+func (s *DBStore) EndCommitted(ctx context.Context, id string) (bool, error) {
+	g := &game{}
+	result := s.db.Select("end_committed").Where("uuid = ?", id).First(g)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return g.EndCommitted, nil
+}
 
-	return result.Error
+// MarkEndedGamesCommitted marks the games that ended before EndGame was
+// used as ended, so that they can't be ended again.
+func (s *DBStore) MarkEndedGamesCommitted(ctx context.Context) (int64, error) {
+	result := s.db.Model(&game{}).
+		Where("game_end_reason <> ? AND NOT end_committed", int(pb.GameEndReason_NONE)).
+		Update("end_committed", true)
+	return result.RowsAffected, result.Error
 }
 
 // Create saves a brand new entity to the database
@@ -224,15 +545,42 @@ func (s *DBStore) Create(ctx context.Context, g *entity.Game) error {
 	if err != nil {
 		return err
 	}
-	result := s.db.Create(dbg)
-	return result.Error
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Create(dbg)
+		if result.Error != nil {
+			return result.Error
+		}
+		_, err := logEvents(tx, g.GameID(), g.History())
+		return err
+	})
 }
 
 func (s *DBStore) ListActive(ctx context.Context) ([]*pb.GameMeta, error) {
+	return s.listActive(ctx, s.db)
+}
+
+// ListActiveCorrespondence lists the ongoing correspondence games of the
+// user with the given UUID.
+func (s *DBStore) ListActiveCorrespondence(ctx context.Context, userID string) ([]*pb.GameMeta, error) {
+	games, err := s.listActive(ctx, s.db.Where("u0.uuid = ? OR u1.uuid = ?", userID, userID))
+	if err != nil {
+		return nil, err
+	}
+	// The game mode is only in the serialized request, so filter here.
+	corres := []*pb.GameMeta{}
+	for _, g := range games {
+		if g.GameRequest.GameMode == pb.GameMode_CORRESPONDENCE {
+			corres = append(corres, g)
+		}
+	}
+	return corres, nil
+}
+
+func (s *DBStore) listActive(ctx context.Context, db *gorm.DB) ([]*pb.GameMeta, error) {
 	var games []activeGame
 
 	// Create query manually
-	result := s.db.Table("games").Select(
+	result := db.Table("games").Select(
 		"u0.username as p0_username, u1.username as p1_username, "+
 			"p0.ratings as p0_ratings, p1.ratings as p1_ratings, "+
 			"games.request as request, games.uuid ").
@@ -241,6 +589,7 @@ func (s *DBStore) ListActive(ctx context.Context) ([]*pb.GameMeta, error) {
 		Joins("JOIN profiles as p0 on p0.user_id = games.player0_id").
 		Joins("JOIN profiles as p1 on p1.user_id = games.player1_id").
 		Where("games.game_end_reason = ?", 0 /* ongoing games only*/).
+		Where("games.adjourned = ?", false).
 		Order("games.id").
 		Scan(&games)
 
@@ -261,6 +610,170 @@ func (s *DBStore) ListActive(ctx context.Context) ([]*pb.GameMeta, error) {
 	return gamesMeta, nil
 }
 
+// ListPastGames lists the finished games of a user that match the query,
+// most recent first, along with how many games match it in all. Imported
+// games are stored as being between the user who imported them and
+// themselves; they aren't games the user played, so they're left out.
+func (s *DBStore) ListPastGames(ctx context.Context, q *entity.PastGamesQuery) ([]*entity.PastGame, int, error) {
+	u, err := s.userStore.GetByUUID(ctx, q.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	db := s.db.Table("games").
+		Where("(games.player0_id = ? OR games.player1_id = ?)", u.ID, u.ID).
+		Where("games.player0_id <> games.player1_id").
+		Where("games.ended_at IS NOT NULL")
+	if q.OpponentID != "" {
+		opp, err := s.userStore.GetByUUID(ctx, q.OpponentID)
+		if err != nil {
+			return nil, 0, err
+		}
+		db = db.Where("(games.player0_id = ? OR games.player1_id = ?)", opp.ID, opp.ID)
+	}
+	if q.Lexicon != "" {
+		db = db.Where("games.lexicon = ?", q.Lexicon)
+	}
+	if q.VariantKey != "" {
+		db = db.Where("games.variant_key = ?", string(q.VariantKey))
+	}
+	if q.RatingMode != nil {
+		db = db.Where("games.rated = ?", *q.RatingMode == pb.RatingMode_RATED)
+	}
+	if len(q.ExcludeEndReasons) > 0 {
+		reasons := make([]int, len(q.ExcludeEndReasons))
+		for i, r := range q.ExcludeEndReasons {
+			reasons[i] = int(r)
+		}
+		db = db.Where("games.game_end_reason NOT IN (?)", reasons)
+	}
+	if !q.After.IsZero() {
+		db = db.Where("games.ended_at >= ?", q.After)
+	}
+	if !q.Before.IsZero() {
+		db = db.Where("games.ended_at < ?", q.Before)
+	}
+
+	var total int
+	if result := db.Count(&total); result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var rows []pastGame
+	result := db.Select(
+		"games.uuid, games.player0_id, games.game_end_reason, games.winner_idx, " +
+			"games.lexicon, games.variant_key, games.rated, games.ended_at, " +
+			"games.player0_score, games.player1_score, " +
+			"games.player0_rating_delta, games.player1_rating_delta, " +
+			"u0.username as p0_username, u1.username as p1_username").
+		Joins("JOIN users as u0 ON u0.id = games.player0_id").
+		Joins("JOIN users as u1 ON u1.id = games.player1_id").
+		Order("games.ended_at DESC, games.id DESC").
+		Limit(q.Limit).Offset(q.Offset).
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	games := make([]*entity.PastGame, len(rows))
+	for idx, r := range rows {
+		games[idx] = r.toPastGame(u.ID)
+	}
+	return games, total, nil
+}
+
+// GetHeadToHead gets the record of a user against an opponent, in one
+// variant if it isn't empty.
+func (s *DBStore) GetHeadToHead(ctx context.Context, userID, opponentID string,
+	variant entity.VariantKey) (*entity.HeadToHead, error) {
+
+	u, err := s.userStore.GetByUUID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	opp, err := s.userStore.GetByUUID(ctx, opponentID)
+	if err != nil {
+		return nil, err
+	}
+	db := s.db.Table("games").
+		Where("(games.player0_id = ? AND games.player1_id = ?) OR "+
+			"(games.player0_id = ? AND games.player1_id = ?)", u.ID, opp.ID, opp.ID, u.ID).
+		Where("games.ended_at IS NOT NULL").
+		Where("games.game_end_reason <> ?", int(pb.GameEndReason_ABORTED))
+	if variant != "" {
+		db = db.Where("games.variant_key = ?", string(variant))
+	}
+
+	var record struct {
+		Games  int
+		Wins   int
+		Draws  int
+		Spread int
+	}
+	result := db.Select("COUNT(*) AS games, "+
+		"COALESCE(SUM(CASE WHEN (games.player0_id = ? AND games.winner_idx = 0) OR "+
+		"(games.player1_id = ? AND games.winner_idx = 1) THEN 1 ELSE 0 END), 0) AS wins, "+
+		"COALESCE(SUM(CASE WHEN games.winner_idx = -1 THEN 1 ELSE 0 END), 0) AS draws, "+
+		"COALESCE(SUM(CASE WHEN games.player0_id = ? THEN games.player0_score - games.player1_score "+
+		"ELSE games.player1_score - games.player0_score END), 0) AS spread",
+		u.ID, u.ID, u.ID).Scan(&record)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var rows []struct{ Stats postgres.Jsonb }
+	result = db.Select("games.stats").Order("games.ended_at").Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	h2h := &entity.HeadToHead{
+		Games:  record.Games,
+		Wins:   record.Wins,
+		Losses: record.Games - record.Wins - record.Draws,
+		Draws:  record.Draws,
+		Spread: record.Spread,
+	}
+	for _, r := range rows {
+		var st *entity.Stats
+		err := json.Unmarshal(r.Stats.RawMessage, &st)
+		if err != nil {
+			log.Err(err).Msg("unmarshal-game-stats")
+			continue
+		}
+		// Some old games never had any stats.
+		if st != nil {
+			h2h.Stats = append(h2h.Stats, st)
+		}
+	}
+	return h2h, nil
+}
+
+// ListEndedGameIDs lists the IDs of the games in the given variants that
+// ended, in the order they ended. Aborted and imported games aren't listed;
+// they count for nothing.
+func (s *DBStore) ListEndedGameIDs(ctx context.Context, variants []entity.VariantKey) ([]string, error) {
+	keys := make([]string, len(variants))
+	for i, v := range variants {
+		keys[i] = string(v)
+	}
+	var ids []string
+	result := s.db.Model(&game{}).
+		Where("variant_key IN (?)", keys).
+		Where("ended_at IS NOT NULL").
+		Where("game_end_reason <> ?", int(pb.GameEndReason_ABORTED)).
+		Where("player0_id <> player1_id").
+		Order("ended_at, id").
+		Pluck("uuid", &ids)
+	return ids, result.Error
+}
+
+// SetRatingDeltas sets how much the players' ratings changed in a game.
+func (s *DBStore) SetRatingDeltas(ctx context.Context, id string, deltas [2]int) error {
+	return s.db.Model(&game{}).Where("uuid = ?", id).Updates(map[string]interface{}{
+		"player0_rating_delta": deltas[0],
+		"player1_rating_delta": deltas[1],
+	}).Error
+}
+
 // List all game IDs, ordered by date played. Should not be used by anything
 // other than debug or migration code when the db is still small.
 func (s *DBStore) ListAllIDs(ctx context.Context) ([]string, error) {
@@ -293,18 +806,34 @@ func (s *DBStore) toDBObj(ctx context.Context, g *entity.Game) (*game, error) {
 		return nil, err
 	}
 
+	// Games in an unsupported variant can still be saved; they just can't be
+	// searched by variant.
+	variantKey, _ := g.RatingKey()
+
 	dbg := &game{
-		UUID:          g.GameID(),
-		Player0ID:     g.PlayerDBIDs[0],
-		Player1ID:     g.PlayerDBIDs[1],
-		Timers:        postgres.Jsonb{RawMessage: timers},
-		Stats:         postgres.Jsonb{RawMessage: stats},
-		Started:       g.Started,
-		GameEndReason: int(g.GameEndReason),
-		WinnerIdx:     g.WinnerIdx,
-		LoserIdx:      g.LoserIdx,
-		Request:       req,
-		History:       hist,
+		UUID:               g.GameID(),
+		Player0ID:          g.PlayerDBIDs[0],
+		Player1ID:          g.PlayerDBIDs[1],
+		Timers:             postgres.Jsonb{RawMessage: timers},
+		Stats:              postgres.Jsonb{RawMessage: stats},
+		Started:            g.Started,
+		Adjourned:          g.Adjourned,
+		GameEndReason:      int(g.GameEndReason),
+		WinnerIdx:          g.WinnerIdx,
+		LoserIdx:           g.LoserIdx,
+		Request:            req,
+		History:            hist,
+		Lexicon:            g.GameReq.Lexicon,
+		VariantKey:         string(variantKey),
+		Rated:              g.GameReq.RatingMode == pb.RatingMode_RATED && !g.Unrated,
+		Player0Score:       g.PointsFor(0),
+		Player1Score:       g.PointsFor(1),
+		Player0RatingDelta: g.RatingDeltas[0],
+		Player1RatingDelta: g.RatingDeltas[1],
+	}
+	if g.GameEndReason != pb.GameEndReason_NONE {
+		endedAt := time.Unix(0, g.Timers.TimeOfLastUpdate*int64(time.Millisecond))
+		dbg.EndedAt = &endedAt
 	}
 	return dbg, nil
 }
@@ -353,3 +882,46 @@ func (a *activeGame) ToGameMeta() (*pb.GameMeta, error) {
 
 	return &pb.GameMeta{Users: players, GameRequest: req, Id: a.Uuid}, nil
 }
+
+type pastGame struct {
+	Uuid               string
+	Player0ID          uint
+	GameEndReason      int
+	WinnerIdx          int
+	Lexicon            string
+	VariantKey         string
+	Rated              bool
+	EndedAt            time.Time
+	Player0Score       int
+	Player1Score       int
+	Player0RatingDelta int
+	Player1RatingDelta int
+	P0Username         string
+	P1Username         string
+}
+
+// toPastGame returns the game from the point of view of the player with the
+// given database ID.
+func (p *pastGame) toPastGame(playerID uint) *entity.PastGame {
+	pidx := 0
+	if p.Player0ID != playerID {
+		pidx = 1
+	}
+	scores := [2]int{p.Player0Score, p.Player1Score}
+	deltas := [2]int{p.Player0RatingDelta, p.Player1RatingDelta}
+	usernames := [2]string{p.P0Username, p.P1Username}
+	return &entity.PastGame{
+		GameID:        p.Uuid,
+		Opponent:      usernames[1-pidx],
+		Score:         scores[pidx],
+		OpponentScore: scores[1-pidx],
+		Won:           p.WinnerIdx == pidx,
+		Lost:          p.WinnerIdx == 1-pidx,
+		GameEndReason: pb.GameEndReason(p.GameEndReason),
+		Lexicon:       p.Lexicon,
+		VariantKey:    entity.VariantKey(p.VariantKey),
+		Rated:         p.Rated,
+		RatingDelta:   deltas[pidx],
+		EndedAt:       p.EndedAt,
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/matryer/is"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/domino14/macondo/alphabet"
 	"github.com/domino14/macondo/board"
@@ -324,3 +326,318 @@ func TestListActive(t *testing.T) {
 	ustore.(*user.DBStore).Disconnect()
 	store.Disconnect()
 }
+
+func TestEventLog(t *testing.T) {
+	log.Info().Msg("TestEventLog")
+	recreateDB()
+
+	is := is.New(t)
+	ustore := userStore(TestingDBConnStr + " dbname=liwords_test")
+	store, err := NewDBStore(&config.Config{
+		MacondoConfig: DefaultConfig,
+		DBConnString:  TestingDBConnStr + " dbname=liwords_test"}, ustore)
+	is.NoErr(err)
+
+	entGame, err := store.Get(context.Background(), "wJxURccCgSAPivUvj4QdYL")
+	is.NoErr(err)
+	_, err = entGame.PlayScoringMove("8E", "AGUE", true)
+	is.NoErr(err)
+	err = store.Set(context.Background(), entGame)
+	is.NoErr(err)
+
+	// The move was logged, and the saved history wasn't touched.
+	var logged int
+	is.NoErr(store.db.Model(&gameEvent{}).Where("game_uuid = ?", "wJxURccCgSAPivUvj4QdYL").
+		Count(&logged).Error)
+	is.Equal(logged, 1)
+	g := &game{}
+	is.NoErr(store.db.Where("uuid = ?", "wJxURccCgSAPivUvj4QdYL").First(g).Error)
+	hist := &macondopb.GameHistory{}
+	is.NoErr(proto.Unmarshal(g.History, hist))
+	is.Equal(len(hist.Events), 0)
+
+	replayed, err := store.Replay(context.Background(), "wJxURccCgSAPivUvj4QdYL")
+	is.NoErr(err)
+	is.Equal(replayed.Turn(), 1)
+	is.Equal(replayed.NickOnTurn(), "cesar")
+	is.Equal(replayed.RackLettersFor(0), "AEIJVVW")
+	is.Equal(replayed.SpreadFor(0), -10)
+
+	ustore.(*user.DBStore).Disconnect()
+	store.Disconnect()
+}
+
+func createFinishedGame(store *DBStore, ustore pkguser.Store, p0, p1 string, winner int,
+	ratingMode pb.RatingMode, endedAt time.Time, is *is.I) {
+
+	u0, err := ustore.Get(context.Background(), p0)
+	is.NoErr(err)
+	u1, err := ustore.Get(context.Background(), p1)
+	is.NoErr(err)
+
+	mcg := newMacondoGame([2]*entity.User{u0, u1})
+	mcg.StartGame()
+	entGame := entity.NewGame(mcg, &pb.GameRequest{
+		Lexicon:            "NWL18",
+		InitialTimeSeconds: 300,
+		RatingMode:         ratingMode,
+		Rules: &pb.GameRules{
+			BoardLayoutName:        "CrosswordGame",
+			LetterDistributionName: "english",
+		},
+	})
+	entGame.PlayerDBIDs = [2]uint{u0.ID, u1.ID}
+	entGame.ResetTimersAndStart()
+	entGame.Timers.TimeOfLastUpdate = endedAt.UnixNano() / int64(time.Millisecond)
+	entGame.SetGameEndReason(pb.GameEndReason_RESIGNED)
+	entGame.SetWinnerIdx(winner)
+	entGame.SetLoserIdx(1 - winner)
+	if winner == -1 {
+		entGame.SetLoserIdx(-1)
+	}
+	if ratingMode == pb.RatingMode_RATED {
+		entGame.RatingDeltas = [2]int{12, -12}
+	}
+	is.NoErr(store.Create(context.Background(), entGame))
+}
+
+func TestListPastGames(t *testing.T) {
+	log.Info().Msg("TestListPastGames")
+	recreateDB()
+	is := is.New(t)
+	ctx := context.Background()
+
+	ustore := userStore(TestingDBConnStr + " dbname=liwords_test")
+	store, err := NewDBStore(&config.Config{
+		MacondoConfig: DefaultConfig,
+		DBConnString:  TestingDBConnStr + " dbname=liwords_test",
+	}, ustore)
+	is.NoErr(err)
+
+	aug1 := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	aug2 := aug1.Add(24 * time.Hour)
+	createFinishedGame(store, ustore, "cesar", "jesse", 0, pb.RatingMode_RATED, aug1, is)
+	createFinishedGame(store, ustore, "jesse", "mina", -1, pb.RatingMode_CASUAL, aug2, is)
+	// Imported games are between the importer and themselves; they're not
+	// listed.
+	createFinishedGame(store, ustore, "jesse", "jesse", 0, pb.RatingMode_CASUAL, aug2, is)
+
+	jesse := "3xpEkpRAy3AizbVmDg3kdi"
+	games, total, err := store.ListPastGames(ctx, &entity.PastGamesQuery{UserID: jesse, Limit: 10})
+	is.NoErr(err)
+	is.Equal(total, 2)
+	is.Equal(len(games), 2)
+	// The most recent game comes first. The game against cesar that's
+	// still going on isn't listed.
+	is.Equal(games[0].Opponent, "mina")
+	is.True(!games[0].Won && !games[0].Lost)
+	is.Equal(games[0].EndedAt.Unix(), aug2.Unix())
+	is.Equal(games[1].Opponent, "cesar")
+	is.True(games[1].Lost)
+	is.Equal(games[1].RatingDelta, -12)
+	is.Equal(games[1].VariantKey, entity.VariantKey("NWL18.classic.blitz"))
+
+	// One game per page.
+	games, total, err = store.ListPastGames(ctx, &entity.PastGamesQuery{UserID: jesse, Limit: 1, Offset: 1})
+	is.NoErr(err)
+	is.Equal(total, 2)
+	is.Equal(len(games), 1)
+	is.Equal(games[0].Opponent, "cesar")
+
+	casual := pb.RatingMode_CASUAL
+	for _, q := range []*entity.PastGamesQuery{
+		{UserID: jesse, OpponentID: "iW7AaqNJDuaxgcYnrFfcJF"},
+		{UserID: jesse, RatingMode: &casual},
+		{UserID: jesse, After: aug2},
+	} {
+		q.Limit = 10
+		games, total, err = store.ListPastGames(ctx, q)
+		is.NoErr(err)
+		is.Equal(total, 1)
+		is.Equal(games[0].Opponent, "mina")
+	}
+	games, total, err = store.ListPastGames(ctx, &entity.PastGamesQuery{
+		UserID: jesse, Lexicon: "CSW19", Limit: 10})
+	is.NoErr(err)
+	is.Equal(total, 0)
+	// Both games were resigned.
+	games, total, err = store.ListPastGames(ctx, &entity.PastGamesQuery{UserID: jesse, Limit: 10,
+		ExcludeEndReasons: []pb.GameEndReason{pb.GameEndReason_ABORTED}})
+	is.NoErr(err)
+	is.Equal(total, 2)
+	games, total, err = store.ListPastGames(ctx, &entity.PastGamesQuery{UserID: jesse, Limit: 10,
+		ExcludeEndReasons: []pb.GameEndReason{pb.GameEndReason_ABORTED, pb.GameEndReason_RESIGNED}})
+	is.NoErr(err)
+	is.Equal(total, 0)
+
+	ustore.(*user.DBStore).Disconnect()
+	store.Disconnect()
+}
+
+func TestGetHeadToHead(t *testing.T) {
+	log.Info().Msg("TestGetHeadToHead")
+	recreateDB()
+	is := is.New(t)
+	ctx := context.Background()
+
+	ustore := userStore(TestingDBConnStr + " dbname=liwords_test")
+	store, err := NewDBStore(&config.Config{
+		MacondoConfig: DefaultConfig,
+		DBConnString:  TestingDBConnStr + " dbname=liwords_test",
+	}, ustore)
+	is.NoErr(err)
+
+	aug1 := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	createFinishedGame(store, ustore, "cesar", "jesse", 0, pb.RatingMode_RATED, aug1, is)
+	createFinishedGame(store, ustore, "jesse", "cesar", 0, pb.RatingMode_RATED, aug1.Add(time.Hour), is)
+	createFinishedGame(store, ustore, "cesar", "jesse", 1, pb.RatingMode_CASUAL, aug1.Add(2*time.Hour), is)
+	createFinishedGame(store, ustore, "jesse", "cesar", -1, pb.RatingMode_CASUAL, aug1.Add(3*time.Hour), is)
+	createFinishedGame(store, ustore, "jesse", "mina", 0, pb.RatingMode_RATED, aug1, is)
+
+	jesse, cesar := "3xpEkpRAy3AizbVmDg3kdi", "mozEwaVMvTfUA2oxZfYN8k"
+	h2h, err := store.GetHeadToHead(ctx, jesse, cesar, "")
+	is.NoErr(err)
+	// The game against cesar that's still going on doesn't count.
+	is.Equal(h2h.Games, 4)
+	is.Equal(h2h.Wins, 2)
+	is.Equal(h2h.Losses, 1)
+	is.Equal(h2h.Draws, 1)
+	// None of these games had any moves, or stats.
+	is.Equal(h2h.Spread, 0)
+	is.Equal(len(h2h.Stats), 0)
+
+	h2h, err = store.GetHeadToHead(ctx, cesar, jesse, "")
+	is.NoErr(err)
+	is.Equal(h2h.Wins, 1)
+	is.Equal(h2h.Losses, 2)
+
+	h2h, err = store.GetHeadToHead(ctx, jesse, cesar, "CSW19.classic.blitz")
+	is.NoErr(err)
+	is.Equal(h2h.Games, 0)
+
+	ustore.(*user.DBStore).Disconnect()
+	store.Disconnect()
+}
+
+func TestEndGame(t *testing.T) {
+	log.Info().Msg("TestEndGame")
+	recreateDB()
+	is := is.New(t)
+	ctx := context.Background()
+
+	ustore := userStore(TestingDBConnStr + " dbname=liwords_test")
+	store, err := NewDBStore(&config.Config{
+		MacondoConfig: DefaultConfig,
+		DBConnString:  TestingDBConnStr + " dbname=liwords_test",
+	}, ustore)
+	is.NoErr(err)
+
+	cesar, err := ustore.Get(ctx, "cesar")
+	is.NoErr(err)
+	jesse, err := ustore.Get(ctx, "jesse")
+	is.NoErr(err)
+	mcg := newMacondoGame([2]*entity.User{cesar, jesse})
+	mcg.StartGame()
+	entGame := entity.NewGame(mcg, &pb.GameRequest{
+		Lexicon:            "NWL18",
+		InitialTimeSeconds: 300,
+		RatingMode:         pb.RatingMode_RATED,
+		Rules: &pb.GameRules{
+			BoardLayoutName:        "CrosswordGame",
+			LetterDistributionName: "english",
+		},
+	})
+	entGame.PlayerDBIDs = [2]uint{cesar.ID, jesse.ID}
+	entGame.ResetTimersAndStart()
+	is.NoErr(store.Create(ctx, entGame))
+
+	entGame.SetGameEndReason(pb.GameEndReason_RESIGNED)
+	entGame.SetWinnerIdx(0)
+	entGame.SetLoserIdx(1)
+	variant := entity.VariantKey("NWL18.classic.blitz")
+	applied := 0
+	commit := &entity.GameEndCommit{
+		VariantKey: variant,
+		Apply: func(players map[string]*entity.User) (map[string]entity.SingleRating,
+			map[string]*entity.Stats, error) {
+
+			applied++
+			is.Equal(len(players), 2)
+			is.Equal(players[cesar.UUID].Username, "cesar")
+			return map[string]entity.SingleRating{
+				cesar.UUID: {Rating: 1520, RatingDeviation: 300, Volatility: 0.06},
+				jesse.UUID: {Rating: 1480, RatingDeviation: 300, Volatility: 0.06},
+			}, map[string]*entity.Stats{
+				cesar.UUID: {PlayerOneId: cesar.UUID},
+			}, nil
+		},
+	}
+	committed, err := store.EndGame(ctx, entGame, commit)
+	is.NoErr(err)
+	is.True(committed)
+	ended, err := store.EndCommitted(ctx, entGame.GameID())
+	is.NoErr(err)
+	is.True(ended)
+
+	// Ending the game again doesn't change anything, and doesn't even rate it.
+	committed, err = store.EndGame(ctx, entGame, commit)
+	is.NoErr(err)
+	is.True(!committed)
+	is.Equal(applied, 1)
+
+	jesse, err = ustore.Get(ctx, "jesse")
+	is.NoErr(err)
+	rating, err := jesse.GetRating(variant)
+	is.NoErr(err)
+	is.Equal(rating.Rating, float64(1480))
+	cesar, err = ustore.Get(ctx, "cesar")
+	is.NoErr(err)
+	is.Equal(cesar.Profile.Stats.Data[variant].PlayerOneId, cesar.UUID)
+
+	saved, err := store.Get(ctx, entGame.GameID())
+	is.NoErr(err)
+	is.Equal(saved.GameEndReason, pb.GameEndReason_RESIGNED)
+	is.Equal(saved.WinnerIdx, 0)
+
+	ustore.(*user.DBStore).Disconnect()
+	store.Disconnect()
+}
+
+func TestListEndedGameIDs(t *testing.T) {
+	log.Info().Msg("TestListEndedGameIDs")
+	recreateDB()
+	is := is.New(t)
+	ctx := context.Background()
+
+	ustore := userStore(TestingDBConnStr + " dbname=liwords_test")
+	store, err := NewDBStore(&config.Config{
+		MacondoConfig: DefaultConfig,
+		DBConnString:  TestingDBConnStr + " dbname=liwords_test",
+	}, ustore)
+	is.NoErr(err)
+
+	aug1 := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	// The games weren't created in the order they ended.
+	createFinishedGame(store, ustore, "jesse", "mina", 0, pb.RatingMode_RATED, aug1.Add(time.Hour), is)
+	createFinishedGame(store, ustore, "cesar", "jesse", 0, pb.RatingMode_CASUAL, aug1, is)
+	createFinishedGame(store, ustore, "jesse", "jesse", 0, pb.RatingMode_CASUAL, aug1, is)
+
+	ids, err := store.ListEndedGameIDs(ctx, []entity.VariantKey{"NWL18.classic.blitz"})
+	is.NoErr(err)
+	// The game that's still going on and the imported game aren't listed.
+	is.Equal(len(ids), 2)
+	first, err := store.Get(ctx, ids[0])
+	is.NoErr(err)
+	is.Equal(first.Timers.TimeOfLastUpdate, aug1.UnixNano()/int64(time.Millisecond))
+	second, err := store.Get(ctx, ids[1])
+	is.NoErr(err)
+	is.True(!second.Unrated)
+	is.Equal(second.RatingDeltas, [2]int{12, -12})
+
+	ids, err = store.ListEndedGameIDs(ctx, []entity.VariantKey{"CSW19.classic.blitz"})
+	is.NoErr(err)
+	is.Equal(len(ids), 0)
+
+	ustore.(*user.DBStore).Disconnect()
+	store.Disconnect()
+}
@@ -0,0 +1,68 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// AcquireLeaseScript acquires or extends a lease, unless someone else holds
+// it, and returns the holder.
+const AcquireLeaseScript = `
+-- Arguments to this Lua script:
+-- gameID, nodeID, ttl in milliseconds  (ARGV[1] through [3])
+
+local leasekey = "gamelease:"..ARGV[1]
+local owner = redis.call("GET", leasekey)
+if owner == false or owner == ARGV[2] then
+    redis.call("SET", leasekey, ARGV[2], "PX", ARGV[3])
+    return ARGV[2]
+end
+return owner
+`
+
+// ReleaseLeaseScript releases a lease, if it's held by the given node.
+const ReleaseLeaseScript = `
+-- Arguments to this Lua script:
+-- gameID, nodeID  (ARGV[1] through [2])
+
+local leasekey = "gamelease:"..ARGV[1]
+if redis.call("GET", leasekey) == ARGV[2] then
+    redis.call("DEL", leasekey)
+end
+`
+
+// RedisLeaseStore keeps the leases of API nodes on games in Redis.
+type RedisLeaseStore struct {
+	redisPool *redis.Pool
+
+	acquireScript *redis.Script
+	releaseScript *redis.Script
+}
+
+func NewRedisLeaseStore(r *redis.Pool) *RedisLeaseStore {
+	return &RedisLeaseStore{
+		redisPool:     r,
+		acquireScript: redis.NewScript(0, AcquireLeaseScript),
+		releaseScript: redis.NewScript(0, ReleaseLeaseScript),
+	}
+}
+
+// Acquire acquires (or extends) the lease on the game for the node, unless
+// another node holds it. It returns the node holding the lease.
+func (s *RedisLeaseStore) Acquire(ctx context.Context, gameID, nodeID string,
+	ttl time.Duration) (string, error) {
+
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	return redis.String(s.acquireScript.Do(conn, gameID, nodeID, ttl.Milliseconds()))
+}
+
+// Release gives up the node's lease on the game, if it holds it.
+func (s *RedisLeaseStore) Release(ctx context.Context, gameID, nodeID string) error {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	_, err := s.releaseScript.Do(conn, gameID, nodeID)
+	return err
+}
@@ -0,0 +1,117 @@
+// Package quickpair stores the pools of users waiting to be quick-paired.
+package quickpair
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/domino14/liwords/pkg/entity"
+)
+
+// MemoryStore is an in-memory store of the quick-pair pools. It's only good
+// for a single API node; see RedisStore for one that several can share.
+type MemoryStore struct {
+	sync.Mutex
+
+	entrants map[string]*entity.QuickPairEntrant
+	seq      int64
+	// pairedUntil is when the current pairing period ends.
+	pairedUntil time.Time
+}
+
+// NewMemoryStore creates a MemoryStore with nobody waiting.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entrants: make(map[string]*entity.QuickPairEntrant)}
+}
+
+// Join puts the entrant in their pool, taking them out of any other pool
+// they were in.
+func (m *MemoryStore) Join(ctx context.Context, e *entity.QuickPairEntrant) error {
+	m.Lock()
+	defer m.Unlock()
+	m.seq++
+	stored := *e
+	stored.Seq = m.seq
+	stored.Misses = 0
+	m.entrants[e.UserID] = &stored
+	return nil
+}
+
+// Leave takes the user out of whatever pool they were in.
+func (m *MemoryStore) Leave(ctx context.Context, userID string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	_, ok := m.entrants[userID]
+	delete(m.entrants, userID)
+	return ok, nil
+}
+
+// LeaveConn takes whoever joined a pool from the given connection out of it.
+func (m *MemoryStore) LeaveConn(ctx context.Context, connID string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	for userID, e := range m.entrants {
+		if e.ConnID == connID {
+			delete(m.entrants, userID)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Entrants returns everyone waiting, in the order they joined.
+func (m *MemoryStore) Entrants(ctx context.Context) ([]*entity.QuickPairEntrant, error) {
+	m.Lock()
+	defer m.Unlock()
+	ret := make([]*entity.QuickPairEntrant, 0, len(m.entrants))
+	for _, e := range m.entrants {
+		cp := *e
+		ret = append(ret, &cp)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Seq < ret[j].Seq })
+	return ret, nil
+}
+
+// current returns whether the entrant is still waiting as they were listed.
+func (m *MemoryStore) current(e *entity.QuickPairEntrant) bool {
+	stored, ok := m.entrants[e.UserID]
+	return ok && stored.Seq == e.Seq
+}
+
+// Settle takes the paired entrants out of their pools, and counts a miss for
+// the missed ones.
+func (m *MemoryStore) Settle(ctx context.Context, pairs [][2]*entity.QuickPairEntrant,
+	missed []*entity.QuickPairEntrant) ([][2]*entity.QuickPairEntrant, error) {
+
+	m.Lock()
+	defer m.Unlock()
+	settled := [][2]*entity.QuickPairEntrant{}
+	for _, p := range pairs {
+		if !m.current(p[0]) || !m.current(p[1]) {
+			continue
+		}
+		delete(m.entrants, p[0].UserID)
+		delete(m.entrants, p[1].UserID)
+		settled = append(settled, p)
+	}
+	for _, e := range missed {
+		if m.current(e) {
+			m.entrants[e.UserID].Misses++
+		}
+	}
+	return settled, nil
+}
+
+// LockPairing returns true once per period.
+func (m *MemoryStore) LockPairing(ctx context.Context, period time.Duration) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	now := time.Now()
+	if now.Before(m.pairedUntil) {
+		return false, nil
+	}
+	m.pairedUntil = now.Add(period)
+	return true, nil
+}
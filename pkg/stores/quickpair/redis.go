@@ -0,0 +1,277 @@
+package quickpair
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// RedisStore keeps the quick-pair pools in Redis, so that every API node
+// sees the same ones.
+//
+// Every entrant is in the quickpair:entrants hash, by user ID. Their
+// sequence numbers and misses, which change on their own, are in the
+// quickpair:seqs and quickpair:misses hashes. The quickpair:conns hash has
+// the user ID for every connection ID, and quickpair:conns:of has it the
+// other way around. quickpair:seq is the last sequence number handed out,
+// and quickpair:pairing is set for as long as the current pairing period
+// lasts.
+type RedisStore struct {
+	redisPool *redis.Pool
+
+	joinScript      *redis.Script
+	leaveScript     *redis.Script
+	leaveConnScript *redis.Script
+	entrantsScript  *redis.Script
+	settleScript    *redis.Script
+}
+
+// leaveFunction is a Lua function that takes a user out of their pool, and
+// returns whether they were in one. It's pasted into the scripts below.
+const leaveFunction = `
+local function leave(user)
+    local conn = redis.call("HGET", "quickpair:conns:of", user)
+    if conn ~= false and redis.call("HGET", "quickpair:conns", conn) == user then
+        redis.call("HDEL", "quickpair:conns", conn)
+    end
+    redis.call("HDEL", "quickpair:conns:of", user)
+    redis.call("HDEL", "quickpair:seqs", user)
+    redis.call("HDEL", "quickpair:misses", user)
+    return redis.call("HDEL", "quickpair:entrants", user)
+end
+`
+
+// JoinQuickPairScript puts a user in their pool, taking them out of any other
+// pool they were in, and returns their sequence number.
+const JoinQuickPairScript = leaveFunction + `
+-- Arguments to this Lua script:
+-- user, conn, data  (ARGV[1] through [3])
+
+leave(ARGV[1])
+local seq = redis.call("INCR", "quickpair:seq")
+redis.call("HSET", "quickpair:entrants", ARGV[1], ARGV[3])
+redis.call("HSET", "quickpair:seqs", ARGV[1], seq)
+if ARGV[2] ~= "" then
+    redis.call("HSET", "quickpair:conns", ARGV[2], ARGV[1])
+    redis.call("HSET", "quickpair:conns:of", ARGV[1], ARGV[2])
+end
+return seq
+`
+
+// LeaveQuickPairScript takes a user out of their pool.
+const LeaveQuickPairScript = leaveFunction + `
+-- Arguments to this Lua script:
+-- user  (ARGV[1])
+
+return leave(ARGV[1])
+`
+
+// LeaveConnQuickPairScript takes whoever joined from a connection out of
+// their pool.
+const LeaveConnQuickPairScript = leaveFunction + `
+-- Arguments to this Lua script:
+-- conn  (ARGV[1])
+
+local user = redis.call("HGET", "quickpair:conns", ARGV[1])
+if user == false then
+    return 0
+end
+return leave(user)
+`
+
+// QuickPairEntrantsScript returns every entrant, with their sequence numbers
+// and misses, all at once.
+const QuickPairEntrantsScript = `
+return {redis.call("HGETALL", "quickpair:entrants"),
+    redis.call("HGETALL", "quickpair:seqs"),
+    redis.call("HGETALL", "quickpair:misses")}
+`
+
+// SettleQuickPairScript takes paired users out of their pools, and counts a
+// miss for the missed ones, unless they left or joined again since their
+// sequence number was read. It returns the (one-based) indexes of the pairs
+// that were taken out.
+const SettleQuickPairScript = leaveFunction + `
+-- Arguments to this Lua script:
+-- number of pairs, then user, seq, user, seq for every pair, then user, seq
+-- for every missed user.
+
+local function current(user, seq)
+    return redis.call("HGET", "quickpair:seqs", user) == seq
+end
+
+local npairs = tonumber(ARGV[1])
+local settled = {}
+for i = 1, npairs do
+    local a = 2 + (i - 1) * 4
+    if current(ARGV[a], ARGV[a+1]) and current(ARGV[a+2], ARGV[a+3]) then
+        leave(ARGV[a])
+        leave(ARGV[a+2])
+        table.insert(settled, i)
+    end
+end
+for a = 2 + npairs * 4, #ARGV, 2 do
+    if current(ARGV[a], ARGV[a+1]) then
+        redis.call("HINCRBY", "quickpair:misses", ARGV[a], 1)
+    end
+end
+return settled
+`
+
+// NewRedisStore creates a new RedisStore.
+func NewRedisStore(r *redis.Pool) *RedisStore {
+	return &RedisStore{
+		redisPool:       r,
+		joinScript:      redis.NewScript(0, JoinQuickPairScript),
+		leaveScript:     redis.NewScript(0, LeaveQuickPairScript),
+		leaveConnScript: redis.NewScript(0, LeaveConnQuickPairScript),
+		entrantsScript:  redis.NewScript(0, QuickPairEntrantsScript),
+		settleScript:    redis.NewScript(0, SettleQuickPairScript),
+	}
+}
+
+// storedEntrant is how an entrant is kept in Redis, minus the parts that
+// change while they wait.
+type storedEntrant struct {
+	UserID      string `json:"u"`
+	ConnID      string `json:"c"`
+	Pool        string `json:"p"`
+	GameRequest []byte `json:"g"`
+	Rating      int    `json:"r"`
+	MinRating   int    `json:"mn"`
+	MaxRating   int    `json:"mx"`
+}
+
+// Join puts the entrant in their pool, taking them out of any other pool
+// they were in.
+func (s *RedisStore) Join(ctx context.Context, e *entity.QuickPairEntrant) error {
+	req, err := proto.Marshal(e.GameRequest)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&storedEntrant{
+		UserID:      e.UserID,
+		ConnID:      e.ConnID,
+		Pool:        e.Pool,
+		GameRequest: req,
+		Rating:      e.Rating,
+		MinRating:   e.MinRating,
+		MaxRating:   e.MaxRating,
+	})
+	if err != nil {
+		return err
+	}
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	_, err = s.joinScript.Do(conn, e.UserID, e.ConnID, data)
+	return err
+}
+
+// Leave takes the user out of whatever pool they were in.
+func (s *RedisStore) Leave(ctx context.Context, userID string) (bool, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	return redis.Bool(s.leaveScript.Do(conn, userID))
+}
+
+// LeaveConn takes whoever joined a pool from the given connection out of it.
+func (s *RedisStore) LeaveConn(ctx context.Context, connID string) (bool, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	return redis.Bool(s.leaveConnScript.Do(conn, connID))
+}
+
+// Entrants returns everyone waiting, in the order they joined.
+func (s *RedisStore) Entrants(ctx context.Context) ([]*entity.QuickPairEntrant, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	ret, err := redis.Values(s.entrantsScript.Do(conn))
+	if err != nil {
+		return nil, err
+	}
+	data, err := redis.StringMap(ret[0], nil)
+	if err != nil {
+		return nil, err
+	}
+	seqs, err := redis.Int64Map(ret[1], nil)
+	if err != nil {
+		return nil, err
+	}
+	misses, err := redis.IntMap(ret[2], nil)
+	if err != nil {
+		return nil, err
+	}
+	entrants := make([]*entity.QuickPairEntrant, 0, len(data))
+	for _, d := range data {
+		stored := &storedEntrant{}
+		if err := json.Unmarshal([]byte(d), stored); err != nil {
+			return nil, err
+		}
+		req := &pb.GameRequest{}
+		if err := proto.Unmarshal(stored.GameRequest, req); err != nil {
+			return nil, err
+		}
+		entrants = append(entrants, &entity.QuickPairEntrant{
+			UserID:      stored.UserID,
+			ConnID:      stored.ConnID,
+			Pool:        stored.Pool,
+			GameRequest: req,
+			Rating:      stored.Rating,
+			MinRating:   stored.MinRating,
+			MaxRating:   stored.MaxRating,
+			Seq:         seqs[stored.UserID],
+			Misses:      misses[stored.UserID],
+		})
+	}
+	sort.Slice(entrants, func(i, j int) bool { return entrants[i].Seq < entrants[j].Seq })
+	return entrants, nil
+}
+
+// Settle takes the paired entrants out of their pools, and counts a miss for
+// the missed ones.
+func (s *RedisStore) Settle(ctx context.Context, pairs [][2]*entity.QuickPairEntrant,
+	missed []*entity.QuickPairEntrant) ([][2]*entity.QuickPairEntrant, error) {
+
+	args := []interface{}{len(pairs)}
+	for _, p := range pairs {
+		args = append(args, p[0].UserID, strconv.FormatInt(p[0].Seq, 10),
+			p[1].UserID, strconv.FormatInt(p[1].Seq, 10))
+	}
+	for _, e := range missed {
+		args = append(args, e.UserID, strconv.FormatInt(e.Seq, 10))
+	}
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	idxs, err := redis.Ints(s.settleScript.Do(conn, args...))
+	if err != nil {
+		return nil, err
+	}
+	settled := make([][2]*entity.QuickPairEntrant, len(idxs))
+	for i, idx := range idxs {
+		settled[i] = pairs[idx-1]
+	}
+	return settled, nil
+}
+
+// LockPairing returns true to the first caller in every period.
+func (s *RedisStore) LockPairing(ctx context.Context, period time.Duration) (bool, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	ms := period.Milliseconds()
+	if ms < 1 {
+		return true, nil
+	}
+	_, err := redis.String(conn.Do("SET", "quickpair:pairing", "1", "NX", "PX", ms))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,117 @@
+package quickpair
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+var RedisURL = os.Getenv("REDIS_URL")
+
+func newPool(t *testing.T) *redis.Pool {
+	if RedisURL == "" {
+		t.Skip("REDIS_URL is not set")
+	}
+	r := &redis.Pool{
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial:        func() (redis.Conn, error) { return redis.DialURL(RedisURL) },
+	}
+	conn := r.Get()
+	defer conn.Close()
+	conn.Do("FLUSHDB")
+	return r
+}
+
+func newEntrant(userID, connID, pool string) *entity.QuickPairEntrant {
+	return &entity.QuickPairEntrant{
+		UserID:      userID,
+		ConnID:      connID,
+		Pool:        pool,
+		GameRequest: &pb.GameRequest{Lexicon: "NWL18", InitialTimeSeconds: 900},
+		Rating:      1500,
+	}
+}
+
+func TestRedisJoinAndLeave(t *testing.T) {
+	is := is.New(t)
+	s := NewRedisStore(newPool(t))
+	ctx := context.Background()
+
+	is.NoErr(s.Join(ctx, newEntrant("cesar", "conn1", "p")))
+	is.NoErr(s.Join(ctx, newEntrant("mina", "conn2", "p")))
+	// Joining again moves cesar to the back of the other pool.
+	is.NoErr(s.Join(ctx, newEntrant("cesar", "conn3", "q")))
+	entrants, err := s.Entrants(ctx)
+	is.NoErr(err)
+	is.Equal(len(entrants), 2)
+	is.Equal(entrants[0].UserID, "mina")
+	is.Equal(entrants[1].UserID, "cesar")
+	is.Equal(entrants[1].Pool, "q")
+	is.Equal(entrants[1].GameRequest.InitialTimeSeconds, int32(900))
+
+	// cesar's old connection is no longer theirs.
+	left, err := s.LeaveConn(ctx, "conn1")
+	is.NoErr(err)
+	is.True(!left)
+	left, err = s.LeaveConn(ctx, "conn3")
+	is.NoErr(err)
+	is.True(left)
+	left, err = s.Leave(ctx, "mina")
+	is.NoErr(err)
+	is.True(left)
+	entrants, err = s.Entrants(ctx)
+	is.NoErr(err)
+	is.Equal(len(entrants), 0)
+}
+
+func TestRedisSettle(t *testing.T) {
+	is := is.New(t)
+	s := NewRedisStore(newPool(t))
+	ctx := context.Background()
+
+	is.NoErr(s.Join(ctx, newEntrant("cesar", "conn1", "p")))
+	is.NoErr(s.Join(ctx, newEntrant("mina", "conn2", "p")))
+	is.NoErr(s.Join(ctx, newEntrant("jesse", "conn3", "p")))
+	is.NoErr(s.Join(ctx, newEntrant("josh", "conn4", "q")))
+	is.NoErr(s.Join(ctx, newEntrant("conrad", "conn5", "q")))
+	listed, err := s.Entrants(ctx)
+	is.NoErr(err)
+
+	// conrad joins again before the pairing is settled.
+	is.NoErr(s.Join(ctx, newEntrant("conrad", "conn5", "q")))
+	settled, err := s.Settle(ctx, [][2]*entity.QuickPairEntrant{
+		{listed[0], listed[1]}, {listed[3], listed[4]}}, []*entity.QuickPairEntrant{listed[2]})
+	is.NoErr(err)
+	is.Equal(len(settled), 1)
+	is.Equal(settled[0][0].UserID, "cesar")
+	is.Equal(settled[0][1].UserID, "mina")
+
+	entrants, err := s.Entrants(ctx)
+	is.NoErr(err)
+	is.Equal(len(entrants), 3)
+	is.Equal(entrants[0].UserID, "jesse")
+	is.Equal(entrants[0].Misses, 1)
+	is.Equal(entrants[2].UserID, "conrad")
+	is.Equal(entrants[2].Misses, 0)
+}
+
+func TestRedisLockPairing(t *testing.T) {
+	is := is.New(t)
+	s := NewRedisStore(newPool(t))
+	ctx := context.Background()
+
+	ok, err := s.LockPairing(ctx, time.Second)
+	is.NoErr(err)
+	is.True(ok)
+	ok, err = s.LockPairing(ctx, time.Second)
+	is.NoErr(err)
+	is.True(!ok)
+}
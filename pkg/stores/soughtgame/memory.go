@@ -4,30 +4,37 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/domino14/liwords/pkg/entity"
 	"github.com/rs/zerolog/log"
 )
 
 var (
-	errNoID     = errors.New("sought game ID was not defined")
-	errNotFound = errors.New("sought game ID was not found")
+	errNoID        = errors.New("sought game ID was not defined")
+	errNotFound    = errors.New("sought game ID was not found")
+	errAlreadyOpen = errors.New("user already has an open sought game")
 )
 
-// MemoryStore is a purely in-memory store of a sought game. In the real final
-// implementation, we will probably use a Postgres-backed store, or perhaps
-// something in Redis.
+// MemoryStore is a purely in-memory store of a sought game. Everything in it
+// is lost when the server restarts; see RedisStore for a persistent one.
 type MemoryStore struct {
 	sync.RWMutex
+	expiration time.Duration
 
 	soughtGames             map[string]*entity.SoughtGame
 	soughtGamesByUser       map[string]*entity.SoughtGame
 	soughtGamesByConnID     map[string]*entity.SoughtGame
 	matchRequestsByReceiver map[string][]*entity.SoughtGame
+	expires                 map[string]time.Time
 }
 
-func NewMemoryStore() *MemoryStore {
+// NewMemoryStore creates a new MemoryStore. Sought games expire after the
+// given duration.
+func NewMemoryStore(expiration time.Duration) *MemoryStore {
 	return &MemoryStore{
+		expiration:              expiration,
+		expires:                 make(map[string]time.Time),
 		soughtGames:             make(map[string]*entity.SoughtGame),
 		soughtGamesByUser:       make(map[string]*entity.SoughtGame),
 		soughtGamesByConnID:     make(map[string]*entity.SoughtGame),
@@ -57,11 +64,19 @@ func (m *MemoryStore) GetByConnID(ctx context.Context, connID string) (*entity.S
 	return g, nil
 }
 
-// Set sets the game in the store.
+// Set sets the game in the store, unless the seeker already has an unexpired
+// one.
 func (m *MemoryStore) Set(ctx context.Context, game *entity.SoughtGame) error {
 	m.Lock()
 	defer m.Unlock()
+	if cur, ok := m.soughtGamesByUser[game.Seeker()]; ok {
+		if time.Now().Before(m.expires[cur.ID()]) {
+			return errAlreadyOpen
+		}
+		m.delete(cur.ID())
+	}
 	m.soughtGames[game.ID()] = game
+	m.expires[game.ID()] = time.Now().Add(m.expiration)
 	m.soughtGamesByUser[game.Seeker()] = game
 	m.soughtGamesByConnID[game.ConnID()] = game
 	if game.Type() == entity.TypeMatch {
@@ -82,20 +97,58 @@ func (m *MemoryStore) Delete(ctx context.Context, id string) error {
 	m.Lock()
 	defer m.Unlock()
 
+	if m.delete(id) == nil {
+		log.Warn().Str("game-id", id).Msg("tried-to-delete-nonexistent-game-id")
+	}
+	return nil
+}
+
+// Claim deletes the game by game ID, and returns it. If two callers claim the
+// same game at the same time, only one of them gets it.
+func (m *MemoryStore) Claim(ctx context.Context, id string) (*entity.SoughtGame, error) {
+	m.Lock()
+	defer m.Unlock()
+	g := m.delete(id)
+	if g == nil {
+		return nil, errNotFound
+	}
+	return g, nil
+}
+
+// DeleteExpired deletes all the expired sought games, and returns their IDs.
+func (m *MemoryStore) DeleteExpired(ctx context.Context) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	now := time.Now()
+	expired := []string{}
+	for id, t := range m.expires {
+		if !now.Before(t) {
+			m.delete(id)
+			expired = append(expired, id)
+		}
+	}
+	return expired, nil
+}
+
+// delete deletes the game by game ID, and returns it (or nil if it didn't
+// exist). The caller must hold the lock.
+func (m *MemoryStore) delete(id string) *entity.SoughtGame {
 	g, ok := m.soughtGames[id]
 	if !ok {
-		log.Warn().Str("game-id", id).Msg("tried-to-delete-nonexistent-game-id")
 		return nil
 	}
-
-	userID := g.Seeker()
 	delete(m.soughtGames, id)
-	delete(m.soughtGamesByUser, userID)
-	delete(m.soughtGamesByConnID, g.ConnID())
+	delete(m.expires, id)
+	if m.soughtGamesByUser[g.Seeker()] == g {
+		delete(m.soughtGamesByUser, g.Seeker())
+	}
+	if m.soughtGamesByConnID[g.ConnID()] == g {
+		delete(m.soughtGamesByConnID, g.ConnID())
+	}
 	if g.Type() == entity.TypeMatch {
 		m.deleteFromReqsByReceiver(g)
 	}
-	return nil
+	return g
 }
 
 func (m *MemoryStore) deleteFromReqsByReceiver(g *entity.SoughtGame) {
@@ -123,12 +176,7 @@ func (m *MemoryStore) DeleteForUser(ctx context.Context, userID string) (string,
 		// Do nothing, game never existed
 		return "", nil
 	}
-	delete(m.soughtGamesByUser, userID)
-	delete(m.soughtGamesByConnID, game.ConnID())
-	delete(m.soughtGames, game.ID())
-	if game.Type() == entity.TypeMatch {
-		m.deleteFromReqsByReceiver(game)
-	}
+	m.delete(game.ID())
 	return game.ID(), nil
 }
 
@@ -142,15 +190,7 @@ func (m *MemoryStore) DeleteForConnID(ctx context.Context, connID string) (strin
 		// Do nothing, game never existed
 		return "", nil
 	}
-
-	delete(m.soughtGamesByUser, game.Seeker())
-	delete(m.soughtGamesByConnID, connID)
-	delete(m.soughtGames, game.ID())
-
-	if game.Type() == entity.TypeMatch {
-		m.deleteFromReqsByReceiver(game)
-	}
-
+	m.delete(game.ID())
 	return game.ID(), nil
 }
 
@@ -0,0 +1,47 @@
+package soughtgame
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestMemorySetRefusesSecondRequest(t *testing.T) {
+	is := is.New(t)
+	s := NewMemoryStore(time.Hour)
+	ctx := context.Background()
+
+	seek := newSeek("cesar", "conn1")
+	is.NoErr(s.Set(ctx, seek))
+	// One open request per user, like the RedisStore.
+	is.Equal(s.Set(ctx, newSeek("cesar", "conn2")), errAlreadyOpen)
+	sg, err := s.GetByConnID(ctx, "conn1")
+	is.NoErr(err)
+	is.Equal(sg.ID(), seek.ID())
+	_, err = s.GetByConnID(ctx, "conn2")
+	is.Equal(err, errNotFound)
+
+	_, err = s.DeleteForUser(ctx, "cesar")
+	is.NoErr(err)
+	is.NoErr(s.Set(ctx, newSeek("cesar", "conn2")))
+}
+
+func TestMemorySetReplacesExpiredRequest(t *testing.T) {
+	is := is.New(t)
+	s := NewMemoryStore(-time.Second)
+	ctx := context.Background()
+
+	seek := newSeek("cesar", "conn1")
+	is.NoErr(s.Set(ctx, seek))
+	next := newSeek("cesar", "conn2")
+	is.NoErr(s.Set(ctx, next))
+	_, err := s.Get(ctx, seek.ID())
+	is.Equal(err, errNotFound)
+	_, err = s.GetByConnID(ctx, "conn1")
+	is.Equal(err, errNotFound)
+	sg, err := s.GetByConnID(ctx, "conn2")
+	is.NoErr(err)
+	is.Equal(sg.ID(), next.ID())
+}
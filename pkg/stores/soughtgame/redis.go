@@ -0,0 +1,369 @@
+package soughtgame
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+const (
+	seekType  = "seek"
+	matchType = "match"
+)
+
+// RedisStore is a Redis store of sought games, so that they survive a
+// restart of the API server, and so that several API servers can share them.
+//
+// A sought game is a hash at soughtgame:<id>. It's indexed by its seeker at
+// soughtgame:user:<userID>, by its connection at soughtgame:conn:<connID>,
+// and, for match requests, by the receiver in the soughtgame:receiver:<userID>
+// set. All the seek IDs are in the soughtgame:seeks set, and every ID is in the
+// soughtgame:expiry sorted set, scored by when it expires.
+type RedisStore struct {
+	redisPool  *redis.Pool
+	expiration time.Duration
+
+	setScript    *redis.Script
+	deleteScript *redis.Script
+}
+
+// SetSoughtGameScript sets a sought game and all of its indexes, unless the
+// seeker already has an unexpired one.
+const SetSoughtGameScript = `
+-- Arguments to this Lua script:
+-- id, type, data, user, conn, receiver, expiresAt, now  (ARGV[1] through [8])
+
+local gamekey = "soughtgame:"..ARGV[1]
+local userkey = "soughtgame:user:"..ARGV[4]
+
+local cur = redis.call("GET", userkey)
+if cur ~= false then
+    local curexpiry = redis.call("HGET", "soughtgame:"..cur, "expires")
+    if curexpiry ~= false and tonumber(curexpiry) > tonumber(ARGV[8]) then
+        return 0
+    end
+end
+
+redis.call("HSET", gamekey, "type", ARGV[2], "data", ARGV[3], "user", ARGV[4],
+    "conn", ARGV[5], "receiver", ARGV[6], "expires", ARGV[7])
+redis.call("SET", userkey, ARGV[1])
+if ARGV[5] ~= "" then
+    redis.call("SET", "soughtgame:conn:"..ARGV[5], ARGV[1])
+end
+if ARGV[2] == "seek" then
+    redis.call("SADD", "soughtgame:seeks", ARGV[1])
+else
+    redis.call("SADD", "soughtgame:receiver:"..ARGV[6], ARGV[1])
+end
+redis.call("ZADD", "soughtgame:expiry", ARGV[7], ARGV[1])
+return 1
+`
+
+// DeleteSoughtGameScript deletes a sought game and all of its indexes. The
+// game is looked up by ID, seeker, or connection ID. It returns the ID and
+// type and data of the deleted game, or false if there was none. Since it's
+// atomic, only one caller ever gets any given sought game back.
+const DeleteSoughtGameScript = `
+-- Arguments to this Lua script:
+-- lookup ("id", "user", or "conn"), value  (ARGV[1] through [2])
+
+local id = ARGV[2]
+if ARGV[1] ~= "id" then
+    id = redis.call("GET", "soughtgame:"..ARGV[1]..":"..ARGV[2])
+    if id == false then
+        return false
+    end
+end
+
+local gamekey = "soughtgame:"..id
+local game = redis.call("HMGET", gamekey, "type", "data", "user", "conn", "receiver")
+redis.call("ZREM", "soughtgame:expiry", id)
+if game[1] == false then
+    return false
+end
+
+-- Only remove the indexes that still point to this game.
+if redis.call("GET", "soughtgame:user:"..game[3]) == id then
+    redis.call("DEL", "soughtgame:user:"..game[3])
+end
+if game[4] ~= "" and redis.call("GET", "soughtgame:conn:"..game[4]) == id then
+    redis.call("DEL", "soughtgame:conn:"..game[4])
+end
+if game[1] == "seek" then
+    redis.call("SREM", "soughtgame:seeks", id)
+else
+    redis.call("SREM", "soughtgame:receiver:"..game[5], id)
+end
+redis.call("DEL", gamekey)
+return {id, game[1], game[2]}
+`
+
+// NewRedisStore creates a new RedisStore. Sought games expire after the
+// given duration.
+func NewRedisStore(r *redis.Pool, expiration time.Duration) *RedisStore {
+	return &RedisStore{
+		redisPool:    r,
+		expiration:   expiration,
+		setScript:    redis.NewScript(0, SetSoughtGameScript),
+		deleteScript: redis.NewScript(0, DeleteSoughtGameScript),
+	}
+}
+
+func unmarshalSoughtGame(sgType string, data []byte) (*entity.SoughtGame, error) {
+	switch sgType {
+	case seekType:
+		req := &pb.SeekRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, err
+		}
+		return &entity.SoughtGame{SeekRequest: req}, nil
+	case matchType:
+		req := &pb.MatchRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, err
+		}
+		return &entity.SoughtGame{MatchRequest: req}, nil
+	}
+	return nil, errors.New("unknown sought game type: " + sgType)
+}
+
+func (s *RedisStore) get(conn redis.Conn, id string) (*entity.SoughtGame, error) {
+	vals, err := redis.Values(conn.Do("HMGET", "soughtgame:"+id, "type", "data", "expires"))
+	if err != nil {
+		return nil, err
+	}
+	if vals[0] == nil {
+		return nil, errNotFound
+	}
+	expires, err := redis.Int64(vals[2], nil)
+	if err != nil {
+		return nil, err
+	}
+	if expires <= time.Now().Unix() {
+		return nil, errNotFound
+	}
+	sgType, err := redis.String(vals[0], nil)
+	if err != nil {
+		return nil, err
+	}
+	data, err := redis.Bytes(vals[1], nil)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSoughtGame(sgType, data)
+}
+
+// getAll gets all the unexpired sought games whose IDs are in the given set.
+func (s *RedisStore) getAll(conn redis.Conn, setKey string) ([]*entity.SoughtGame, error) {
+	ids, err := redis.Strings(conn.Do("SMEMBERS", setKey))
+	if err != nil {
+		return nil, err
+	}
+	ret := []*entity.SoughtGame{}
+	for _, id := range ids {
+		sg, err := s.get(conn, id)
+		if err == errNotFound {
+			// It expired, and hasn't been swept up yet.
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		ret = append(ret, sg)
+	}
+	return ret, nil
+}
+
+// Get gets the sought game with the given ID.
+func (s *RedisStore) Get(ctx context.Context, id string) (*entity.SoughtGame, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	return s.get(conn, id)
+}
+
+// GetByConnID gets the sought game with the given socket connection ID.
+func (s *RedisStore) GetByConnID(ctx context.Context, connID string) (*entity.SoughtGame, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	id, err := redis.String(conn.Do("GET", "soughtgame:conn:"+connID))
+	if err == redis.ErrNil {
+		return nil, errNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return s.get(conn, id)
+}
+
+// Set sets the game in the store, unless the seeker already has an unexpired
+// one.
+func (s *RedisStore) Set(ctx context.Context, game *entity.SoughtGame) error {
+	var sgType, receiver string
+	var data []byte
+	var err error
+	switch game.Type() {
+	case entity.TypeSeek:
+		sgType = seekType
+		data, err = proto.Marshal(game.SeekRequest)
+	case entity.TypeMatch:
+		sgType = matchType
+		receiver = game.MatchRequest.ReceivingUser.UserId
+		data, err = proto.Marshal(game.MatchRequest)
+	default:
+		return errors.New("sought game has no request")
+	}
+	if err != nil {
+		return err
+	}
+	if game.ID() == "" {
+		return errNoID
+	}
+	conn := s.redisPool.Get()
+	defer conn.Close()
+
+	now := time.Now()
+	set, err := redis.Int(s.setScript.Do(conn, game.ID(), sgType, data, game.Seeker(),
+		game.ConnID(), receiver, now.Add(s.expiration).Unix(), now.Unix()))
+	if err != nil {
+		return err
+	}
+	if set == 0 {
+		return errAlreadyOpen
+	}
+	return nil
+}
+
+// del runs the delete script. It returns the deleted game, or nil if there
+// was nothing to delete.
+func (s *RedisStore) del(lookup, value string) (*entity.SoughtGame, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	ret, err := redis.Values(s.deleteScript.Do(conn, lookup, value))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var sgType string
+	var data []byte
+	if _, err := redis.Scan(ret[1:], &sgType, &data); err != nil {
+		return nil, err
+	}
+	return unmarshalSoughtGame(sgType, data)
+}
+
+// Delete deletes the game by game ID.
+func (s *RedisStore) Delete(ctx context.Context, id string) error {
+	sg, err := s.del("id", id)
+	if err != nil {
+		return err
+	}
+	if sg == nil {
+		log.Warn().Str("game-id", id).Msg("tried-to-delete-nonexistent-game-id")
+	}
+	return nil
+}
+
+// Claim deletes the game by game ID, and returns it. If two callers claim the
+// same game at the same time, only one of them gets it.
+func (s *RedisStore) Claim(ctx context.Context, id string) (*entity.SoughtGame, error) {
+	sg, err := s.del("id", id)
+	if err != nil {
+		return nil, err
+	}
+	if sg == nil {
+		return nil, errNotFound
+	}
+	return sg, nil
+}
+
+// DeleteForUser deletes the game by seeker ID.
+func (s *RedisStore) DeleteForUser(ctx context.Context, userID string) (string, error) {
+	sg, err := s.del("user", userID)
+	if err != nil || sg == nil {
+		return "", err
+	}
+	return sg.ID(), nil
+}
+
+// DeleteForConnID deletes the game by connection ID
+func (s *RedisStore) DeleteForConnID(ctx context.Context, connID string) (string, error) {
+	sg, err := s.del("conn", connID)
+	if err != nil || sg == nil {
+		return "", err
+	}
+	return sg.ID(), nil
+}
+
+// DeleteExpired deletes all the expired sought games, and returns their IDs.
+func (s *RedisStore) DeleteExpired(ctx context.Context) ([]string, error) {
+	conn := s.redisPool.Get()
+	ids, err := redis.Strings(conn.Do("ZRANGEBYSCORE", "soughtgame:expiry", "-inf",
+		strconv.FormatInt(time.Now().Unix(), 10)))
+	conn.Close()
+	if err != nil {
+		return nil, err
+	}
+	expired := []string{}
+	for _, id := range ids {
+		sg, err := s.del("id", id)
+		if err != nil {
+			return expired, err
+		}
+		if sg != nil {
+			expired = append(expired, id)
+		}
+	}
+	return expired, nil
+}
+
+// ListOpenSeeks lists all open seek requests
+func (s *RedisStore) ListOpenSeeks(ctx context.Context) ([]*entity.SoughtGame, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	return s.getAll(conn, "soughtgame:seeks")
+}
+
+// ListOpenMatches lists all open match requests for the receiver.
+func (s *RedisStore) ListOpenMatches(ctx context.Context, receiver string) ([]*entity.SoughtGame, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	return s.getAll(conn, "soughtgame:receiver:"+receiver)
+}
+
+// ExistsForUser returns true if the user already has an outstanding seek request.
+func (s *RedisStore) ExistsForUser(ctx context.Context, userID string) (bool, error) {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+	id, err := redis.String(conn.Do("GET", "soughtgame:user:"+userID))
+	if err == redis.ErrNil {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	_, err = s.get(conn, id)
+	if err == errNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// UserMatchedBy returns true if there is an open seek request from matcher for user
+func (s *RedisStore) UserMatchedBy(ctx context.Context, userID, matcher string) (bool, error) {
+	matches, err := s.ListOpenMatches(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range matches {
+		if m.MatchRequest.User.UserId == matcher {
+			return true, nil
+		}
+	}
+	return false, nil
+}
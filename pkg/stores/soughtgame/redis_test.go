@@ -0,0 +1,168 @@
+package soughtgame
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+var RedisURL = os.Getenv("REDIS_URL")
+
+func newPool(addr string) *redis.Pool {
+	return &redis.Pool{
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial:        func() (redis.Conn, error) { return redis.DialURL(addr) },
+	}
+}
+
+func flushTestDB(r *redis.Pool) {
+	conn := r.Get()
+	defer conn.Close()
+	conn.Do("FLUSHDB")
+}
+
+func newSeek(userID, connID string) *entity.SoughtGame {
+	return entity.NewSoughtGame(&pb.SeekRequest{
+		GameRequest:  &pb.GameRequest{Lexicon: "NWL18", InitialTimeSeconds: 900},
+		User:         &pb.MatchUser{UserId: userID},
+		ConnectionId: connID,
+	})
+}
+
+func newMatch(userID, receiverID, connID string) *entity.SoughtGame {
+	return entity.NewMatchRequest(&pb.MatchRequest{
+		GameRequest:   &pb.GameRequest{Lexicon: "NWL18", InitialTimeSeconds: 900},
+		User:          &pb.MatchUser{UserId: userID},
+		ReceivingUser: &pb.MatchUser{UserId: receiverID},
+		ConnectionId:  connID,
+	})
+}
+
+func TestRedisSetAndGet(t *testing.T) {
+	if RedisURL == "" {
+		t.Skip("REDIS_URL is not set")
+	}
+	is := is.New(t)
+	redisPool := newPool(RedisURL)
+	flushTestDB(redisPool)
+	s := NewRedisStore(redisPool, time.Hour)
+	ctx := context.Background()
+
+	seek := newSeek("cesar", "conn1")
+	is.NoErr(s.Set(ctx, seek))
+	sg, err := s.Get(ctx, seek.ID())
+	is.NoErr(err)
+	is.Equal(sg.SeekRequest.GameRequest.InitialTimeSeconds, int32(900))
+	sg, err = s.GetByConnID(ctx, "conn1")
+	is.NoErr(err)
+	is.Equal(sg.ID(), seek.ID())
+
+	// One open request per user.
+	is.Equal(s.Set(ctx, newSeek("cesar", "conn2")), errAlreadyOpen)
+	exists, err := s.ExistsForUser(ctx, "cesar")
+	is.NoErr(err)
+	is.True(exists)
+
+	match := newMatch("mina", "cesar", "conn3")
+	is.NoErr(s.Set(ctx, match))
+	seeks, err := s.ListOpenSeeks(ctx)
+	is.NoErr(err)
+	is.Equal(len(seeks), 1)
+	matches, err := s.ListOpenMatches(ctx, "cesar")
+	is.NoErr(err)
+	is.Equal(len(matches), 1)
+	is.Equal(matches[0].ID(), match.ID())
+	matched, err := s.UserMatchedBy(ctx, "cesar", "mina")
+	is.NoErr(err)
+	is.True(matched)
+
+	id, err := s.DeleteForConnID(ctx, "conn3")
+	is.NoErr(err)
+	is.Equal(id, match.ID())
+	matched, err = s.UserMatchedBy(ctx, "cesar", "mina")
+	is.NoErr(err)
+	is.True(!matched)
+
+	id, err = s.DeleteForUser(ctx, "cesar")
+	is.NoErr(err)
+	is.Equal(id, seek.ID())
+	_, err = s.Get(ctx, seek.ID())
+	is.Equal(err, errNotFound)
+	exists, err = s.ExistsForUser(ctx, "cesar")
+	is.NoErr(err)
+	is.True(!exists)
+}
+
+func TestRedisClaimOnlyOnce(t *testing.T) {
+	if RedisURL == "" {
+		t.Skip("REDIS_URL is not set")
+	}
+	is := is.New(t)
+	redisPool := newPool(RedisURL)
+	flushTestDB(redisPool)
+	s := NewRedisStore(redisPool, time.Hour)
+	ctx := context.Background()
+
+	seek := newSeek("cesar", "conn1")
+	is.NoErr(s.Set(ctx, seek))
+
+	claimed := make(chan bool)
+	for i := 0; i < 5; i++ {
+		go func() {
+			_, err := s.Claim(ctx, seek.ID())
+			claimed <- err == nil
+		}()
+	}
+	got := 0
+	for i := 0; i < 5; i++ {
+		if <-claimed {
+			got++
+		}
+	}
+	is.Equal(got, 1)
+	seeks, err := s.ListOpenSeeks(ctx)
+	is.NoErr(err)
+	is.Equal(len(seeks), 0)
+	_, err = s.GetByConnID(ctx, "conn1")
+	is.Equal(err, errNotFound)
+}
+
+func TestRedisExpiry(t *testing.T) {
+	if RedisURL == "" {
+		t.Skip("REDIS_URL is not set")
+	}
+	is := is.New(t)
+	redisPool := newPool(RedisURL)
+	flushTestDB(redisPool)
+	s := NewRedisStore(redisPool, -time.Second)
+	ctx := context.Background()
+
+	seek := newSeek("cesar", "conn1")
+	is.NoErr(s.Set(ctx, seek))
+	_, err := s.Get(ctx, seek.ID())
+	is.Equal(err, errNotFound)
+	seeks, err := s.ListOpenSeeks(ctx)
+	is.NoErr(err)
+	is.Equal(len(seeks), 0)
+
+	// An expired request doesn't keep its user from making another.
+	seek2 := newSeek("cesar", "conn2")
+	is.NoErr(s.Set(ctx, seek2))
+
+	expired, err := s.DeleteExpired(ctx)
+	is.NoErr(err)
+	is.Equal(len(expired), 2)
+	conn := redisPool.Get()
+	defer conn.Close()
+	n, err := redis.Int(conn.Do("DBSIZE"))
+	is.NoErr(err)
+	is.Equal(n, 0)
+}
@@ -19,11 +19,11 @@ type ListStatStore struct {
 
 type liststat struct {
 	GameID    string `gorm:"index"`
-	PlayerID  string `gorm:"index"`
-	Timestamp int64  `gorm:"index"` // unix timestamp in milliseconds
+	PlayerID  string `gorm:"index:idx_liststats_player_id,idx_liststat_player_type_time"`
+	StatType  int    `gorm:"index:idx_liststat_player_type_time"`
+	Timestamp int64  `gorm:"index:idx_liststats_timestamp,idx_liststat_player_type_time"` // unix timestamp in milliseconds
 
-	StatType int
-	Item     postgres.Jsonb
+	Item postgres.Jsonb
 }
 
 func NewListStatStore(dbURL string) (*ListStatStore, error) {
@@ -92,6 +92,77 @@ func (l *ListStatStore) GetListItems(statType int, gameIds []string, playerID st
 		return nil, result.Error
 	}
 
+	return toListItems(stats)
+}
+
+// GetRatingHistory gets the ratings a player had after each of their rated
+// games in the variant, oldest first. Only games that ended at or after
+// `after`, and before `before`, are included; both are unix timestamps in
+// milliseconds, and zero means no limit.
+func (l *ListStatStore) GetRatingHistory(playerID string, variant entity.VariantKey,
+	after, before int64) ([]*entity.ListItem, error) {
+
+	var stats []liststat
+
+	db := l.db.Table("liststats").
+		Select("game_id, player_id, timestamp, item").
+		Where("player_id = ? AND stat_type = ?", playerID,
+			entity.StatName_value[entity.RATINGS_STAT]).
+		// Unrated games have a rating item too, just without a rating.
+		Where("item->>'v' = ? AND item->>'r' IS NOT NULL", string(variant))
+	if after != 0 {
+		db = db.Where("timestamp >= ?", after)
+	}
+	if before != 0 {
+		db = db.Where("timestamp < ?", before)
+	}
+	result := db.Order("timestamp").Scan(&stats)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return toListItems(stats)
+}
+
+// ReplaceRatingHistory replaces the ratings that players had after each of
+// their rated games in the given variants with the given items, in one
+// transaction. The rating items of unrated games, which have no rating, are
+// left alone.
+func (l *ListStatStore) ReplaceRatingHistory(variants []entity.VariantKey,
+	items []*entity.ListItem) error {
+
+	keys := make([]string, len(variants))
+	for i, v := range variants {
+		keys[i] = string(v)
+	}
+	statType := entity.StatName_value[entity.RATINGS_STAT]
+	return l.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("stat_type = ?", statType).
+			Where("item->>'v' IN (?) AND item->>'r' IS NOT NULL", keys).
+			Delete(&liststat{})
+		if result.Error != nil {
+			return result.Error
+		}
+		for _, it := range items {
+			jsonitem, err := json.Marshal(it.Item)
+			if err != nil {
+				return err
+			}
+			result = tx.Create(&liststat{
+				GameID:    it.GameId,
+				PlayerID:  it.PlayerId,
+				Timestamp: it.Time,
+				StatType:  statType,
+				Item:      postgres.Jsonb{RawMessage: jsonitem},
+			})
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+		return nil
+	})
+}
+
+func toListItems(stats []liststat) ([]*entity.ListItem, error) {
 	items := make([]*entity.ListItem, len(stats))
 	for idx, dbstat := range stats {
 		datum := entity.ListDatum{}
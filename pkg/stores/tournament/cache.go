@@ -0,0 +1,73 @@
+package tournament
+
+import (
+	"context"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/domino14/liwords/pkg/entity"
+)
+
+// The number of tournaments to keep in memory. There aren't going to be
+// many running at the same time.
+const CacheCap = 100
+
+// Cache keeps tournaments in memory, so that every user of a tournament on
+// this node shares the same object. Like the game cache, it is per-node, so
+// its copies can be behind the database; they're only good for reading.
+// Changes always start over from the database.
+type Cache struct {
+	cache   *lru.Cache
+	backing *DBStore
+}
+
+// NewCache creates a tournament cache in front of a DB store.
+func NewCache(backing *DBStore) *Cache {
+	lrucache, err := lru.New(CacheCap)
+	if err != nil {
+		panic(err)
+	}
+	return &Cache{cache: lrucache, backing: backing}
+}
+
+// Get gets a tournament from the cache, loading it into the cache if it's
+// not there.
+func (c *Cache) Get(ctx context.Context, id string) (*entity.Tournament, error) {
+	t, ok := c.cache.Get(id)
+	if ok && t != nil {
+		return t.(*entity.Tournament), nil
+	}
+	uncached, err := c.backing.Get(ctx, id)
+	if err == nil {
+		c.cache.Add(id, uncached)
+	}
+	return uncached, err
+}
+
+// Update changes the tournament in the backing store (see DBStore.Update),
+// and caches the result. The cached copy is replaced rather than changed, so
+// whoever is still reading it isn't affected.
+func (c *Cache) Update(ctx context.Context, id string,
+	f func(t *entity.Tournament) error) (*entity.Tournament, error) {
+
+	t, err := c.backing.Update(ctx, id, f)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(id, t)
+	return t, nil
+}
+
+// Create creates the tournament in the backing store and in the cache.
+func (c *Cache) Create(ctx context.Context, t *entity.Tournament) error {
+	err := c.backing.Create(ctx, t)
+	if err != nil {
+		return err
+	}
+	c.cache.Add(t.UUID, t)
+	return nil
+}
+
+func (c *Cache) Disconnect() {
+	c.backing.Disconnect()
+}
@@ -0,0 +1,167 @@
+package tournament
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	tpb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// DBStore is a postgres-backed store for tournaments.
+type DBStore struct {
+	db *gorm.DB
+}
+
+type tournament struct {
+	gorm.Model
+	UUID          string `gorm:"type:varchar(24);index"`
+	Name          string
+	DirectorID    string `gorm:"type:varchar(24)"`
+	NumRounds     int
+	PairingMethod int
+
+	// The protobuf representation of the game request template.
+	Request []byte
+	// Players, pairings and results.
+	Data postgres.Jsonb
+}
+
+// tournamentData is everything about a tournament that changes as it
+// goes on.
+type tournamentData struct {
+	Players      []*entity.TournamentPlayer    `json:"players"`
+	Rounds       [][]*entity.TournamentPairing `json:"rounds"`
+	CurrentRound int                           `json:"round"`
+}
+
+// NewDBStore creates a new DB store for tournaments.
+func NewDBStore(dbURL string) (*DBStore, error) {
+	db, err := gorm.Open("postgres", dbURL)
+	if err != nil {
+		return nil, err
+	}
+	db.AutoMigrate(&tournament{})
+	return &DBStore{db: db}, nil
+}
+
+// Get gets the tournament with the given ID.
+func (s *DBStore) Get(ctx context.Context, id string) (*entity.Tournament, error) {
+	t := &tournament{}
+	if result := s.db.Where("uuid = ?", id).First(t); result.Error != nil {
+		return nil, result.Error
+	}
+	return fromDBObj(t)
+}
+
+// Update loads the tournament with the given ID and calls f to change it,
+// then saves it, all in one transaction. The tournament's row stays locked
+// until then, so no other node can change it in the meantime. If f returns
+// an error, nothing is saved. It returns the changed tournament.
+func (s *DBStore) Update(ctx context.Context, id string,
+	f func(t *entity.Tournament) error) (*entity.Tournament, error) {
+
+	var t *entity.Tournament
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		dbt := &tournament{}
+		result := tx.Set("gorm:query_option", "FOR UPDATE").Where("uuid = ?", id).First(dbt)
+		if result.Error != nil {
+			return result.Error
+		}
+		var err error
+		t, err = fromDBObj(dbt)
+		if err != nil {
+			return err
+		}
+		if err = f(t); err != nil {
+			return err
+		}
+		dbt, err = toDBObj(t)
+		if err != nil {
+			return err
+		}
+		// Updating from a struct would skip any field that's zero, so list
+		// them all.
+		result = tx.Model(&tournament{}).Where("uuid = ?", id).Updates(map[string]interface{}{
+			"name":           dbt.Name,
+			"director_id":    dbt.DirectorID,
+			"num_rounds":     dbt.NumRounds,
+			"pairing_method": dbt.PairingMethod,
+			"request":        dbt.Request,
+			"data":           dbt.Data,
+		})
+		return result.Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func fromDBObj(t *tournament) (*entity.Tournament, error) {
+	var data tournamentData
+	err := json.Unmarshal(t.Data.RawMessage, &data)
+	if err != nil {
+		return nil, err
+	}
+	req := &pb.GameRequest{}
+	err = proto.Unmarshal(t.Request, req)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.Tournament{
+		UUID:          t.UUID,
+		Name:          t.Name,
+		DirectorID:    t.DirectorID,
+		GameReq:       req,
+		NumRounds:     t.NumRounds,
+		PairingMethod: tpb.PairingMethod(t.PairingMethod),
+		Players:       data.Players,
+		Rounds:        data.Rounds,
+		CurrentRound:  data.CurrentRound,
+	}, nil
+}
+
+// Create saves a brand new tournament to the database.
+func (s *DBStore) Create(ctx context.Context, t *entity.Tournament) error {
+	dbt, err := toDBObj(t)
+	if err != nil {
+		return err
+	}
+	result := s.db.Create(dbt)
+	return result.Error
+}
+
+func toDBObj(t *entity.Tournament) (*tournament, error) {
+	data, err := json.Marshal(tournamentData{
+		Players:      t.Players,
+		Rounds:       t.Rounds,
+		CurrentRound: t.CurrentRound,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := proto.Marshal(t.GameReq)
+	if err != nil {
+		return nil, err
+	}
+	return &tournament{
+		UUID:          t.UUID,
+		Name:          t.Name,
+		DirectorID:    t.DirectorID,
+		NumRounds:     t.NumRounds,
+		PairingMethod: int(t.PairingMethod),
+		Request:       req,
+		Data:          postgres.Jsonb{RawMessage: data},
+	}, nil
+}
+
+// Disconnect closes the database connection.
+func (s *DBStore) Disconnect() {
+	s.db.Close()
+}
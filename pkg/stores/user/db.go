@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"math/rand"
+	"sort"
 	"strings"
 
 	"github.com/jinzhu/gorm"
@@ -33,6 +34,8 @@ type User struct {
 	// Password will be hashed.
 	Password    string `gorm:"type:varchar(128)"`
 	InternalBot bool   `gorm:"default:false;index"`
+	// BotTier is the difficulty tier of an internal bot; higher is harder.
+	BotTier int `gorm:"default:0"`
 }
 
 // A user profile is in a one-to-one relationship with a user. It is the
@@ -62,6 +65,18 @@ type profile struct {
 	// More complex stats might be in a separate place.
 }
 
+// A leaderboardEntry is a user's rating in one variant. The ratings are
+// kept in the profile too, but they can't be ranked there. Bots don't have
+// entries.
+type leaderboardEntry struct {
+	UserID     uint   `gorm:"primary_key;auto_increment:false"`
+	VariantKey string `gorm:"type:varchar(64);primary_key;index:idx_leaderboard_variant_rating"`
+
+	Rating            float64 `gorm:"index:idx_leaderboard_variant_rating"`
+	RatingDeviation   float64
+	LastGameTimestamp int64
+}
+
 type following struct {
 	// Follower follows user; pretty straightforward.
 	UserID uint
@@ -77,7 +92,7 @@ func NewDBStore(dbURL string) (*DBStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	db.AutoMigrate(&User{}, &profile{}, &following{})
+	db.AutoMigrate(&User{}, &profile{}, &following{}, &leaderboardEntry{})
 	db.Model(&User{}).
 		AddUniqueIndex("username_idx", "lower(username)").
 		AddUniqueIndex("email_idx", "lower(email)")
@@ -88,6 +103,7 @@ func NewDBStore(dbURL string) (*DBStore, error) {
 		AddForeignKey("user_id", "users(id)", "RESTRICT", "RESTRICT").
 		AddForeignKey("follower_id", "users(id)", "RESTRICT", "RESTRICT").
 		AddUniqueIndex("user_follower_idx", "user_id", "follower_id")
+	db.Model(&leaderboardEntry{}).AddForeignKey("user_id", "users(id)", "RESTRICT", "RESTRICT")
 
 	return &DBStore{db: db}, nil
 }
@@ -113,6 +129,7 @@ func (s *DBStore) Get(ctx context.Context, username string) (*entity.User, error
 		Email:     u.Email,
 		Password:  u.Password,
 		IsBot:     u.InternalBot,
+		BotTier:   u.BotTier,
 		Anonymous: false,
 		Profile:   profile,
 	}
@@ -137,6 +154,7 @@ func (s *DBStore) GetByEmail(ctx context.Context, email string) (*entity.User, e
 		Password:  u.Password,
 		Anonymous: false,
 		IsBot:     u.InternalBot,
+		BotTier:   u.BotTier,
 	}
 
 	return entu, nil
@@ -201,6 +219,7 @@ func (s *DBStore) GetByUUID(ctx context.Context, uuid string) (*entity.User, err
 			Email:    u.Email,
 			Password: u.Password,
 			IsBot:    u.InternalBot,
+			BotTier:  u.BotTier,
 			Profile:  profile,
 		}
 	}
@@ -219,6 +238,7 @@ func (s *DBStore) New(ctx context.Context, u *entity.User) error {
 		Email:       u.Email,
 		Password:    u.Password,
 		InternalBot: u.IsBot,
+		BotTier:     u.BotTier,
 	}
 	result := s.db.Create(dbu)
 	if result.Error != nil {
@@ -258,18 +278,64 @@ func (s *DBStore) SetPassword(ctx context.Context, uuid string, hashpass string)
 // SetRating sets the specific rating for the given variant.
 func (s *DBStore) SetRating(ctx context.Context, uuid string, variant entity.VariantKey,
 	rating entity.SingleRating) error {
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return SetRatingInTx(tx, uuid, variant, rating)
+	})
+}
+
+// lockProfile gets a user and their profile, locking the profile until the
+// end of the transaction tx.
+func lockProfile(tx *gorm.DB, uuid string) (*User, *profile, error) {
 	u := &User{}
 	p := &profile{}
 
-	if result := s.db.Where("uuid = ?", uuid).First(u); result.Error != nil {
-		return result.Error
+	if result := tx.Where("uuid = ?", uuid).First(u); result.Error != nil {
+		return nil, nil, result.Error
 	}
-	if result := s.db.Model(u).Related(p); result.Error != nil {
-		return result.Error
+	result := tx.Set("gorm:query_option", "FOR UPDATE").Where("user_id = ?", u.ID).First(p)
+	if result.Error != nil {
+		return nil, nil, result.Error
+	}
+	return u, p, nil
+}
+
+// LockUserInTx gets a user, locking their profile until the end of the
+// transaction tx, so that their ratings and stats can be worked out from
+// what they are and saved before anything else changes them.
+func LockUserInTx(tx *gorm.DB, uuid string) (*entity.User, error) {
+	u, p, err := lockProfile(tx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := dbProfileToProfile(p)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.User{
+		ID:       u.ID,
+		Username: u.Username,
+		UUID:     u.UUID,
+		Email:    u.Email,
+		IsBot:    u.InternalBot,
+		BotTier:  u.BotTier,
+		Profile:  profile,
+	}, nil
+}
+
+// SetRatingInTx sets the specific rating for the given variant, and the
+// user's leaderboard entry, as part of the transaction tx. It lets the
+// ratings be saved along with other things, like the game that was rated.
+func SetRatingInTx(tx *gorm.DB, uuid string, variant entity.VariantKey,
+	rating entity.SingleRating) error {
+
+	u, p, err := lockProfile(tx, uuid)
+	if err != nil {
+		return err
 	}
 
 	var existingRatings entity.Ratings
-	err := json.Unmarshal(p.Ratings.RawMessage, &existingRatings)
+	err = json.Unmarshal(p.Ratings.RawMessage, &existingRatings)
 	if err != nil {
 		log.Err(err).Msg("existing ratings missing; initializing...")
 		existingRatings = entity.Ratings{Data: map[entity.VariantKey]entity.SingleRating{}}
@@ -285,24 +351,249 @@ func (s *DBStore) SetRating(ctx context.Context, uuid string, variant entity.Var
 		return err
 	}
 
-	return s.db.Model(p).Update("ratings", postgres.Jsonb{RawMessage: bytes}).Error
+	err = tx.Model(p).Update("ratings", postgres.Jsonb{RawMessage: bytes}).Error
+	if err != nil {
+		return err
+	}
+	if u.InternalBot {
+		return nil
+	}
+	return tx.Exec(`INSERT INTO leaderboard_entries
+		(user_id, variant_key, rating, rating_deviation, last_game_timestamp)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (user_id, variant_key) DO UPDATE SET
+		rating = EXCLUDED.rating, rating_deviation = EXCLUDED.rating_deviation,
+		last_game_timestamp = EXCLUDED.last_game_timestamp`,
+		u.ID, string(variant), rating.Rating, rating.RatingDeviation,
+		rating.LastGameTimestamp).Error
+}
+
+// leaderboardQuery selects the entries that are on the leaderboard of the
+// variant: those whose rating is known, and who have played since
+// activeSince (a unix timestamp in seconds).
+func (s *DBStore) leaderboardQuery(variant entity.VariantKey, activeSince int64) *gorm.DB {
+	return s.db.Table("leaderboard_entries").
+		Where("leaderboard_entries.variant_key = ?", string(variant)).
+		Where("leaderboard_entries.rating_deviation <= ?", entity.RatingDeviationConfidence).
+		Where("leaderboard_entries.last_game_timestamp >= ?", activeSince)
+}
+
+type rankedEntry struct {
+	Rank              int
+	Uuid              string
+	Username          string
+	Rating            float64
+	RatingDeviation   float64
+	LastGameTimestamp int64
+}
+
+func (r *rankedEntry) toEntity() *entity.LeaderboardEntry {
+	return &entity.LeaderboardEntry{
+		Rank:     r.Rank,
+		UUID:     r.Uuid,
+		Username: r.Username,
+		Rating: entity.SingleRating{
+			Rating:            r.Rating,
+			RatingDeviation:   r.RatingDeviation,
+			LastGameTimestamp: r.LastGameTimestamp,
+		},
+	}
+}
+
+// GetLeaderboard gets a page of the leaderboard of a variant, best rating
+// first, and how many users are on it in all. Users with the same rating
+// share a rank.
+func (s *DBStore) GetLeaderboard(ctx context.Context, variant entity.VariantKey,
+	activeSince int64, limit, offset int) ([]*entity.LeaderboardEntry, int, error) {
+
+	var total int
+	if result := s.leaderboardQuery(variant, activeSince).Count(&total); result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	ranked := s.leaderboardQuery(variant, activeSince).
+		Select("users.uuid, users.username, leaderboard_entries.rating, " +
+			"leaderboard_entries.rating_deviation, leaderboard_entries.last_game_timestamp, " +
+			"RANK() OVER (ORDER BY leaderboard_entries.rating DESC) AS rank").
+		Joins("JOIN users ON users.id = leaderboard_entries.user_id").
+		SubQuery()
+
+	var entries []rankedEntry
+	result := s.db.Raw("SELECT * FROM ? AS ranked ORDER BY rank, username LIMIT ? OFFSET ?",
+		ranked, limit, offset).Scan(&entries)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	leaderboard := make([]*entity.LeaderboardEntry, len(entries))
+	for idx, e := range entries {
+		leaderboard[idx] = e.toEntity()
+	}
+	return leaderboard, total, nil
+}
+
+// GetLeaderboardEntry gets the place of a user on the leaderboard of a
+// variant, and how many users are on it in all. The entry is nil if the
+// user isn't on it.
+func (s *DBStore) GetLeaderboardEntry(ctx context.Context, uuid string,
+	variant entity.VariantKey, activeSince int64) (*entity.LeaderboardEntry, int, error) {
+
+	var total int
+	if result := s.leaderboardQuery(variant, activeSince).Count(&total); result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var entries []rankedEntry
+	result := s.leaderboardQuery(variant, activeSince).
+		Select("users.uuid, users.username, leaderboard_entries.rating, "+
+			"leaderboard_entries.rating_deviation, leaderboard_entries.last_game_timestamp").
+		Joins("JOIN users ON users.id = leaderboard_entries.user_id").
+		Where("users.uuid = ?", uuid).
+		Scan(&entries)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	if len(entries) == 0 {
+		return nil, total, nil
+	}
+	entry := entries[0]
+
+	var better int
+	result = s.leaderboardQuery(variant, activeSince).
+		Where("leaderboard_entries.rating > ?", entry.Rating).
+		Count(&better)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	entry.Rank = better + 1
+	return entry.toEntity(), total, nil
+}
+
+// RebuildLeaderboards recreates every leaderboard from the ratings in the
+// users' profiles.
+func (s *DBStore) RebuildLeaderboards(ctx context.Context) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM leaderboard_entries").Error; err != nil {
+			return err
+		}
+		return tx.Exec(`INSERT INTO leaderboard_entries
+			(user_id, variant_key, rating, rating_deviation, last_game_timestamp)
+			SELECT profiles.user_id, r.key, (r.value->>'r')::float8,
+				(r.value->>'rd')::float8, (r.value->>'ts')::bigint
+			FROM profiles
+			JOIN users ON users.id = profiles.user_id
+			CROSS JOIN LATERAL jsonb_each(CASE
+				WHEN jsonb_typeof(profiles.ratings->'Data') = 'object'
+				THEN profiles.ratings->'Data' ELSE '{}'::jsonb END) AS r
+			WHERE NOT users.internal_bot AND profiles.deleted_at IS NULL`).Error
+	})
+}
+
+// ListRatedIn lists the UUIDs of the users who have a rating in any of the
+// given variants.
+func (s *DBStore) ListRatedIn(ctx context.Context, variants []entity.VariantKey) ([]string, error) {
+	keys := make([]string, len(variants))
+	for i, v := range variants {
+		keys[i] = string(v)
+	}
+	var uuids []string
+	result := s.db.Table("profiles").
+		Joins("JOIN users ON users.id = profiles.user_id").
+		Where("jsonb_typeof(profiles.ratings->'Data') = 'object'").
+		Where("jsonb_exists_any(profiles.ratings->'Data', ARRAY[?]::text[])", keys).
+		Order("users.uuid").
+		Pluck("users.uuid", &uuids)
+	return uuids, result.Error
+}
+
+// A VariantRebuild is what the ratings and profile stats of a set of
+// variants became when they were rebuilt from the games played in them, by
+// user UUID and variant.
+type VariantRebuild struct {
+	Variants []entity.VariantKey
+	// Ratings is nil if the ratings weren't rebuilt, and Stats is nil if the
+	// stats weren't.
+	Ratings map[string]map[entity.VariantKey]entity.SingleRating
+	Stats   map[string]map[entity.VariantKey]*entity.Stats
+}
+
+// SaveRebuild replaces every user's ratings and/or profile stats in the
+// rebuilt variants with those of the rebuild, in one transaction. Users the
+// rebuild has nothing for lose what they had in those variants. The
+// leaderboards of the variants are rebuilt along with the ratings.
+func (s *DBStore) SaveRebuild(ctx context.Context, r *VariantRebuild) error {
+	keys := make([]string, len(r.Variants))
+	for i, v := range r.Variants {
+		keys[i] = string(v)
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if r.Ratings != nil {
+			err := tx.Exec(`UPDATE profiles SET ratings = jsonb_set(ratings, '{Data}',
+				(ratings->'Data') - ARRAY[?]::text[])
+				WHERE jsonb_typeof(ratings->'Data') = 'object'`, keys).Error
+			if err != nil {
+				return err
+			}
+			err = tx.Exec("DELETE FROM leaderboard_entries WHERE variant_key IN (?)", keys).Error
+			if err != nil {
+				return err
+			}
+		}
+		if r.Stats != nil {
+			err := tx.Exec(`UPDATE profiles SET stats = jsonb_set(stats, '{Data}',
+				(stats->'Data') - ARRAY[?]::text[])
+				WHERE jsonb_typeof(stats->'Data') = 'object'`, keys).Error
+			if err != nil {
+				return err
+			}
+		}
+		// Users are saved in order, so as not to deadlock with games that
+		// end in the meantime.
+		uuids := []string{}
+		for uuid := range r.Ratings {
+			uuids = append(uuids, uuid)
+		}
+		for uuid := range r.Stats {
+			if _, ok := r.Ratings[uuid]; !ok {
+				uuids = append(uuids, uuid)
+			}
+		}
+		sort.Strings(uuids)
+		for _, uuid := range uuids {
+			for variant, rating := range r.Ratings[uuid] {
+				if err := SetRatingInTx(tx, uuid, variant, rating); err != nil {
+					return err
+				}
+			}
+			for variant, st := range r.Stats[uuid] {
+				if err := SetStatsInTx(tx, uuid, variant, st); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
 }
 
 func (s *DBStore) SetStats(ctx context.Context, uuid string, variant entity.VariantKey,
 	stats *entity.Stats) error {
 
-	u := &User{}
-	p := &profile{}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return SetStatsInTx(tx, uuid, variant, stats)
+	})
+}
 
-	if result := s.db.Where("uuid = ?", uuid).First(u); result.Error != nil {
-		return result.Error
-	}
-	if result := s.db.Model(u).Related(p); result.Error != nil {
-		return result.Error
+// SetStatsInTx sets the user's profile stats for the given variant as part
+// of the transaction tx.
+func SetStatsInTx(tx *gorm.DB, uuid string, variant entity.VariantKey,
+	stats *entity.Stats) error {
+
+	_, p, err := lockProfile(tx, uuid)
+	if err != nil {
+		return err
 	}
 
 	var existingProfileStats entity.ProfileStats
-	err := json.Unmarshal(p.Stats.RawMessage, &existingProfileStats)
+	err = json.Unmarshal(p.Stats.RawMessage, &existingProfileStats)
 	if err != nil {
 		log.Err(err).Msg("existing stats missing; initializing...")
 		existingProfileStats = entity.ProfileStats{Data: map[entity.VariantKey]*entity.Stats{}}
@@ -315,17 +606,26 @@ func (s *DBStore) SetStats(ctx context.Context, uuid string, variant entity.Vari
 	if err != nil {
 		return err
 	}
-	return s.db.Model(p).Update("stats", postgres.Jsonb{RawMessage: bytes}).Error
+	return tx.Model(p).Update("stats", postgres.Jsonb{RawMessage: bytes}).Error
 }
 
-func (s *DBStore) GetRandomBot(ctx context.Context) (*entity.User, error) {
+// GetRandomBot gets a random internal bot of the given difficulty tier, or of
+// any tier if it is 0.
+func (s *DBStore) GetRandomBot(ctx context.Context, tier int) (*entity.User, error) {
 
 	var users []*User
 	p := &profile{}
 
-	if result := s.db.Where("internal_bot = ?", true).Find(&users); result.Error != nil {
+	query := s.db.Where("internal_bot = ?", true)
+	if tier != 0 {
+		query = query.Where("bot_tier = ?", tier)
+	}
+	if result := query.Find(&users); result.Error != nil {
 		return nil, result.Error
 	}
+	if len(users) == 0 {
+		return nil, errors.New("no bot is available")
+	}
 	idx := rand.Intn(len(users))
 	u := users[idx]
 
@@ -346,6 +646,7 @@ func (s *DBStore) GetRandomBot(ctx context.Context) (*entity.User, error) {
 		Password:  u.Password,
 		Anonymous: false,
 		IsBot:     u.InternalBot,
+		BotTier:   u.BotTier,
 		Profile:   profile,
 	}
 
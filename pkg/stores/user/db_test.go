@@ -157,3 +157,167 @@ func TestRemoveNonexistentFollower(t *testing.T) {
 
 	ustore.Disconnect()
 }
+
+func TestLeaderboard(t *testing.T) {
+	is := is.New(t)
+	ustore := recreateDB()
+	ctx := context.Background()
+
+	for _, u := range []*entity.User{
+		{Username: "HastyBot", Email: "hastybot@woogles.io", UUID: "hastybotuuid", IsBot: true},
+		{Username: "newbie", Email: "newbie@woogles.io", UUID: "newbieuuid"},
+	} {
+		is.NoErr(ustore.New(ctx, u))
+	}
+	variant := entity.VariantKey("NWL18.classic.blitz")
+	for _, r := range []struct {
+		uuid   string
+		rating float64
+		rd     float64
+		ts     int64
+	}{
+		{"mozEwaVMvTfUA2oxZfYN8k", 1600, 60, 2000}, // cesar
+		{"iW7AaqNJDuaxgcYnrFfcJF", 1700, 60, 3000}, // mina
+		{"3xpEkpRAy3AizbVmDg3kdi", 1600, 80, 1000}, // jesse
+		{"hastybotuuid", 1800, 60, 3000},
+		// newbie's rating isn't known yet.
+		{"newbieuuid", 1900, 200, 3000},
+	} {
+		is.NoErr(ustore.SetRating(ctx, r.uuid, variant, entity.SingleRating{
+			Rating: r.rating, RatingDeviation: r.rd, LastGameTimestamp: r.ts}))
+	}
+	// Ratings in other variants are on their own leaderboards.
+	is.NoErr(ustore.SetRating(ctx, "3xpEkpRAy3AizbVmDg3kdi", "CSW19.classic.blitz",
+		entity.SingleRating{Rating: 2000, RatingDeviation: 60, LastGameTimestamp: 3000}))
+
+	check := func() {
+		entries, total, err := ustore.GetLeaderboard(ctx, variant, 0, 10, 0)
+		is.NoErr(err)
+		is.Equal(total, 3)
+		is.Equal(len(entries), 3)
+		is.Equal(entries[0].Username, "mina")
+		is.Equal(entries[0].Rank, 1)
+		// cesar and jesse are tied.
+		is.Equal(entries[1].Username, "cesar")
+		is.Equal(entries[1].Rank, 2)
+		is.Equal(entries[2].Username, "jesse")
+		is.Equal(entries[2].Rank, 2)
+		is.Equal(entries[2].Rating.RatingDeviation, float64(80))
+
+		entries, total, err = ustore.GetLeaderboard(ctx, variant, 0, 1, 1)
+		is.NoErr(err)
+		is.Equal(total, 3)
+		is.Equal(len(entries), 1)
+		is.Equal(entries[0].Username, "cesar")
+
+		// jesse hasn't played lately.
+		entries, total, err = ustore.GetLeaderboard(ctx, variant, 1500, 10, 0)
+		is.NoErr(err)
+		is.Equal(total, 2)
+
+		entry, total, err := ustore.GetLeaderboardEntry(ctx, "3xpEkpRAy3AizbVmDg3kdi", variant, 0)
+		is.NoErr(err)
+		is.Equal(total, 3)
+		is.Equal(entry.Rank, 2)
+		is.Equal(entry.Username, "jesse")
+
+		entry, _, err = ustore.GetLeaderboardEntry(ctx, "newbieuuid", variant, 0)
+		is.NoErr(err)
+		is.True(entry == nil)
+		entry, _, err = ustore.GetLeaderboardEntry(ctx, "hastybotuuid", variant, 0)
+		is.NoErr(err)
+		is.True(entry == nil)
+	}
+	check()
+	// The leaderboards can be rebuilt from the profiles.
+	is.NoErr(ustore.RebuildLeaderboards(ctx))
+	check()
+
+	ustore.Disconnect()
+}
+
+func TestGetRandomBot(t *testing.T) {
+	is := is.New(t)
+	ustore := recreateDB()
+	ctx := context.Background()
+
+	_, err := ustore.GetRandomBot(ctx, 0)
+	is.True(err != nil)
+
+	for _, u := range []*entity.User{
+		{Username: "BeginnerBot", Email: "beginnerbot@woogles.io", UUID: "beginnerbotuuid",
+			IsBot: true, BotTier: 1},
+		{Username: "HastyBot", Email: "hastybot@woogles.io", UUID: "hastybotuuid",
+			IsBot: true, BotTier: 3},
+	} {
+		is.NoErr(ustore.New(ctx, u))
+	}
+	for i := 0; i < 10; i++ {
+		u, err := ustore.GetRandomBot(ctx, 3)
+		is.NoErr(err)
+		is.Equal(u.Username, "HastyBot")
+		is.True(u.IsBot)
+		is.Equal(u.BotTier, 3)
+
+		u, err = ustore.GetRandomBot(ctx, 0)
+		is.NoErr(err)
+		is.True(u.IsBot)
+	}
+	// Nobody plays at this tier.
+	_, err = ustore.GetRandomBot(ctx, 2)
+	is.True(err != nil)
+
+	ustore.Disconnect()
+}
+
+func TestSaveRebuild(t *testing.T) {
+	is := is.New(t)
+	ustore := recreateDB()
+	ctx := context.Background()
+
+	cesar, mina := "mozEwaVMvTfUA2oxZfYN8k", "iW7AaqNJDuaxgcYnrFfcJF"
+	blitz, csw := entity.VariantKey("NWL18.classic.blitz"), entity.VariantKey("CSW19.classic.blitz")
+	for _, uuid := range []string{cesar, mina} {
+		for _, v := range []entity.VariantKey{blitz, csw} {
+			is.NoErr(ustore.SetRating(ctx, uuid, v, entity.SingleRating{
+				Rating: 1600, RatingDeviation: 60, LastGameTimestamp: 1000}))
+			is.NoErr(ustore.SetStats(ctx, uuid, v, &entity.Stats{PlayerOneId: uuid}))
+		}
+	}
+
+	rated, err := ustore.ListRatedIn(ctx, []entity.VariantKey{blitz})
+	is.NoErr(err)
+	is.Equal(len(rated), 2)
+
+	// Only cesar played in the rebuilt variant; only its ratings are rebuilt.
+	err = ustore.SaveRebuild(ctx, &VariantRebuild{
+		Variants: []entity.VariantKey{blitz},
+		Ratings: map[string]map[entity.VariantKey]entity.SingleRating{
+			cesar: {blitz: {Rating: 1550, RatingDeviation: 70, LastGameTimestamp: 2000}},
+		},
+	})
+	is.NoErr(err)
+
+	u, err := ustore.GetByUUID(ctx, cesar)
+	is.NoErr(err)
+	is.Equal(u.Profile.Ratings.Data[blitz].Rating, float64(1550))
+	is.Equal(u.Profile.Ratings.Data[csw].Rating, float64(1600))
+	u, err = ustore.GetByUUID(ctx, mina)
+	is.NoErr(err)
+	_, ok := u.Profile.Ratings.Data[blitz]
+	is.True(!ok)
+	is.Equal(u.Profile.Ratings.Data[csw].Rating, float64(1600))
+	// The stats weren't rebuilt.
+	is.Equal(u.Profile.Stats.Data[blitz].PlayerOneId, mina)
+
+	entries, total, err := ustore.GetLeaderboard(ctx, blitz, 0, 10, 0)
+	is.NoErr(err)
+	is.Equal(total, 1)
+	is.Equal(entries[0].Username, "cesar")
+
+	rated, err = ustore.ListRatedIn(ctx, []entity.VariantKey{blitz})
+	is.NoErr(err)
+	is.Equal(rated, []string{cesar})
+
+	ustore.Disconnect()
+}
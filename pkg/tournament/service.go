@@ -0,0 +1,228 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/apiserver"
+	"github.com/domino14/liwords/pkg/catalog"
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	"github.com/domino14/liwords/pkg/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	tpb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// TournamentService is a Twirp service for creating and running tournaments.
+type TournamentService struct {
+	userStore       user.Store
+	tournamentStore TournamentStore
+	gameStarter     GameStarter
+	// catalog has the lexica and letter distributions that tournaments can
+	// be played with.
+	catalog *catalog.Catalog
+}
+
+// NewTournamentService creates a Twirp TournamentService. It can't start any
+// games until it has a GameStarter.
+func NewTournamentService(u user.Store, ts TournamentStore, cat *catalog.Catalog) *TournamentService {
+	return &TournamentService{userStore: u, tournamentStore: ts, catalog: cat}
+}
+
+// SetGameStarter sets the GameStarter used to start the games of every round.
+func (ts *TournamentService) SetGameStarter(gs GameStarter) {
+	ts.gameStarter = gs
+}
+
+// NewTournament creates a new tournament, directed by the user making the
+// request.
+func (ts *TournamentService) NewTournament(ctx context.Context, req *tpb.NewTournamentRequest) (*tpb.NewTournamentResponse, error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, errors.New("the tournament needs a name")
+	}
+	if req.NumRounds < 1 {
+		return nil, errors.New("the tournament needs at least one round")
+	}
+	if req.GameRequest == nil {
+		return nil, errors.New("no game request")
+	}
+	if _, ok := tpb.PairingMethod_name[int32(req.PairingMethod)]; !ok {
+		return nil, errUnknownPairingMethod
+	}
+	gameReq := proto.Clone(req.GameRequest).(*pb.GameRequest)
+	if req.Lexicon != "" {
+		gameReq.Lexicon = req.Lexicon
+	}
+	if gameReq.PlayerVsBot {
+		return nil, errors.New("tournaments are for humans only")
+	}
+	err = gameplay.ValidateSoughtGame(ctx, ts.catalog, gameReq)
+	if err != nil {
+		return nil, err
+	}
+
+	t := entity.NewTournament(req.Name, sess.UserUUID, gameReq, int(req.NumRounds),
+		req.PairingMethod)
+	err = ts.tournamentStore.Create(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Str("tournamentID", t.UUID).Str("director", sess.Username).Msg("new-tournament")
+	return &tpb.NewTournamentResponse{Id: t.UUID}, nil
+}
+
+// RegisterPlayer registers the user making the request in the tournament.
+func (ts *TournamentService) RegisterPlayer(ctx context.Context, req *tpb.RegisterPlayerRequest) (*tpb.RegisterPlayerResponse, error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := ts.userStore.GetByUUID(ctx, sess.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Anonymous || u.IsBot {
+		return nil, errors.New("you must log in to play in a tournament")
+	}
+	_, err = ts.tournamentStore.Update(ctx, req.TournamentId, func(t *entity.Tournament) error {
+		if t.Started() {
+			return errors.New("this tournament has already started")
+		}
+		if t.Player(u.UUID) != nil {
+			return errors.New("you are already registered")
+		}
+		t.Players = append(t.Players, &entity.TournamentPlayer{UserID: u.UUID, Username: u.Username})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.RegisterPlayerResponse{}, nil
+}
+
+// StartRound pairs the next round and starts all of its games. If some
+// games of the current round couldn't be started, it starts just those
+// instead.
+func (ts *TournamentService) StartRound(ctx context.Context, req *tpb.StartRoundRequest) (*tpb.StartRoundResponse, error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ts.gameStarter == nil {
+		return nil, errors.New("games can't be started right now")
+	}
+	// The games are started while the tournament is locked, so that no other
+	// node can start the same ones.
+	var startErr error
+	t, err := ts.tournamentStore.Update(ctx, req.TournamentId, func(t *entity.Tournament) error {
+		if t.DirectorID != sess.UserUUID {
+			return errors.New("only the director can start a round")
+		}
+		pairings := t.UnstartedPairings()
+		if len(pairings) > 0 {
+			log.Info().Str("tournamentID", t.UUID).Int("round", t.CurrentRound).
+				Int("games", len(pairings)).Msg("restarting-tournament-games")
+		} else {
+			if t.CurrentRound+1 >= t.NumRounds {
+				return errors.New("all rounds have already been played")
+			}
+			if !t.RoundDone() {
+				return errors.New("the current round isn't over yet")
+			}
+			if len(t.Players) < 2 {
+				return errors.New("there are not enough players to start a round")
+			}
+			var err error
+			pairings, err = pairRound(t, t.CurrentRound+1, req.FixedPairings)
+			if err != nil {
+				return err
+			}
+			t.Rounds = append(t.Rounds, pairings)
+			t.CurrentRound++
+		}
+		for _, p := range pairings {
+			if p.IsBye() {
+				p.Done = true
+				continue
+			}
+			p.GameID, startErr = ts.gameStarter.StartTournamentGame(ctx, t, p.Players[0], p.Players[1])
+			if startErr != nil {
+				log.Err(startErr).Str("tournamentID", t.UUID).Interface("players", p.Players).
+					Msg("starting-tournament-game")
+				break
+			}
+		}
+		// Save whatever did start, even if something went wrong. Starting
+		// the round again starts the rest.
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if startErr != nil {
+		return nil, fmt.Errorf("could not start all the games of the round, start it again to retry: %w", startErr)
+	}
+	round := t.CurrentRound
+	log.Info().Str("tournamentID", t.UUID).Int("round", round).Msg("tournament-round-started")
+	return &tpb.StartRoundResponse{Round: int32(round), Pairings: pairingsToPB(t, t.Rounds[round])}, nil
+}
+
+// GetPairings gets the pairings (and results) of a round.
+func (ts *TournamentService) GetPairings(ctx context.Context, req *tpb.PairingsRequest) (*tpb.PairingsResponse, error) {
+	t, err := ts.tournamentStore.Get(ctx, req.TournamentId)
+	if err != nil {
+		return nil, err
+	}
+	t.RLock()
+	defer t.RUnlock()
+	if req.Round < 0 || int(req.Round) >= len(t.Rounds) {
+		return nil, errors.New("that round has not been paired")
+	}
+	return &tpb.PairingsResponse{Pairings: pairingsToPB(t, t.Rounds[req.Round])}, nil
+}
+
+// GetStandings gets the current standings of the tournament.
+func (ts *TournamentService) GetStandings(ctx context.Context, req *tpb.StandingsRequest) (*tpb.StandingsResponse, error) {
+	t, err := ts.tournamentStore.Get(ctx, req.TournamentId)
+	if err != nil {
+		return nil, err
+	}
+	t.RLock()
+	defer t.RUnlock()
+	return &tpb.StandingsResponse{
+		Name:         t.Name,
+		NumRounds:    int32(t.NumRounds),
+		CurrentRound: int32(t.CurrentRound),
+		Standings:    Standings(t),
+	}, nil
+}
+
+func pairingsToPB(t *entity.Tournament, pairings []*entity.TournamentPairing) []*tpb.Pairing {
+	pbps := make([]*tpb.Pairing, len(pairings))
+	for i, p := range pairings {
+		pbp := &tpb.Pairing{
+			GameId:    p.GameID,
+			Winner:    int32(p.Winner),
+			Done:      p.Done,
+			EndReason: p.EndReason,
+		}
+		for j, id := range p.Players {
+			if id == "" {
+				continue
+			}
+			pbp.UserIds = append(pbp.UserIds, id)
+			pbp.Usernames = append(pbp.Usernames, t.Player(id).Username)
+			pbp.Scores = append(pbp.Scores, int32(p.Scores[j]))
+		}
+		pbps[i] = pbp
+	}
+	return pbps
+}
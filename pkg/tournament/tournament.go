@@ -0,0 +1,289 @@
+// Package tournament runs tournaments: it registers players, pairs every
+// round, and keeps track of the results and standings.
+package tournament
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/autopair"
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	tpb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// ByeSpread is the spread awarded for a bye.
+const ByeSpread = 50
+
+var (
+	errUnknownPairingMethod = errors.New("unknown pairing method")
+	errFixedPairings        = errors.New("fixed pairings are only supported by Swiss tournaments")
+	errGameNotInTournament  = errors.New("game is not part of this tournament")
+)
+
+// TournamentStore is an interface for getting a tournament. Update is the
+// only way to change one: it calls the given function on an up-to-date copy
+// that nobody else can change until it's saved, and returns that copy.
+type TournamentStore interface {
+	Get(ctx context.Context, id string) (*entity.Tournament, error)
+	Update(ctx context.Context, id string, f func(t *entity.Tournament) error) (*entity.Tournament, error)
+	Create(context.Context, *entity.Tournament) error
+}
+
+// A GameStarter creates and starts a game of the given tournament between
+// two of its players. The first player goes first. It returns the ID of the
+// new game.
+type GameStarter interface {
+	StartTournamentGame(ctx context.Context, t *entity.Tournament, first, second string) (string, error)
+}
+
+// RecordResult records the result of a finished tournament game.
+func RecordResult(ctx context.Context, ts TournamentStore, evt *pb.GameEndedEvent) error {
+	_, err := ts.Update(ctx, evt.TournamentId, func(t *entity.Tournament) error {
+		p := t.PairingForGame(evt.GameId)
+		if p == nil {
+			return errGameNotInTournament
+		}
+		if p.Done {
+			return nil
+		}
+		p.EndReason = evt.EndReason
+		p.Done = true
+		if evt.EndReason == pb.GameEndReason_ABORTED {
+			// Nobody played it, so it has no result. It doesn't hold up the
+			// round, and doesn't count in the standings.
+			p.Winner = -1
+			log.Info().Str("tournamentID", t.UUID).Str("gameID", evt.GameId).Msg("tournament-game-aborted")
+			return nil
+		}
+		for i := 0; i < 2; i++ {
+			username := t.Player(p.Players[i]).Username
+			p.Scores[i] = int(evt.Scores[username])
+			if !evt.Tie && evt.Winner == username {
+				p.Winner = i
+			}
+		}
+		if evt.Tie {
+			p.Winner = -1
+		}
+		log.Info().Str("tournamentID", t.UUID).Str("gameID", evt.GameId).
+			Interface("scores", p.Scores).Int("winner", p.Winner).Msg("tournament-result")
+		return nil
+	})
+	return err
+}
+
+// Standings returns the standings of the tournament, best first. Players are
+// ranked by wins (a draw counts as half a win), then spread, then Buchholz
+// score, then registration order. Aborted games don't count.
+func Standings(t *entity.Tournament) []*tpb.Standing {
+	standings := make([]*tpb.Standing, len(t.Players))
+	byID := make(map[string]*tpb.Standing)
+	for i, p := range t.Players {
+		standings[i] = &tpb.Standing{UserId: p.UserID, Username: p.Username}
+		byID[p.UserID] = standings[i]
+	}
+	opponents := make(map[string][]string)
+
+	for _, round := range t.Rounds {
+		for _, p := range round {
+			if !p.Done || p.EndReason == pb.GameEndReason_ABORTED {
+				continue
+			}
+			if p.IsBye() {
+				byID[p.Players[0]].Wins++
+				byID[p.Players[0]].Spread += ByeSpread
+				continue
+			}
+			for i := 0; i < 2; i++ {
+				s := byID[p.Players[i]]
+				s.Spread += int32(p.Scores[i] - p.Scores[1-i])
+				switch p.Winner {
+				case -1:
+					s.Draws++
+				case i:
+					s.Wins++
+				default:
+					s.Losses++
+				}
+				opponents[p.Players[i]] = append(opponents[p.Players[i]], p.Players[1-i])
+			}
+		}
+	}
+	for _, s := range standings {
+		for _, opp := range opponents[s.UserId] {
+			s.Buchholz += points(byID[opp])
+		}
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if points(a) != points(b) {
+			return points(a) > points(b)
+		}
+		if a.Spread != b.Spread {
+			return a.Spread > b.Spread
+		}
+		return a.Buchholz > b.Buchholz
+	})
+	return standings
+}
+
+func points(s *tpb.Standing) float64 {
+	return float64(s.Wins) + float64(s.Draws)/2
+}
+
+// pairRound pairs up all the players for the given (zero-indexed) round,
+// with the given pairings made by hand.
+func pairRound(t *entity.Tournament, round int,
+	fixed []*tpb.FixedPairing) ([]*entity.TournamentPairing, error) {
+
+	if len(fixed) > 0 && t.PairingMethod != tpb.PairingMethod_SWISS {
+		return nil, errFixedPairings
+	}
+	var order []string
+	switch t.PairingMethod {
+	case tpb.PairingMethod_RANDOM:
+		order = make([]string, len(t.Players))
+		for i, p := range t.Players {
+			order[i] = p.UserID
+		}
+		rand.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+		return pairInOrder(order), nil
+
+	case tpb.PairingMethod_KING_OF_THE_HILL:
+		standings := Standings(t)
+		order = make([]string, len(standings))
+		for i, s := range standings {
+			order[i] = s.UserId
+		}
+		if len(order)%2 == 1 {
+			order = moveByeToEnd(t, order)
+		}
+		return pairInOrder(order), nil
+
+	case tpb.PairingMethod_ROUND_ROBIN:
+		return pairRoundRobin(t, round), nil
+
+	case tpb.PairingMethod_SWISS:
+		return pairSwiss(t, fixed)
+	}
+	return nil, errUnknownPairingMethod
+}
+
+// pairInOrder pairs the first player with the second, the third with the
+// fourth, and so on. If there is an odd number of players, the last one
+// gets a bye.
+func pairInOrder(order []string) []*entity.TournamentPairing {
+	pairings := []*entity.TournamentPairing{}
+	for i := 0; i < len(order); i += 2 {
+		p := &entity.TournamentPairing{Players: [2]string{order[i], ""}}
+		if i+1 < len(order) {
+			p.Players[1] = order[i+1]
+		}
+		pairings = append(pairings, p)
+	}
+	return pairings
+}
+
+// moveByeToEnd moves the lowest-ranked player who hasn't had a bye yet to
+// the end of the order, so that they get the bye.
+func moveByeToEnd(t *entity.Tournament, order []string) []string {
+	hadBye := make(map[string]bool)
+	for _, round := range t.Rounds {
+		for _, p := range round {
+			if p.IsBye() {
+				hadBye[p.Players[0]] = true
+			}
+		}
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		if !hadBye[order[i]] {
+			bye := order[i]
+			reordered := append([]string{}, order[:i]...)
+			reordered = append(reordered, order[i+1:]...)
+			return append(reordered, bye)
+		}
+	}
+	// Everyone has had a bye already; give it to the lowest-ranked player.
+	return order
+}
+
+// pairRoundRobin pairs a round with the circle method: the first player stays
+// put, and everyone else rotates by one seat every round. Once everyone has
+// played everyone, it starts over.
+func pairRoundRobin(t *entity.Tournament, round int) []*entity.TournamentPairing {
+	seats := make([]string, len(t.Players))
+	for i, p := range t.Players {
+		seats[i] = p.UserID
+	}
+	if len(seats)%2 == 1 {
+		// Whoever is seated here has a bye.
+		seats = append(seats, "")
+	}
+	n := len(seats)
+	rotation := round % (n - 1)
+	rotated := make([]string, n)
+	rotated[0] = seats[0]
+	for i := 1; i < n; i++ {
+		rotated[1+(i-1+rotation)%(n-1)] = seats[i]
+	}
+
+	pairings := []*entity.TournamentPairing{}
+	for i := 0; i < n/2; i++ {
+		p0, p1 := rotated[i], rotated[n-1-i]
+		if round%2 == 1 {
+			// Alternate who goes first.
+			p0, p1 = p1, p0
+		}
+		if p0 == "" {
+			p0, p1 = p1, p0
+		}
+		pairings = append(pairings, &entity.TournamentPairing{Players: [2]string{p0, p1}})
+	}
+	return pairings
+}
+
+// pairSwiss pairs the next round with the Swiss pairer.
+func pairSwiss(t *entity.Tournament, fixed []*tpb.FixedPairing) ([]*entity.TournamentPairing, error) {
+	standings := Standings(t)
+	players := make([]*autopair.SwissPlayer, len(standings))
+	byID := make(map[string]*autopair.SwissPlayer)
+	for i, s := range standings {
+		players[i] = &autopair.SwissPlayer{ID: s.UserId, Wins: points(s)}
+		byID[s.UserId] = players[i]
+	}
+	for _, round := range t.Rounds {
+		for _, p := range round {
+			if p.IsBye() {
+				byID[p.Players[0]].HadBye = true
+				continue
+			}
+			if p.EndReason == pb.GameEndReason_ABORTED {
+				// They never played, so they can be paired again.
+				continue
+			}
+			first, second := byID[p.Players[0]], byID[p.Players[1]]
+			first.Firsts++
+			second.Seconds++
+			first.Opponents = append(first.Opponents, second.ID)
+			second.Opponents = append(second.Opponents, first.ID)
+		}
+	}
+	swissFixed := make([]autopair.SwissPairing, len(fixed))
+	for i, f := range fixed {
+		swissFixed[i] = autopair.SwissPairing{First: f.First, Second: f.Second}
+	}
+	swissPairings, err := autopair.SwissPair(players, swissFixed)
+	if err != nil {
+		return nil, err
+	}
+	pairings := make([]*entity.TournamentPairing, len(swissPairings))
+	for i, sp := range swissPairings {
+		pairings[i] = &entity.TournamentPairing{Players: [2]string{sp.First, sp.Second}}
+	}
+	return pairings, nil
+}
@@ -0,0 +1,221 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+	tpb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+func newTestTournament(method tpb.PairingMethod, players ...string) *entity.Tournament {
+	t := entity.NewTournament("Test Open", "director", &pb.GameRequest{}, 5, method)
+	for _, p := range players {
+		t.Players = append(t.Players, &entity.TournamentPlayer{UserID: p, Username: p + "-nick"})
+	}
+	return t
+}
+
+func result(p0, p1 string, s0, s1 int) *entity.TournamentPairing {
+	p := &entity.TournamentPairing{Players: [2]string{p0, p1}, Scores: [2]int{s0, s1}, Done: true}
+	switch {
+	case s0 > s1:
+		p.Winner = 0
+	case s1 > s0:
+		p.Winner = 1
+	default:
+		p.Winner = -1
+	}
+	return p
+}
+
+func TestStandings(t *testing.T) {
+	is := is.New(t)
+	tm := newTestTournament(tpb.PairingMethod_KING_OF_THE_HILL, "a", "b", "c", "d", "e")
+	tm.Rounds = [][]*entity.TournamentPairing{
+		{result("a", "b", 400, 350), result("c", "d", 380, 380),
+			{Players: [2]string{"e", ""}, Done: true}},
+		{result("a", "c", 300, 420), result("b", "e", 410, 400),
+			{Players: [2]string{"d", ""}, Done: true}},
+	}
+	tm.CurrentRound = 1
+
+	standings := Standings(tm)
+	order := []string{}
+	for _, s := range standings {
+		order = append(order, s.UserId)
+	}
+	// c: 1.5 wins, +120. d: 1.5 wins, +50. e: 1 win, +40. a: 1 win, -70.
+	// b: 1 win, -40.
+	is.Equal(order, []string{"c", "d", "e", "b", "a"})
+	is.Equal(standings[0].Wins, int32(1))
+	is.Equal(standings[0].Draws, int32(1))
+	is.Equal(standings[0].Spread, int32(120))
+	is.Equal(standings[1].Spread, int32(ByeSpread))
+	// c played d (1.5) and a (1).
+	is.Equal(standings[0].Buchholz, 2.5)
+}
+
+func TestStandingsIgnoreUnfinishedGames(t *testing.T) {
+	is := is.New(t)
+	tm := newTestTournament(tpb.PairingMethod_KING_OF_THE_HILL, "a", "b")
+	tm.Rounds = [][]*entity.TournamentPairing{
+		{{Players: [2]string{"a", "b"}, GameID: "abc"}},
+	}
+	tm.CurrentRound = 0
+	for _, s := range Standings(tm) {
+		is.Equal(s.Wins+s.Losses+s.Draws, int32(0))
+	}
+}
+
+// memStore is a TournamentStore that holds one tournament.
+type memStore struct {
+	t *entity.Tournament
+}
+
+func (m *memStore) Get(ctx context.Context, id string) (*entity.Tournament, error) {
+	return m.t, nil
+}
+
+func (m *memStore) Update(ctx context.Context, id string,
+	f func(t *entity.Tournament) error) (*entity.Tournament, error) {
+
+	if err := f(m.t); err != nil {
+		return nil, err
+	}
+	return m.t, nil
+}
+
+func (m *memStore) Create(ctx context.Context, t *entity.Tournament) error {
+	m.t = t
+	return nil
+}
+
+func TestAbortedGameIsNotPlayed(t *testing.T) {
+	is := is.New(t)
+	tm := newTestTournament(tpb.PairingMethod_SWISS, "a", "b", "c", "d")
+	tm.Rounds = [][]*entity.TournamentPairing{
+		{{Players: [2]string{"a", "b"}, GameID: "ab"}, result("c", "d", 400, 300)},
+	}
+	tm.CurrentRound = 0
+	ts := &memStore{t: tm}
+
+	err := RecordResult(context.Background(), ts, &pb.GameEndedEvent{
+		GameId:    "ab",
+		EndReason: pb.GameEndReason_ABORTED,
+		Tie:       true,
+		Scores:    map[string]int32{"a-nick": 0, "b-nick": 0},
+	})
+	is.NoErr(err)
+	// It doesn't hold up the round, but nobody drew it.
+	is.True(tm.RoundDone())
+	for _, s := range Standings(tm) {
+		if s.UserId == "a" || s.UserId == "b" {
+			is.Equal(s.Wins+s.Losses+s.Draws, int32(0))
+		}
+	}
+
+	// Nobody played anyone, so the next round is paired as if it were the
+	// first one.
+	tm.Rounds[0][1] = &entity.TournamentPairing{Players: [2]string{"c", "d"},
+		Done: true, Winner: -1, EndReason: pb.GameEndReason_ABORTED}
+	pairings, err := pairRound(tm, 1, nil)
+	is.NoErr(err)
+	fresh, err := pairRound(newTestTournament(tpb.PairingMethod_SWISS, "a", "b", "c", "d"), 0, nil)
+	is.NoErr(err)
+	is.Equal(pairings[0].Players, fresh[0].Players)
+	is.Equal(pairings[1].Players, fresh[1].Players)
+}
+
+func TestUnstartedPairings(t *testing.T) {
+	is := is.New(t)
+	tm := newTestTournament(tpb.PairingMethod_SWISS, "a", "b", "c", "d", "e")
+	is.Equal(len(tm.UnstartedPairings()), 0)
+
+	tm.Rounds = [][]*entity.TournamentPairing{
+		{{Players: [2]string{"a", "b"}, GameID: "ab"}, {Players: [2]string{"c", "d"}},
+			{Players: [2]string{"e", ""}, Done: true}},
+	}
+	tm.CurrentRound = 0
+	// Only c and d's game didn't start; the bye doesn't need a game.
+	unstarted := tm.UnstartedPairings()
+	is.Equal(len(unstarted), 1)
+	is.Equal(unstarted[0].Players, [2]string{"c", "d"})
+}
+
+func TestKingOfTheHillByeGoesToLowestWithoutBye(t *testing.T) {
+	is := is.New(t)
+	tm := newTestTournament(tpb.PairingMethod_KING_OF_THE_HILL, "a", "b", "c")
+	tm.Rounds = [][]*entity.TournamentPairing{
+		{result("a", "b", 400, 300), {Players: [2]string{"c", ""}, Done: true}},
+	}
+	tm.CurrentRound = 0
+
+	pairings, err := pairRound(tm, 1, nil)
+	is.NoErr(err)
+	is.Equal(len(pairings), 2)
+	// c is second on the standings, b is last, and hasn't had a bye.
+	is.Equal(pairings[0].Players, [2]string{"a", "c"})
+	is.Equal(pairings[1].Players, [2]string{"b", ""})
+}
+
+func TestRoundRobinEveryonePlaysEveryone(t *testing.T) {
+	is := is.New(t)
+	tm := newTestTournament(tpb.PairingMethod_ROUND_ROBIN, "a", "b", "c", "d", "e")
+
+	met := map[[2]string]int{}
+	byes := map[string]int{}
+	for round := 0; round < 5; round++ {
+		pairings, err := pairRound(tm, round, nil)
+		is.NoErr(err)
+		is.Equal(len(pairings), 3)
+		seen := map[string]bool{}
+		for _, p := range pairings {
+			for _, pl := range p.Players {
+				if pl != "" {
+					is.True(!seen[pl])
+					seen[pl] = true
+				}
+			}
+			if p.IsBye() {
+				byes[p.Players[0]]++
+				continue
+			}
+			pair := p.Players
+			if pair[0] > pair[1] {
+				pair[0], pair[1] = pair[1], pair[0]
+			}
+			met[pair]++
+		}
+		is.Equal(len(seen), 5)
+	}
+	// 5 players, so 10 distinct games, and everyone sits out once.
+	is.Equal(len(met), 10)
+	for _, n := range met {
+		is.Equal(n, 1)
+	}
+	for _, p := range []string{"a", "b", "c", "d", "e"} {
+		is.Equal(byes[p], 1)
+	}
+}
+
+func TestSwissRoundAvoidsRematches(t *testing.T) {
+	is := is.New(t)
+	tm := newTestTournament(tpb.PairingMethod_SWISS, "a", "b", "c", "d")
+	tm.Rounds = [][]*entity.TournamentPairing{
+		{result("a", "b", 400, 300), result("c", "d", 400, 300)},
+	}
+	tm.CurrentRound = 0
+
+	pairings, err := pairRound(tm, 1, nil)
+	is.NoErr(err)
+	is.Equal(pairings[0].Players, [2]string{"a", "c"})
+	is.Equal(pairings[1].Players, [2]string{"b", "d"})
+
+	_, err = pairRound(newTestTournament(tpb.PairingMethod_RANDOM, "a", "b"), 0,
+		[]*tpb.FixedPairing{{First: "a", Second: "b"}})
+	is.Equal(err, errFixedPairings)
+}
@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/user_service"
+)
+
+const (
+	// Users drop off a leaderboard if they haven't played a rated game in
+	// its variant for this long.
+	leaderboardInactivityCutoff = 60 * 24 * time.Hour
+
+	defaultLeaderboardLimit = 50
+	maxLeaderboardLimit     = 100
+)
+
+// LeaderboardStore keeps the users' ratings ranked by variant. It's kept up
+// to date by SetRating.
+type LeaderboardStore interface {
+	// GetLeaderboard gets a page of a leaderboard, and how many users are on
+	// it. Only users who have played since activeSince, a unix timestamp in
+	// seconds, are on it.
+	GetLeaderboard(ctx context.Context, variant entity.VariantKey, activeSince int64,
+		limit, offset int) ([]*entity.LeaderboardEntry, int, error)
+	// GetLeaderboardEntry finds a user on a leaderboard. The entry is nil if
+	// they're not on it.
+	GetLeaderboardEntry(ctx context.Context, uuid string, variant entity.VariantKey,
+		activeSince int64) (*entity.LeaderboardEntry, int, error)
+}
+
+// LeaderboardService is a Twirp service for the per-variant leaderboards.
+type LeaderboardService struct {
+	userStore        Store
+	leaderboardStore LeaderboardStore
+}
+
+// NewLeaderboardService creates a Twirp LeaderboardService
+func NewLeaderboardService(u Store, ls LeaderboardStore) *LeaderboardService {
+	return &LeaderboardService{userStore: u, leaderboardStore: ls}
+}
+
+func activeSince(now time.Time) int64 {
+	return now.Add(-leaderboardInactivityCutoff).Unix()
+}
+
+func leaderboardEntry(e *entity.LeaderboardEntry) *pb.LeaderboardEntry {
+	return &pb.LeaderboardEntry{
+		Rank:              int32(e.Rank),
+		Username:          e.Username,
+		Rating:            int32(math.Round(e.Rating.Rating)),
+		RatingDeviation:   int32(math.Round(e.Rating.RatingDeviation)),
+		LastGameTimestamp: e.Rating.LastGameTimestamp,
+	}
+}
+
+// GetLeaderboard gets a page of the leaderboard of a variant.
+func (ls *LeaderboardService) GetLeaderboard(ctx context.Context, r *pb.LeaderboardRequest) (*pb.LeaderboardResponse, error) {
+	if r.VariantKey == "" {
+		return nil, errors.New("please pick a variant")
+	}
+	if r.Limit < 0 || r.Offset < 0 {
+		return nil, errors.New("the limit and offset can't be negative")
+	}
+	limit := int(r.Limit)
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	} else if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+	entries, total, err := ls.leaderboardStore.GetLeaderboard(ctx,
+		entity.VariantKey(r.VariantKey), activeSince(time.Now()), limit, int(r.Offset))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.LeaderboardResponse{Total: int32(total)}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, leaderboardEntry(e))
+	}
+	return resp, nil
+}
+
+// GetLeaderboardPosition finds a user on the leaderboard of a variant.
+func (ls *LeaderboardService) GetLeaderboardPosition(ctx context.Context, r *pb.LeaderboardPositionRequest) (*pb.LeaderboardPositionResponse, error) {
+	if r.VariantKey == "" {
+		return nil, errors.New("please pick a variant")
+	}
+	u, err := ls.userStore.Get(ctx, r.Username)
+	if err != nil {
+		return nil, err
+	}
+	entry, total, err := ls.leaderboardStore.GetLeaderboardEntry(ctx, u.UUID,
+		entity.VariantKey(r.VariantKey), activeSince(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.LeaderboardPositionResponse{Total: int32(total)}
+	if entry != nil {
+		resp.Entry = leaderboardEntry(entry)
+	}
+	return resp, nil
+}
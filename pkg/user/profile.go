@@ -3,16 +3,24 @@ package user
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
+	"github.com/domino14/liwords/pkg/entity"
 	pb "github.com/domino14/liwords/rpc/api/proto/user_service"
 )
 
+// RatingHistoryStore looks up the ratings players had after their games.
+type RatingHistoryStore interface {
+	GetRatingHistory(playerID string, variant entity.VariantKey, after, before int64) ([]*entity.ListItem, error)
+}
+
 type ProfileService struct {
-	userStore Store
+	userStore          Store
+	ratingHistoryStore RatingHistoryStore
 }
 
-func NewProfileService(u Store) *ProfileService {
-	return &ProfileService{userStore: u}
+func NewProfileService(u Store, rhs RatingHistoryStore) *ProfileService {
+	return &ProfileService{userStore: u, ratingHistoryStore: rhs}
 }
 
 func (ps *ProfileService) GetRatings(ctx context.Context, r *pb.RatingsRequest) (*pb.RatingsResponse, error) {
@@ -75,3 +83,53 @@ func (ps *ProfileService) GetProfile(ctx context.Context, r *pb.ProfileRequest)
 		StatsJson:   string(statjson),
 	}, nil
 }
+
+// GetRatingHistory returns a user's ratings over time in one variant.
+func (ps *ProfileService) GetRatingHistory(ctx context.Context, r *pb.RatingHistoryRequest) (*pb.RatingHistoryResponse, error) {
+	if r.VariantKey == "" {
+		return nil, errors.New("please pick a variant")
+	}
+	if r.MaxPoints < 0 {
+		return nil, errors.New("max points can't be negative")
+	}
+	user, err := ps.userStore.Get(ctx, r.Username)
+	if err != nil {
+		return nil, err
+	}
+	// The ratings are timestamped in milliseconds.
+	items, err := ps.ratingHistoryStore.GetRatingHistory(user.UUID,
+		entity.VariantKey(r.VariantKey), r.After*1000, r.Before*1000)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]*pb.RatingPoint, len(items))
+	for idx, item := range items {
+		points[idx] = &pb.RatingPoint{
+			GameId:          item.GameId,
+			Time:            item.Time,
+			Rating:          int32(item.Item.Rating),
+			RatingDeviation: int32(item.Item.RatingDeviation),
+		}
+	}
+	return &pb.RatingHistoryResponse{
+		Points: downsample(points, int(r.MaxPoints)),
+		Total:  int32(len(points)),
+	}, nil
+}
+
+// downsample returns at most max evenly spaced points, including the first
+// and the last; if max is one, just the last. If max is zero, all of them
+// are returned.
+func downsample(points []*pb.RatingPoint, max int) []*pb.RatingPoint {
+	if max == 0 || len(points) <= max {
+		return points
+	}
+	if max == 1 {
+		return points[len(points)-1:]
+	}
+	sampled := make([]*pb.RatingPoint, max)
+	for i := range sampled {
+		sampled[i] = points[i*(len(points)-1)/(max-1)]
+	}
+	return sampled
+}
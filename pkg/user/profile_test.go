@@ -0,0 +1,36 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	pb "github.com/domino14/liwords/rpc/api/proto/user_service"
+)
+
+func ratingPoints(n int) []*pb.RatingPoint {
+	points := make([]*pb.RatingPoint, n)
+	for i := range points {
+		points[i] = &pb.RatingPoint{Rating: int32(1500 + i)}
+	}
+	return points
+}
+
+func ratings(points []*pb.RatingPoint) []int32 {
+	r := make([]int32, len(points))
+	for i, p := range points {
+		r[i] = p.Rating
+	}
+	return r
+}
+
+func TestDownsample(t *testing.T) {
+	is := is.New(t)
+	points := ratingPoints(10)
+
+	is.Equal(len(downsample(points, 0)), 10)
+	is.Equal(len(downsample(points, 20)), 10)
+	is.Equal(ratings(downsample(points, 4)), []int32{1500, 1503, 1506, 1509})
+	is.Equal(ratings(downsample(points, 3)), []int32{1500, 1504, 1509})
+	is.Equal(ratings(downsample(points, 1)), []int32{1509})
+}
@@ -17,7 +17,9 @@ type Store interface {
 	SetPassword(ctx context.Context, uuid string, hashpass string) error
 	SetRating(ctx context.Context, uuid string, variant entity.VariantKey, rating entity.SingleRating) error
 	SetStats(ctx context.Context, uuid string, variant entity.VariantKey, stats *entity.Stats) error
-	GetRandomBot(ctx context.Context) (*entity.User, error)
+	// GetRandomBot gets a random bot of the given difficulty tier, or of any
+	// tier if it is 0.
+	GetRandomBot(ctx context.Context, tier int) (*entity.User, error)
 	AddFollower(ctx context.Context, targetUser, follower uint) error
 	RemoveFollower(ctx context.Context, targetUser, follower uint) error
 	// GetFollows gets all the users that the passed-in DB ID is following.
@@ -0,0 +1,60 @@
+package variants
+
+import (
+	"github.com/domino14/macondo/alphabet"
+	"github.com/domino14/macondo/gaddag"
+)
+
+// AnagramLexicon is the lexicon for dogworms: a word is valid if its
+// letters can be rearranged into a word of the underlying gaddag.
+type AnagramLexicon struct {
+	gaddag.GenericDawg
+}
+
+// Name implements lexicon.Lexicon.
+func (l AnagramLexicon) Name() string {
+	return l.LexiconName()
+}
+
+// HasWord implements lexicon.Lexicon.
+func (l AnagramLexicon) HasWord(word alphabet.MachineWord) bool {
+	if len(word) == 0 {
+		return false
+	}
+	counts := map[alphabet.MachineLetter]int{}
+	for _, ml := range word {
+		counts[ml.Unblank()]++
+	}
+	return l.hasAnagram(l.GetRootNodeIndex(), counts, len(word))
+}
+
+// hasAnagram returns whether some arrangement of the letters left in counts
+// completes a word from the node. In a gaddag, the path from the root that
+// doesn't go through the separator spells a word backwards; since any order
+// will do here, that's the only one we need to follow.
+func (l AnagramLexicon) hasAnagram(nodeIdx uint32, counts map[alphabet.MachineLetter]int,
+	left int) bool {
+
+	if left == 1 {
+		for ml, n := range counts {
+			if n > 0 {
+				return l.InLetterSet(ml, nodeIdx)
+			}
+		}
+		return false
+	}
+	numArcs := l.NumArcs(nodeIdx)
+	for i := byte(1); i <= numArcs; i++ {
+		nextNodeIdx, ml := l.ArcToIdxLetter(nodeIdx + uint32(i))
+		if counts[ml] == 0 {
+			continue
+		}
+		counts[ml]--
+		found := l.hasAnagram(nextNodeIdx, counts, left-1)
+		counts[ml]++
+		if found {
+			return true
+		}
+	}
+	return false
+}
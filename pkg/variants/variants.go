@@ -0,0 +1,142 @@
+// Package variants sets up the Macondo game rules for each of the game
+// variants we support: the board, the letter distribution and the lexicon.
+package variants
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/domino14/macondo/alphabet"
+	"github.com/domino14/macondo/board"
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/cross_set"
+	"github.com/domino14/macondo/gaddag"
+	"github.com/domino14/macondo/game"
+	"github.com/domino14/macondo/lexicon"
+
+	"github.com/domino14/liwords/pkg/catalog"
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+// SuperCrosswordGameBoard is the 21x21 board Super OMGWords is played on.
+// Macondo has no quadruple bonus squares, so it sticks to doubles and
+// triples.
+var SuperCrosswordGameBoard = []string{
+	`=  '   '  =  '   '  =`,
+	` -   "   ' '   "   - `,
+	`  -   ' '   ' '   -  `,
+	`'  -      '      -  '`,
+	`    -    " "    -    `,
+	` "   -         -   " `,
+	`  '   -       -   '  `,
+	`'      "     "      '`,
+	`  '     '   '     '  `,
+	` '  "    ' '    "  ' `,
+	`=  '      -      '  =`,
+	` '  "    ' '    "  ' `,
+	`  '     '   '     '  `,
+	`'      "     "      '`,
+	`  '   -       -   '  `,
+	` "   -         -   " `,
+	`    -    " "    -    `,
+	`'  -      '      -  '`,
+	`  -   ' '   ' '   -  `,
+	` -   "   ' '   "   - `,
+	`=  '   '  =  '   '  =`,
+}
+
+// distributions lists the letter distributions each variant can be played
+// with. Variants that change the tiles have their own distribution files,
+// named after the base distribution; see LetterDistributionName.
+var distributions = map[entity.Variant][]string{
+	entity.VarClassic:   {"english", "spanish", "polish"},
+	entity.VarAWorth100: {"english"},
+	entity.VarDogworms:  {"english", "spanish", "polish"},
+	entity.VarSuper:     {"english"},
+}
+
+// BoardLayout returns the layout of the named board.
+func BoardLayout(name string) ([]string, error) {
+	switch name {
+	case entity.CrosswordGame:
+		return board.CrosswordGameBoard, nil
+	case entity.SuperCrosswordGame:
+		return SuperCrosswordGameBoard, nil
+	}
+	return nil, errors.New("unsupported board layout")
+}
+
+// LetterDistributionName returns the name of the letter distribution file
+// the game is played with.
+func LetterDistributionName(rules *pb.GameRules) (string, error) {
+	variant, err := entity.VariantFromRules(rules)
+	if err != nil {
+		return "", err
+	}
+	name := strings.ToLower(rules.LetterDistributionName)
+	switch variant {
+	case entity.VarAWorth100:
+		name += "_a100"
+	case entity.VarSuper:
+		name += "_super"
+	}
+	return name, nil
+}
+
+// Validate returns an error if the game can't be played with this
+// combination of variant, lexicon and letter distribution.
+func Validate(lexiconName string, rules *pb.GameRules) error {
+	variant, err := entity.VariantFromRules(rules)
+	if err != nil {
+		return err
+	}
+	dist := strings.ToLower(rules.LetterDistributionName)
+	if dist != catalog.Language(lexiconName) {
+		return errors.New("the letter distribution does not match the lexicon")
+	}
+	for _, d := range distributions[variant] {
+		if d == dist {
+			return nil
+		}
+	}
+	return errors.New("this variant can't be played with the " + dist + " letter distribution")
+}
+
+// GameRules returns the Macondo rules for a game of the given variant,
+// played with the given lexicon.
+func GameRules(cfg *macondoconfig.Config, rules *pb.GameRules, lexiconName string) (*game.GameRules, error) {
+	variant, err := entity.VariantFromRules(rules)
+	if err != nil {
+		return nil, err
+	}
+	bd, err := BoardLayout(rules.BoardLayoutName)
+	if err != nil {
+		return nil, err
+	}
+	distName, err := LetterDistributionName(rules)
+	if err != nil {
+		return nil, err
+	}
+	dist, err := alphabet.LoadLetterDistribution(cfg, distName)
+	if err != nil {
+		return nil, err
+	}
+	gd, err := gaddag.LoadFromCache(cfg, lexiconName)
+	if err != nil {
+		return nil, err
+	}
+
+	return game.NewGameRules(
+		cfg, dist, board.MakeBoard(bd), Lexicon(gd, variant),
+		cross_set.CrossScoreOnlyGenerator{Dist: dist}), nil
+}
+
+// Lexicon returns the lexicon that words are checked against in the variant.
+// In dogworms, that's every anagram of a word in the gaddag.
+func Lexicon(gd gaddag.GenericDawg, variant entity.Variant) lexicon.Lexicon {
+	if variant == entity.VarDogworms {
+		return &AnagramLexicon{GenericDawg: gd}
+	}
+	return &gaddag.Lexicon{GenericDawg: gd}
+}
@@ -0,0 +1,110 @@
+package variants
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/macondo/alphabet"
+	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/gaddag"
+	"github.com/domino14/macondo/gaddagmaker"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
+)
+
+func TestSuperBoard(t *testing.T) {
+	is := is.New(t)
+	n := len(SuperCrosswordGameBoard)
+	is.Equal(n, 21)
+	for r, row := range SuperCrosswordGameBoard {
+		is.Equal(len(row), n)
+		for c := range row {
+			is.Equal(row[c], SuperCrosswordGameBoard[c][r])
+			is.Equal(row[c], SuperCrosswordGameBoard[n-1-r][c])
+		}
+	}
+	is.Equal(SuperCrosswordGameBoard[n/2][n/2], byte('-'))
+}
+
+func TestLetterDistributionName(t *testing.T) {
+	is := is.New(t)
+	rules := &pb.GameRules{BoardLayoutName: entity.CrosswordGame, LetterDistributionName: "English"}
+	name, err := LetterDistributionName(rules)
+	is.NoErr(err)
+	is.Equal(name, "english")
+
+	rules.VariantName = string(entity.VarAWorth100)
+	name, err = LetterDistributionName(rules)
+	is.NoErr(err)
+	is.Equal(name, "english_a100")
+
+	// Super OMGWords needs the super board.
+	rules.VariantName = string(entity.VarSuper)
+	_, err = LetterDistributionName(rules)
+	is.True(err != nil)
+	rules.BoardLayoutName = entity.SuperCrosswordGame
+	name, err = LetterDistributionName(rules)
+	is.NoErr(err)
+	is.Equal(name, "english_super")
+}
+
+func TestValidate(t *testing.T) {
+	is := is.New(t)
+	rules := func(variant entity.Variant, dist string) *pb.GameRules {
+		bd := entity.CrosswordGame
+		if variant == entity.VarSuper {
+			bd = entity.SuperCrosswordGame
+		}
+		return &pb.GameRules{BoardLayoutName: bd, LetterDistributionName: dist,
+			VariantName: string(variant)}
+	}
+	is.NoErr(Validate("NWL18", rules(entity.VarClassic, "English")))
+	is.NoErr(Validate("FISE2", rules(entity.VarDogworms, "spanish")))
+	is.NoErr(Validate("CSW19", rules(entity.VarSuper, "english")))
+	is.NoErr(Validate("CSW19", rules(entity.VarAWorth100, "english")))
+
+	is.True(Validate("NWL18", rules(entity.VarClassic, "polish")) != nil)
+	is.True(Validate("OSPS42", rules(entity.VarSuper, "polish")) != nil)
+	is.True(Validate("FISE2", rules(entity.VarAWorth100, "spanish")) != nil)
+	is.True(Validate("NWL18", rules("scrabble-rush", "english")) != nil)
+}
+
+func TestAnagramLexicon(t *testing.T) {
+	is := is.New(t)
+	gd := gaddag.GaddagToSimpleGaddag(
+		gaddagmaker.GenerateGaddagFromStream(strings.NewReader("DOG\nWORM\nWORMS\n"), "TEST"))
+	lex := AnagramLexicon{GenericDawg: gd}
+	word := func(s string) alphabet.MachineWord {
+		mw, err := alphabet.ToMachineWord(s, gd.GetAlphabet())
+		is.NoErr(err)
+		return mw
+	}
+
+	is.True(lex.HasWord(word("DOG")))
+	is.True(lex.HasWord(word("GOD")))
+	is.True(lex.HasWord(word("MROW")))
+	is.True(lex.HasWord(word("SmROW")))
+	is.True(!lex.HasWord(word("DO")))
+	is.True(!lex.HasWord(word("DOGG")))
+	is.True(!lex.HasWord(word("WORMD")))
+	is.Equal(lex.Name(), "TEST")
+}
+
+func TestVariantDistributions(t *testing.T) {
+	is := is.New(t)
+	cfg := &macondoconfig.Config{LetterDistributionPath: "../../data/letterdistributions"}
+
+	dist, err := alphabet.LoadLetterDistribution(cfg, "english_a100")
+	is.NoErr(err)
+	a, err := dist.Alphabet().Val('A')
+	is.NoErr(err)
+	is.Equal(dist.Score(a), 100)
+	is.Equal(dist.NumTotalTiles(), 100)
+
+	dist, err = alphabet.LoadLetterDistribution(cfg, "english_super")
+	is.NoErr(err)
+	is.Equal(dist.NumTotalTiles(), 200)
+}
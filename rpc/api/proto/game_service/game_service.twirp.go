@@ -43,6 +43,19 @@ type GameMetadataService interface {
 	GetMetadata(context.Context, *GameInfoRequest) (*GameInfoResponse, error)
 
 	GetGCG(context.Context, *GCGRequest) (*GCGResponse, error)
+
+	// GetCorrespondenceGames lists the user's ongoing correspondence games.
+	GetCorrespondenceGames(context.Context, *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error)
+
+	// GetLexica lists the lexica and letter distributions games can be played
+	// with.
+	GetLexica(context.Context, *LexicaRequest) (*LexicaResponse, error)
+
+	// GetPastGames searches a user's finished games, most recent first.
+	GetPastGames(context.Context, *PastGamesRequest) (*PastGamesResponse, error)
+
+	// GetHeadToHead gets the record of a user against an opponent.
+	GetHeadToHead(context.Context, *HeadToHeadRequest) (*HeadToHeadResponse, error)
 }
 
 // ===================================
@@ -51,7 +64,7 @@ type GameMetadataService interface {
 
 type gameMetadataServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [6]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -71,9 +84,13 @@ func NewGameMetadataServiceProtobufClient(baseURL string, client HTTPClient, opt
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "game_service", "GameMetadataService")
-	urls := [2]string{
+	urls := [6]string{
 		serviceURL + "GetMetadata",
 		serviceURL + "GetGCG",
+		serviceURL + "GetCorrespondenceGames",
+		serviceURL + "GetLexica",
+		serviceURL + "GetPastGames",
+		serviceURL + "GetHeadToHead",
 	}
 
 	return &gameMetadataServiceProtobufClient{
@@ -176,13 +193,197 @@ func (c *gameMetadataServiceProtobufClient) callGetGCG(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *gameMetadataServiceProtobufClient) GetCorrespondenceGames(ctx context.Context, in *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCorrespondenceGames")
+	caller := c.callGetCorrespondenceGames
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CorrespondenceGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CorrespondenceGamesRequest) when calling interceptor")
+					}
+					return c.callGetCorrespondenceGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CorrespondenceGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CorrespondenceGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *gameMetadataServiceProtobufClient) callGetCorrespondenceGames(ctx context.Context, in *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error) {
+	out := new(CorrespondenceGamesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *gameMetadataServiceProtobufClient) GetLexica(ctx context.Context, in *LexicaRequest) (*LexicaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLexica")
+	caller := c.callGetLexica
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LexicaRequest) (*LexicaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexicaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexicaRequest) when calling interceptor")
+					}
+					return c.callGetLexica(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexicaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexicaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *gameMetadataServiceProtobufClient) callGetLexica(ctx context.Context, in *LexicaRequest) (*LexicaResponse, error) {
+	out := new(LexicaResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *gameMetadataServiceProtobufClient) GetPastGames(ctx context.Context, in *PastGamesRequest) (*PastGamesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPastGames")
+	caller := c.callGetPastGames
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PastGamesRequest) (*PastGamesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PastGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PastGamesRequest) when calling interceptor")
+					}
+					return c.callGetPastGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PastGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PastGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *gameMetadataServiceProtobufClient) callGetPastGames(ctx context.Context, in *PastGamesRequest) (*PastGamesResponse, error) {
+	out := new(PastGamesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *gameMetadataServiceProtobufClient) GetHeadToHead(ctx context.Context, in *HeadToHeadRequest) (*HeadToHeadResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetHeadToHead")
+	caller := c.callGetHeadToHead
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *HeadToHeadRequest) (*HeadToHeadResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HeadToHeadRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HeadToHeadRequest) when calling interceptor")
+					}
+					return c.callGetHeadToHead(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HeadToHeadResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HeadToHeadResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *gameMetadataServiceProtobufClient) callGetHeadToHead(ctx context.Context, in *HeadToHeadRequest) (*HeadToHeadResponse, error) {
+	out := new(HeadToHeadResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===============================
 // GameMetadataService JSON Client
 // ===============================
 
 type gameMetadataServiceJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [6]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -202,9 +403,13 @@ func NewGameMetadataServiceJSONClient(baseURL string, client HTTPClient, opts ..
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "game_service", "GameMetadataService")
-	urls := [2]string{
+	urls := [6]string{
 		serviceURL + "GetMetadata",
 		serviceURL + "GetGCG",
+		serviceURL + "GetCorrespondenceGames",
+		serviceURL + "GetLexica",
+		serviceURL + "GetPastGames",
+		serviceURL + "GetHeadToHead",
 	}
 
 	return &gameMetadataServiceJSONClient{
@@ -307,87 +512,271 @@ func (c *gameMetadataServiceJSONClient) callGetGCG(ctx context.Context, in *GCGR
 	return out, nil
 }
 
-// ==================================
-// GameMetadataService Server Handler
-// ==================================
-
-type gameMetadataServiceServer struct {
-	GameMetadataService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+func (c *gameMetadataServiceJSONClient) GetCorrespondenceGames(ctx context.Context, in *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCorrespondenceGames")
+	caller := c.callGetCorrespondenceGames
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CorrespondenceGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CorrespondenceGamesRequest) when calling interceptor")
+					}
+					return c.callGetCorrespondenceGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CorrespondenceGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CorrespondenceGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewGameMetadataServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewGameMetadataServiceServer(svc GameMetadataService, opts ...interface{}) TwirpServer {
-	serverOpts := twirp.ServerOptions{}
-	for _, opt := range opts {
-		switch o := opt.(type) {
-		case twirp.ServerOption:
-			o(&serverOpts)
-		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
-			twirp.WithServerHooks(o)(&serverOpts)
-		case nil: // backwards compatibility, allow nil value for the argument
-			continue
-		default:
-			panic(fmt.Sprintf("Invalid option type %T on NewGameMetadataServiceServer", o))
+func (c *gameMetadataServiceJSONClient) callGetCorrespondenceGames(ctx context.Context, in *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error) {
+	out := new(CorrespondenceGamesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
 		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &gameMetadataServiceServer{
-		GameMetadataService: svc,
-		pathPrefix:          serverOpts.PathPrefix(),
-		interceptor:         twirp.ChainInterceptors(serverOpts.Interceptors...),
-		hooks:               serverOpts.Hooks,
-		jsonSkipDefaults:    serverOpts.JSONSkipDefaults,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *gameMetadataServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// GameMetadataServicePathPrefix is a convenience constant that could used to identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// that add a "/twirp" prefix by default, and use CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const GameMetadataServicePathPrefix = "/twirp/game_service.GameMetadataService/"
-
-func (s *gameMetadataServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+func (c *gameMetadataServiceJSONClient) GetLexica(ctx context.Context, in *LexicaRequest) (*LexicaResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "game_service")
 	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	ctx = ctxsetters.WithMethodName(ctx, "GetLexica")
+	caller := c.callGetLexica
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LexicaRequest) (*LexicaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexicaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexicaRequest) when calling interceptor")
+					}
+					return c.callGetLexica(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexicaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexicaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
+func (c *gameMetadataServiceJSONClient) callGetLexica(ctx context.Context, in *LexicaRequest) (*LexicaResponse, error) {
+	out := new(LexicaResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "game_service.GameMetadataService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	return out, nil
+}
+
+func (c *gameMetadataServiceJSONClient) GetPastGames(ctx context.Context, in *PastGamesRequest) (*PastGamesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPastGames")
+	caller := c.callGetPastGames
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PastGamesRequest) (*PastGamesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PastGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PastGamesRequest) when calling interceptor")
+					}
+					return c.callGetPastGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PastGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PastGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *gameMetadataServiceJSONClient) callGetPastGames(ctx context.Context, in *PastGamesRequest) (*PastGamesResponse, error) {
+	out := new(PastGamesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *gameMetadataServiceJSONClient) GetHeadToHead(ctx context.Context, in *HeadToHeadRequest) (*HeadToHeadResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetHeadToHead")
+	caller := c.callGetHeadToHead
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *HeadToHeadRequest) (*HeadToHeadResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HeadToHeadRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HeadToHeadRequest) when calling interceptor")
+					}
+					return c.callGetHeadToHead(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HeadToHeadResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HeadToHeadResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *gameMetadataServiceJSONClient) callGetHeadToHead(ctx context.Context, in *HeadToHeadRequest) (*HeadToHeadResponse, error) {
+	out := new(HeadToHeadResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==================================
+// GameMetadataService Server Handler
+// ==================================
+
+type gameMetadataServiceServer struct {
+	GameMetadataService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewGameMetadataServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewGameMetadataServiceServer(svc GameMetadataService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewGameMetadataServiceServer", o))
+		}
+	}
+
+	return &gameMetadataServiceServer{
+		GameMetadataService: svc,
+		pathPrefix:          serverOpts.PathPrefix(),
+		interceptor:         twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:               serverOpts.Hooks,
+		jsonSkipDefaults:    serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *gameMetadataServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// GameMetadataServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const GameMetadataServicePathPrefix = "/twirp/game_service.GameMetadataService/"
+
+func (s *gameMetadataServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameMetadataService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "game_service.GameMetadataService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
 	}
 
 	switch method {
@@ -397,6 +786,18 @@ func (s *gameMetadataServiceServer) ServeHTTP(resp http.ResponseWriter, req *htt
 	case "GetGCG":
 		s.serveGetGCG(ctx, resp, req)
 		return
+	case "GetCorrespondenceGames":
+		s.serveGetCorrespondenceGames(ctx, resp, req)
+		return
+	case "GetLexica":
+		s.serveGetLexica(ctx, resp, req)
+		return
+	case "GetPastGames":
+		s.serveGetPastGames(ctx, resp, req)
+		return
+	case "GetHeadToHead":
+		s.serveGetHeadToHead(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -754,19 +1155,1639 @@ func (s *gameMetadataServiceServer) serveGetGCGProtobuf(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *gameMetadataServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 0
+func (s *gameMetadataServiceServer) serveGetCorrespondenceGames(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetCorrespondenceGamesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetCorrespondenceGamesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
 }
 
-func (s *gameMetadataServiceServer) ProtocGenTwirpVersion() string {
-	return "v7.1.0"
+func (s *gameMetadataServiceServer) serveGetCorrespondenceGamesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCorrespondenceGames")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(CorrespondenceGamesRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.GameMetadataService.GetCorrespondenceGames
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CorrespondenceGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CorrespondenceGamesRequest) when calling interceptor")
+					}
+					return s.GameMetadataService.GetCorrespondenceGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CorrespondenceGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CorrespondenceGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CorrespondenceGamesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CorrespondenceGamesResponse and nil error while calling GetCorrespondenceGames. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
-// that is everything in a Twirp route except for the <Method>. This can be used for routing,
-// for example to identify the requests that are targeted to this service in a mux.
-func (s *gameMetadataServiceServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "game_service", "GameMetadataService")
+func (s *gameMetadataServiceServer) serveGetCorrespondenceGamesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCorrespondenceGames")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(CorrespondenceGamesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.GameMetadataService.GetCorrespondenceGames
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CorrespondenceGamesRequest) (*CorrespondenceGamesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CorrespondenceGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CorrespondenceGamesRequest) when calling interceptor")
+					}
+					return s.GameMetadataService.GetCorrespondenceGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CorrespondenceGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CorrespondenceGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CorrespondenceGamesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CorrespondenceGamesResponse and nil error while calling GetCorrespondenceGames. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameMetadataServiceServer) serveGetLexica(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetLexicaJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetLexicaProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *gameMetadataServiceServer) serveGetLexicaJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLexica")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(LexicaRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.GameMetadataService.GetLexica
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LexicaRequest) (*LexicaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexicaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexicaRequest) when calling interceptor")
+					}
+					return s.GameMetadataService.GetLexica(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexicaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexicaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LexicaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LexicaResponse and nil error while calling GetLexica. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameMetadataServiceServer) serveGetLexicaProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLexica")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(LexicaRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.GameMetadataService.GetLexica
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LexicaRequest) (*LexicaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexicaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexicaRequest) when calling interceptor")
+					}
+					return s.GameMetadataService.GetLexica(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexicaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexicaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LexicaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LexicaResponse and nil error while calling GetLexica. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameMetadataServiceServer) serveGetPastGames(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPastGamesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPastGamesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *gameMetadataServiceServer) serveGetPastGamesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPastGames")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(PastGamesRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.GameMetadataService.GetPastGames
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PastGamesRequest) (*PastGamesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PastGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PastGamesRequest) when calling interceptor")
+					}
+					return s.GameMetadataService.GetPastGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PastGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PastGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PastGamesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PastGamesResponse and nil error while calling GetPastGames. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameMetadataServiceServer) serveGetPastGamesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPastGames")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(PastGamesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.GameMetadataService.GetPastGames
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PastGamesRequest) (*PastGamesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PastGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PastGamesRequest) when calling interceptor")
+					}
+					return s.GameMetadataService.GetPastGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PastGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PastGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PastGamesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PastGamesResponse and nil error while calling GetPastGames. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameMetadataServiceServer) serveGetHeadToHead(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetHeadToHeadJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetHeadToHeadProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *gameMetadataServiceServer) serveGetHeadToHeadJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetHeadToHead")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(HeadToHeadRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.GameMetadataService.GetHeadToHead
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *HeadToHeadRequest) (*HeadToHeadResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HeadToHeadRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HeadToHeadRequest) when calling interceptor")
+					}
+					return s.GameMetadataService.GetHeadToHead(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HeadToHeadResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HeadToHeadResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *HeadToHeadResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HeadToHeadResponse and nil error while calling GetHeadToHead. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameMetadataServiceServer) serveGetHeadToHeadProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetHeadToHead")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(HeadToHeadRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.GameMetadataService.GetHeadToHead
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *HeadToHeadRequest) (*HeadToHeadResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HeadToHeadRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HeadToHeadRequest) when calling interceptor")
+					}
+					return s.GameMetadataService.GetHeadToHead(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HeadToHeadResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HeadToHeadResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *HeadToHeadResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HeadToHeadResponse and nil error while calling GetHeadToHead. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameMetadataServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *gameMetadataServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *gameMetadataServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "game_service", "GameMetadataService")
+}
+
+// ===========================
+// AnnotationService Interface
+// ===========================
+
+type AnnotationService interface {
+	AnnotateTurn(context.Context, *AnnotationRequest) (*AnnotationResponse, error)
+}
+
+// =================================
+// AnnotationService Protobuf Client
+// =================================
+
+type annotationServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAnnotationServiceProtobufClient creates a Protobuf client that implements the AnnotationService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewAnnotationServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AnnotationService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "game_service", "AnnotationService")
+	urls := [1]string{
+		serviceURL + "AnnotateTurn",
+	}
+
+	return &annotationServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *annotationServiceProtobufClient) AnnotateTurn(ctx context.Context, in *AnnotationRequest) (*AnnotationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "AnnotationService")
+	ctx = ctxsetters.WithMethodName(ctx, "AnnotateTurn")
+	caller := c.callAnnotateTurn
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AnnotationRequest) (*AnnotationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AnnotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AnnotationRequest) when calling interceptor")
+					}
+					return c.callAnnotateTurn(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AnnotationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AnnotationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *annotationServiceProtobufClient) callAnnotateTurn(ctx context.Context, in *AnnotationRequest) (*AnnotationResponse, error) {
+	out := new(AnnotationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// AnnotationService JSON Client
+// =============================
+
+type annotationServiceJSONClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAnnotationServiceJSONClient creates a JSON client that implements the AnnotationService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAnnotationServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AnnotationService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "game_service", "AnnotationService")
+	urls := [1]string{
+		serviceURL + "AnnotateTurn",
+	}
+
+	return &annotationServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *annotationServiceJSONClient) AnnotateTurn(ctx context.Context, in *AnnotationRequest) (*AnnotationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "AnnotationService")
+	ctx = ctxsetters.WithMethodName(ctx, "AnnotateTurn")
+	caller := c.callAnnotateTurn
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AnnotationRequest) (*AnnotationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AnnotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AnnotationRequest) when calling interceptor")
+					}
+					return c.callAnnotateTurn(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AnnotationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AnnotationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *annotationServiceJSONClient) callAnnotateTurn(ctx context.Context, in *AnnotationRequest) (*AnnotationResponse, error) {
+	out := new(AnnotationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ================================
+// AnnotationService Server Handler
+// ================================
+
+type annotationServiceServer struct {
+	AnnotationService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewAnnotationServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAnnotationServiceServer(svc AnnotationService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewAnnotationServiceServer", o))
+		}
+	}
+
+	return &annotationServiceServer{
+		AnnotationService: svc,
+		pathPrefix:        serverOpts.PathPrefix(),
+		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:             serverOpts.Hooks,
+		jsonSkipDefaults:  serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *annotationServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// AnnotationServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AnnotationServicePathPrefix = "/twirp/game_service.AnnotationService/"
+
+func (s *annotationServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "AnnotationService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "game_service.AnnotationService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "AnnotateTurn":
+		s.serveAnnotateTurn(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *annotationServiceServer) serveAnnotateTurn(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAnnotateTurnJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAnnotateTurnProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *annotationServiceServer) serveAnnotateTurnJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AnnotateTurn")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(AnnotationRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.AnnotationService.AnnotateTurn
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AnnotationRequest) (*AnnotationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AnnotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AnnotationRequest) when calling interceptor")
+					}
+					return s.AnnotationService.AnnotateTurn(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AnnotationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AnnotationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AnnotationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AnnotationResponse and nil error while calling AnnotateTurn. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *annotationServiceServer) serveAnnotateTurnProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AnnotateTurn")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(AnnotationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AnnotationService.AnnotateTurn
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AnnotationRequest) (*AnnotationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AnnotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AnnotationRequest) when calling interceptor")
+					}
+					return s.AnnotationService.AnnotateTurn(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AnnotationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AnnotationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AnnotationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AnnotationResponse and nil error while calling AnnotateTurn. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *annotationServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 1
+}
+
+func (s *annotationServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *annotationServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "game_service", "AnnotationService")
+}
+
+// ===========================
+// GameImportService Interface
+// ===========================
+
+type GameImportService interface {
+	ImportGCG(context.Context, *ImportGCGRequest) (*ImportGCGResponse, error)
+}
+
+// =================================
+// GameImportService Protobuf Client
+// =================================
+
+type gameImportServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewGameImportServiceProtobufClient creates a Protobuf client that implements the GameImportService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewGameImportServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) GameImportService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "game_service", "GameImportService")
+	urls := [1]string{
+		serviceURL + "ImportGCG",
+	}
+
+	return &gameImportServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *gameImportServiceProtobufClient) ImportGCG(ctx context.Context, in *ImportGCGRequest) (*ImportGCGResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameImportService")
+	ctx = ctxsetters.WithMethodName(ctx, "ImportGCG")
+	caller := c.callImportGCG
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ImportGCGRequest) (*ImportGCGResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportGCGRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportGCGRequest) when calling interceptor")
+					}
+					return c.callImportGCG(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportGCGResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportGCGResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *gameImportServiceProtobufClient) callImportGCG(ctx context.Context, in *ImportGCGRequest) (*ImportGCGResponse, error) {
+	out := new(ImportGCGResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// GameImportService JSON Client
+// =============================
+
+type gameImportServiceJSONClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewGameImportServiceJSONClient creates a JSON client that implements the GameImportService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewGameImportServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) GameImportService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "game_service", "GameImportService")
+	urls := [1]string{
+		serviceURL + "ImportGCG",
+	}
+
+	return &gameImportServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *gameImportServiceJSONClient) ImportGCG(ctx context.Context, in *ImportGCGRequest) (*ImportGCGResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameImportService")
+	ctx = ctxsetters.WithMethodName(ctx, "ImportGCG")
+	caller := c.callImportGCG
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ImportGCGRequest) (*ImportGCGResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportGCGRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportGCGRequest) when calling interceptor")
+					}
+					return c.callImportGCG(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportGCGResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportGCGResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *gameImportServiceJSONClient) callImportGCG(ctx context.Context, in *ImportGCGRequest) (*ImportGCGResponse, error) {
+	out := new(ImportGCGResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ================================
+// GameImportService Server Handler
+// ================================
+
+type gameImportServiceServer struct {
+	GameImportService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewGameImportServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewGameImportServiceServer(svc GameImportService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewGameImportServiceServer", o))
+		}
+	}
+
+	return &gameImportServiceServer{
+		GameImportService: svc,
+		pathPrefix:        serverOpts.PathPrefix(),
+		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:             serverOpts.Hooks,
+		jsonSkipDefaults:  serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *gameImportServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// GameImportServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const GameImportServicePathPrefix = "/twirp/game_service.GameImportService/"
+
+func (s *gameImportServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "game_service")
+	ctx = ctxsetters.WithServiceName(ctx, "GameImportService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "game_service.GameImportService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "ImportGCG":
+		s.serveImportGCG(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *gameImportServiceServer) serveImportGCG(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveImportGCGJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveImportGCGProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *gameImportServiceServer) serveImportGCGJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ImportGCG")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(ImportGCGRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.GameImportService.ImportGCG
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ImportGCGRequest) (*ImportGCGResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportGCGRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportGCGRequest) when calling interceptor")
+					}
+					return s.GameImportService.ImportGCG(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportGCGResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportGCGResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ImportGCGResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ImportGCGResponse and nil error while calling ImportGCG. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameImportServiceServer) serveImportGCGProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ImportGCG")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(ImportGCGRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.GameImportService.ImportGCG
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ImportGCGRequest) (*ImportGCGResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportGCGRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportGCGRequest) when calling interceptor")
+					}
+					return s.GameImportService.ImportGCG(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportGCGResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportGCGResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ImportGCGResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ImportGCGResponse and nil error while calling ImportGCG. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *gameImportServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 2
+}
+
+func (s *gameImportServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *gameImportServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "game_service", "GameImportService")
 }
 
 // =====
@@ -1316,47 +3337,99 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5f, 0x4f, 0x13, 0x4f,
-	0x14, 0x4d, 0x7f, 0xd0, 0x7f, 0xb7, 0x40, 0x61, 0xe0, 0x87, 0x6b, 0x0d, 0x0a, 0x4d, 0x0c, 0x88,
-	0x49, 0xab, 0x95, 0x18, 0x5f, 0xf0, 0x81, 0xc6, 0x6c, 0x48, 0x44, 0xcd, 0xa2, 0x2f, 0xbe, 0x6c,
-	0x86, 0xdd, 0xcb, 0x32, 0x71, 0x76, 0xa6, 0xce, 0xce, 0x56, 0xf8, 0x3a, 0xbe, 0xf9, 0xc1, 0xfc,
-	0x1e, 0x66, 0xfe, 0x6c, 0x5b, 0x08, 0xc6, 0xa7, 0xce, 0x39, 0xe7, 0xde, 0xb9, 0x7b, 0xcf, 0xbd,
-	0x53, 0x78, 0x46, 0x27, 0x6c, 0x38, 0x51, 0x52, 0xcb, 0x61, 0x46, 0x73, 0x8c, 0x0b, 0x54, 0x53,
-	0x96, 0xe0, 0x2d, 0x30, 0xb0, 0x3a, 0x59, 0x59, 0xe4, 0x7a, 0x7b, 0xf3, 0x44, 0x85, 0x94, 0x6b,
-	0x96, 0xe3, 0xec, 0xe0, 0x12, 0x7a, 0xfb, 0x39, 0x4d, 0xa4, 0x48, 0xe5, 0x70, 0x1e, 0x5a, 0x31,
-	0xfe, 0xd7, 0x05, 0xf6, 0x0f, 0xa1, 0x1b, 0xd2, 0x1c, 0x4f, 0xc5, 0xa5, 0x8c, 0xf0, 0x7b, 0x89,
-	0x85, 0x26, 0x0f, 0xa0, 0x69, 0xcb, 0xb1, 0x34, 0xa8, 0xed, 0xd6, 0x0e, 0xda, 0x51, 0xc3, 0xc0,
-	0xd3, 0xb4, 0xff, 0xbb, 0x06, 0xf0, 0x89, 0xd3, 0x1b, 0x54, 0x26, 0xdc, 0xc4, 0x95, 0x05, 0xaa,
-	0x85, 0x38, 0x03, 0x4f, 0x53, 0xd2, 0x83, 0x96, 0x60, 0xc9, 0x37, 0x41, 0x73, 0x0c, 0xfe, 0xb3,
-	0xca, 0x0c, 0x93, 0x47, 0xd0, 0xbe, 0x2c, 0x39, 0x8f, 0xad, 0xb8, 0xe4, 0x44, 0x43, 0x7c, 0x30,
-	0xe2, 0x1e, 0xac, 0x24, 0xb2, 0x14, 0x5a, 0xdd, 0xc4, 0x89, 0x4c, 0x31, 0x58, 0xb6, 0x7a, 0xc7,
-	0x73, 0x63, 0x99, 0x22, 0xd9, 0x86, 0x86, 0xa2, 0x9a, 0x89, 0x2c, 0xa8, 0xbb, 0x9a, 0x0e, 0x91,
-	0x2d, 0xa8, 0x6b, 0xa6, 0x39, 0x06, 0x0d, 0x4b, 0x3b, 0x40, 0x76, 0x00, 0xe8, 0x94, 0x6a, 0xaa,
-	0xe2, 0x52, 0xf1, 0xa0, 0x69, 0xa5, 0xb6, 0x63, 0xbe, 0x28, 0x4e, 0xfe, 0x87, 0x06, 0x2b, 0xe2,
-	0x0b, 0xa9, 0x83, 0xd6, 0x6e, 0xed, 0xa0, 0x15, 0xd5, 0x59, 0x71, 0x22, 0x75, 0xff, 0xd7, 0x32,
-	0xac, 0xcf, 0x4d, 0x29, 0x26, 0x52, 0x14, 0x48, 0x46, 0xd0, 0x9c, 0xd8, 0xde, 0x8b, 0xa0, 0xb6,
-	0xbb, 0x74, 0xd0, 0x19, 0x05, 0x83, 0x5b, 0x83, 0x9a, 0x1b, 0x13, 0x55, 0x81, 0x24, 0x80, 0x26,
-	0xc7, 0x6b, 0x96, 0x48, 0xe1, 0x7d, 0xa8, 0xa0, 0x51, 0xa6, 0x54, 0x31, 0x2a, 0xb4, 0x37, 0xa1,
-	0x82, 0xe4, 0x10, 0x36, 0xcc, 0x1c, 0xe3, 0x44, 0x0a, 0xad, 0xa4, 0x37, 0xca, 0x19, 0xd1, 0x35,
-	0xc2, 0xd8, 0xf1, 0xd6, 0xaf, 0x17, 0xb0, 0xc5, 0x04, 0xd3, 0x8c, 0xf2, 0xd8, 0xe6, 0x14, 0x68,
-	0x46, 0x5b, 0x58, 0x6b, 0xea, 0x11, 0xf1, 0xda, 0x67, 0x96, 0xe3, 0xb9, 0x53, 0xc8, 0x3e, 0x74,
-	0xb5, 0x2c, 0x95, 0xb9, 0x54, 0x68, 0x77, 0xb7, 0x33, 0x6c, 0x6d, 0x4e, 0xdb, 0xab, 0x8f, 0x61,
-	0x2d, 0xb9, 0xa2, 0x9c, 0xa3, 0xc8, 0x30, 0x56, 0x25, 0x47, 0xeb, 0xde, 0xda, 0x68, 0x7b, 0x50,
-	0xed, 0xcf, 0xb8, 0x92, 0xa3, 0x92, 0x63, 0xb4, 0x9a, 0x2c, 0x42, 0x72, 0x04, 0x1d, 0x37, 0x98,
-	0x38, 0x37, 0x83, 0x6c, 0xd9, 0xdc, 0xcd, 0x01, 0x67, 0x3f, 0xa4, 0x4a, 0x8b, 0x41, 0x64, 0xb5,
-	0x33, 0x99, 0x62, 0x04, 0x6a, 0x76, 0x26, 0x04, 0x96, 0x53, 0x29, 0x30, 0x68, 0xdb, 0x69, 0xd8,
-	0xb3, 0xe9, 0x31, 0xa7, 0xd7, 0xb1, 0x9c, 0xa2, 0xb2, 0x3d, 0xe6, 0x4c, 0x94, 0x1a, 0x8b, 0x00,
-	0x5c, 0x8f, 0x39, 0xbd, 0xfe, 0xe8, 0xa5, 0x33, 0xa7, 0x90, 0xb7, 0xd0, 0xb5, 0x93, 0x41, 0x91,
-	0xc6, 0x0a, 0x69, 0x21, 0x45, 0xd0, 0xf1, 0xdf, 0x5e, 0xd5, 0x37, 0xd3, 0x7d, 0x27, 0xd2, 0xc8,
-	0xaa, 0xd1, 0x6a, 0xb6, 0x08, 0xc9, 0x73, 0xd8, 0x60, 0x22, 0x51, 0x68, 0x2d, 0xaa, 0x2c, 0x5d,
-	0xb1, 0xe5, 0xd6, 0x67, 0x82, 0x37, 0xb4, 0xff, 0x14, 0x20, 0x1c, 0x87, 0xff, 0x7c, 0x3a, 0x4f,
-	0xa0, 0x63, 0xc3, 0xfc, 0x32, 0xad, 0xc3, 0x52, 0x96, 0x64, 0x3e, 0xc6, 0x1c, 0x47, 0x3f, 0x6b,
-	0xb0, 0x69, 0xbe, 0xea, 0x0c, 0x35, 0x4d, 0xa9, 0xa6, 0xe7, 0x6e, 0xad, 0xc8, 0x7b, 0xe8, 0x84,
-	0xa8, 0x2b, 0x96, 0xec, 0xdc, 0x5e, 0xba, 0x3b, 0x4f, 0xb7, 0xf7, 0xf8, 0x6f, 0xb2, 0xaf, 0x7b,
-	0x0c, 0x8d, 0x10, 0x75, 0x38, 0x0e, 0xc9, 0x9d, 0xed, 0x9d, 0xf7, 0xd0, 0x7b, 0x78, 0x8f, 0xe2,
-	0xd2, 0x4f, 0xde, 0x7c, 0x7d, 0x9d, 0x31, 0x7d, 0x55, 0x5e, 0x0c, 0x12, 0x99, 0x0f, 0x53, 0x99,
-	0x33, 0x21, 0x5f, 0x1e, 0x0d, 0xbd, 0xab, 0x43, 0x35, 0x49, 0x86, 0xf7, 0xff, 0xa7, 0x5d, 0x34,
-	0x2c, 0xf7, 0xea, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x23, 0xed, 0xb4, 0xf4, 0x04, 0x00,
-	0x00,
+	// 1491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdb, 0x72, 0x1b, 0x45,
+	0x13, 0xfe, 0x65, 0x59, 0xa7, 0x96, 0x64, 0x4b, 0x13, 0xff, 0x46, 0x51, 0x0e, 0x76, 0x16, 0x52,
+	0x71, 0x0e, 0x65, 0x13, 0x93, 0xa2, 0xe0, 0x22, 0x54, 0x39, 0x4e, 0x22, 0x0c, 0xce, 0x81, 0xb5,
+	0x53, 0x29, 0xb8, 0x59, 0xc6, 0xbb, 0x23, 0x65, 0xc9, 0xee, 0x8c, 0x98, 0x9d, 0xf5, 0xe1, 0x82,
+	0x77, 0xe0, 0x8a, 0x2b, 0x9e, 0x88, 0xd7, 0xa0, 0x78, 0x02, 0xee, 0x29, 0x6a, 0x7a, 0x66, 0xa5,
+	0x95, 0x2c, 0x39, 0xa4, 0x72, 0x63, 0x6f, 0xf7, 0xd7, 0x33, 0xdd, 0xfd, 0x75, 0x4f, 0xcf, 0x08,
+	0x6e, 0xd3, 0x61, 0xb8, 0x35, 0x94, 0x42, 0x89, 0xad, 0x01, 0x8d, 0x99, 0x97, 0x30, 0x79, 0x1c,
+	0xfa, 0x6c, 0x42, 0xd8, 0x44, 0x9c, 0x34, 0xf2, 0xba, 0xee, 0x8d, 0xf1, 0x42, 0xc9, 0x68, 0xa4,
+	0xc2, 0x98, 0x8d, 0x3e, 0xcc, 0x82, 0xee, 0xad, 0x98, 0xfa, 0x82, 0x07, 0x62, 0x6b, 0x6c, 0x9a,
+	0x69, 0xec, 0x7f, 0x63, 0xe8, 0xdc, 0x81, 0xe5, 0x1e, 0x8d, 0xd9, 0x1e, 0xef, 0x0b, 0x97, 0xfd,
+	0x9c, 0xb2, 0x44, 0x91, 0x8f, 0xa0, 0x82, 0xee, 0xc2, 0xa0, 0x53, 0x58, 0x2f, 0x6c, 0xd4, 0xdc,
+	0xb2, 0x16, 0xf7, 0x02, 0xe7, 0xaf, 0x02, 0xc0, 0xcb, 0x88, 0x9e, 0x31, 0xa9, 0xcd, 0xb5, 0x5d,
+	0x9a, 0x30, 0x99, 0xb3, 0xd3, 0xe2, 0x5e, 0x40, 0xba, 0x50, 0xe5, 0xa1, 0xff, 0x96, 0xd3, 0x98,
+	0x75, 0x16, 0x10, 0x19, 0xc9, 0xe4, 0x0a, 0xd4, 0xfa, 0x69, 0x14, 0x79, 0x08, 0x16, 0x0d, 0xa8,
+	0x15, 0xcf, 0x35, 0x78, 0x03, 0x1a, 0xbe, 0x48, 0xb9, 0x92, 0x67, 0x9e, 0x2f, 0x02, 0xd6, 0x59,
+	0x44, 0xbc, 0x6e, 0x75, 0xbb, 0x22, 0x60, 0x64, 0x15, 0xca, 0x92, 0xaa, 0x90, 0x0f, 0x3a, 0x25,
+	0xe3, 0xd3, 0x48, 0x64, 0x05, 0x4a, 0x2a, 0x54, 0x11, 0xeb, 0x94, 0x51, 0x6d, 0x04, 0x72, 0x0d,
+	0x80, 0x1e, 0x53, 0x45, 0xa5, 0x97, 0xca, 0xa8, 0x53, 0x41, 0xa8, 0x66, 0x34, 0xaf, 0x64, 0x44,
+	0xfe, 0x0f, 0xe5, 0x30, 0xf1, 0x8e, 0x84, 0xea, 0x54, 0xd7, 0x0b, 0x1b, 0x55, 0xb7, 0x14, 0x26,
+	0x8f, 0x84, 0x72, 0xfe, 0x58, 0x84, 0xd6, 0x98, 0x94, 0x64, 0x28, 0x78, 0xc2, 0xc8, 0x36, 0x54,
+	0x86, 0x98, 0x7b, 0xd2, 0x29, 0xac, 0x17, 0x37, 0xea, 0xdb, 0x9d, 0xcd, 0x89, 0x42, 0x8d, 0x89,
+	0x71, 0x33, 0x43, 0xd2, 0x81, 0x4a, 0xc4, 0x4e, 0x43, 0x5f, 0x70, 0xcb, 0x43, 0x26, 0x6a, 0xe4,
+	0x98, 0xca, 0x90, 0x72, 0x65, 0x49, 0xc8, 0x44, 0x72, 0x07, 0xda, 0xba, 0x8e, 0x9e, 0x2f, 0xb8,
+	0x92, 0xc2, 0x12, 0x65, 0x88, 0x58, 0xd6, 0xc0, 0xae, 0xd1, 0x23, 0x5f, 0x9f, 0xc2, 0x4a, 0xc8,
+	0x43, 0x15, 0xd2, 0xc8, 0xc3, 0x35, 0x09, 0xd3, 0xa5, 0x4d, 0x90, 0x9a, 0x92, 0x4b, 0x2c, 0x76,
+	0x18, 0xc6, 0xec, 0xc0, 0x20, 0xe4, 0x16, 0x2c, 0x2b, 0x91, 0x4a, 0xbd, 0x29, 0x57, 0x66, 0x6f,
+	0x43, 0xd8, 0xd2, 0x58, 0x8d, 0x5b, 0x3f, 0x84, 0x25, 0xff, 0x0d, 0x8d, 0x22, 0xc6, 0x07, 0xcc,
+	0x93, 0x69, 0xc4, 0x90, 0xbd, 0xa5, 0xed, 0xd5, 0xcd, 0xac, 0x7f, 0x76, 0x33, 0xd8, 0x4d, 0x23,
+	0xe6, 0x36, 0xfd, 0xbc, 0x48, 0x1e, 0x40, 0xdd, 0x14, 0xc6, 0x8b, 0x75, 0x21, 0xab, 0xb8, 0xf6,
+	0xd2, 0x66, 0x14, 0x9e, 0x08, 0x19, 0x24, 0x9b, 0x2e, 0x62, 0xcf, 0x44, 0xc0, 0x5c, 0x90, 0xa3,
+	0x6f, 0x42, 0x60, 0x31, 0x10, 0x9c, 0x75, 0x6a, 0x58, 0x0d, 0xfc, 0xd6, 0x39, 0xc6, 0xf4, 0xd4,
+	0x13, 0xc7, 0x4c, 0x62, 0x8e, 0x71, 0xc8, 0x53, 0xc5, 0x92, 0x0e, 0x98, 0x1c, 0x63, 0x7a, 0xfa,
+	0xc2, 0x42, 0xcf, 0x0c, 0x42, 0xbe, 0x82, 0x65, 0xac, 0x0c, 0xe3, 0x81, 0x27, 0x19, 0x4d, 0x04,
+	0xef, 0xd4, 0x6d, 0xec, 0x99, 0x7f, 0x5d, 0xdd, 0x27, 0x3c, 0x70, 0x11, 0x75, 0x9b, 0x83, 0xbc,
+	0x48, 0xee, 0x42, 0x3b, 0xe4, 0xbe, 0x64, 0x48, 0x51, 0x46, 0x69, 0x03, 0xdd, 0xb5, 0x46, 0x40,
+	0x46, 0xe8, 0x1a, 0xd4, 0xc3, 0x78, 0x28, 0xa4, 0x62, 0x81, 0x77, 0x74, 0xd6, 0x69, 0x22, 0x99,
+	0x90, 0xa9, 0x1e, 0x9d, 0x39, 0x37, 0x01, 0x7a, 0xbb, 0xbd, 0x77, 0x9e, 0xad, 0x35, 0xa8, 0xa3,
+	0x99, 0xed, 0xb6, 0x16, 0x14, 0x07, 0xfe, 0xc0, 0xda, 0xe8, 0x4f, 0xe7, 0x2a, 0x74, 0x77, 0x85,
+	0x94, 0x68, 0x10, 0x30, 0xee, 0x33, 0x9d, 0x43, 0x62, 0xf7, 0x75, 0x9e, 0xc2, 0x95, 0x99, 0xa8,
+	0xdd, 0xee, 0x16, 0x94, 0xb4, 0x9f, 0xac, 0x75, 0xdb, 0x13, 0x44, 0x3c, 0x63, 0x8a, 0xba, 0x06,
+	0x77, 0x7e, 0x84, 0xfa, 0xbe, 0x69, 0x51, 0x3c, 0xe2, 0x04, 0x16, 0xb1, 0x47, 0x4c, 0x1c, 0x8b,
+	0xdc, 0x1e, 0xd2, 0x20, 0x4c, 0x74, 0x8b, 0x7b, 0xb9, 0x13, 0x5e, 0xb7, 0x3a, 0x6c, 0x9e, 0x2e,
+	0x54, 0x23, 0xca, 0x07, 0x29, 0x1d, 0x8c, 0xce, 0x78, 0x26, 0x3b, 0x2f, 0x60, 0x75, 0x9f, 0x29,
+	0xc5, 0xe4, 0xe3, 0x30, 0x51, 0x32, 0x3c, 0x4a, 0x55, 0xf8, 0x41, 0xce, 0x9c, 0x65, 0x68, 0x62,
+	0xc8, 0x34, 0xe3, 0xe2, 0xf7, 0x02, 0x2c, 0x65, 0x1a, 0x9b, 0xff, 0x7d, 0x28, 0xe3, 0xc9, 0xa3,
+	0x96, 0x80, 0xcb, 0x93, 0x67, 0x37, 0x97, 0xb2, 0x6b, 0x0d, 0xc9, 0x6b, 0x58, 0x89, 0x30, 0x4e,
+	0x2f, 0xc8, 0x05, 0x9a, 0x74, 0x16, 0x70, 0x83, 0x4f, 0xa6, 0x37, 0x98, 0x95, 0x91, 0x7b, 0x29,
+	0x3a, 0xa7, 0x4f, 0x1c, 0x0a, 0xed, 0x1d, 0xce, 0x85, 0xa2, 0x5a, 0x7c, 0x57, 0x5f, 0xe8, 0xfe,
+	0x62, 0xc7, 0xba, 0x11, 0x43, 0x1e, 0xb0, 0x53, 0xcc, 0xbf, 0xe4, 0x02, 0xaa, 0xf6, 0xb4, 0x06,
+	0x59, 0x13, 0x2a, 0xe3, 0x19, 0xbf, 0x9d, 0x0d, 0x20, 0x79, 0x17, 0x96, 0x84, 0xcc, 0xb2, 0x90,
+	0xb3, 0xfc, 0x73, 0x01, 0x5a, 0x2f, 0x69, 0xa2, 0xf2, 0xcd, 0xa4, 0xcb, 0xa7, 0x27, 0x79, 0xae,
+	0x18, 0x23, 0x59, 0x63, 0x62, 0x38, 0x14, 0x9c, 0x71, 0x95, 0xcd, 0xf6, 0x4c, 0xce, 0x8f, 0xbb,
+	0xe2, 0xe4, 0xb8, 0x5b, 0x83, 0xba, 0x9d, 0x6f, 0xde, 0x5b, 0x76, 0x66, 0xc7, 0x19, 0x58, 0xd5,
+	0xb7, 0xec, 0x8c, 0xbc, 0x84, 0xa6, 0x9d, 0x17, 0xfd, 0x30, 0x52, 0x4c, 0xe2, 0x08, 0x5b, 0xda,
+	0xbe, 0x3b, 0x35, 0x63, 0xa7, 0x22, 0xb5, 0x73, 0xe4, 0x29, 0x2e, 0x71, 0x1b, 0x32, 0x27, 0xe9,
+	0x0b, 0x81, 0xf6, 0xf5, 0x4e, 0x7a, 0xbe, 0x15, 0x5d, 0x23, 0xe8, 0xeb, 0xe3, 0x88, 0xf5, 0x85,
+	0x34, 0xe3, 0xac, 0xe8, 0x5a, 0x49, 0x5b, 0x47, 0x61, 0x1c, 0x9a, 0x8b, 0xa0, 0xe4, 0x1a, 0x41,
+	0x5b, 0x8b, 0x7e, 0x3f, 0x61, 0x0a, 0x27, 0x52, 0xc9, 0xb5, 0x92, 0xb3, 0x09, 0x8d, 0xbc, 0x67,
+	0x52, 0x81, 0xe2, 0xce, 0xf3, 0xef, 0x5b, 0xff, 0x23, 0x35, 0x28, 0xb9, 0x3b, 0x87, 0x4f, 0x1e,
+	0xb7, 0x0a, 0x04, 0xa0, 0xbc, 0xbb, 0x73, 0xf0, 0x6a, 0x67, 0xbf, 0xb5, 0xe0, 0xfc, 0xbd, 0x00,
+	0xd5, 0x2c, 0xf6, 0xf9, 0xa5, 0xbe, 0x88, 0xda, 0x15, 0x28, 0x25, 0xbe, 0x0e, 0xbb, 0x68, 0xe2,
+	0x43, 0x81, 0xdc, 0x84, 0xa5, 0xcc, 0xc2, 0x33, 0xf0, 0x22, 0xc2, 0xcd, 0x4c, 0x7b, 0x80, 0x66,
+	0x2d, 0x28, 0x9e, 0x08, 0x8e, 0x94, 0x56, 0x5d, 0xfd, 0xa9, 0x5b, 0x21, 0x12, 0x89, 0x42, 0x6e,
+	0xaa, 0x2e, 0x7e, 0xcf, 0x1a, 0x9b, 0x95, 0xf7, 0x19, 0x9b, 0xb9, 0xea, 0x57, 0x2f, 0xac, 0x7e,
+	0xed, 0x5c, 0xf5, 0x57, 0xa0, 0x24, 0xa9, 0x62, 0x01, 0x0e, 0xf5, 0xaa, 0x6b, 0x04, 0xf2, 0xf1,
+	0xa8, 0x27, 0xfc, 0x37, 0x94, 0x0f, 0x18, 0x4e, 0xf1, 0x52, 0x56, 0xe6, 0x5d, 0xd4, 0x91, 0xcb,
+	0x50, 0x65, 0x3c, 0x60, 0x81, 0x47, 0x15, 0xce, 0xe8, 0xa2, 0x5b, 0x41, 0x79, 0x47, 0x39, 0xaf,
+	0xa1, 0x9d, 0x6b, 0x18, 0x7b, 0x08, 0xee, 0x4d, 0x4e, 0xc2, 0xd5, 0xd9, 0x0d, 0x66, 0xc7, 0xa1,
+	0x0e, 0x4c, 0x09, 0x45, 0x23, 0x7b, 0xee, 0x8c, 0xe0, 0xfc, 0x56, 0x80, 0xf6, 0xd7, 0x8c, 0x06,
+	0x87, 0x42, 0xff, 0xfd, 0xd0, 0x53, 0x33, 0xc5, 0x4e, 0xf1, 0x1c, 0x3b, 0x1b, 0xd0, 0xe2, 0x69,
+	0xec, 0x49, 0xe6, 0xeb, 0x3a, 0x9b, 0xe8, 0x4d, 0x9d, 0x97, 0x78, 0x1a, 0xbb, 0xa8, 0xc6, 0x24,
+	0x9d, 0x5f, 0x17, 0x80, 0xe4, 0x03, 0xb3, 0x39, 0xaf, 0x8c, 0x73, 0xc6, 0x2c, 0x4c, 0x6e, 0x04,
+	0x16, 0x4f, 0x42, 0x1c, 0x68, 0x5a, 0x89, 0xdf, 0xba, 0xe1, 0x23, 0x91, 0x24, 0x2c, 0xb1, 0x7d,
+	0x66, 0x25, 0xbd, 0x43, 0x20, 0xe9, 0x49, 0xe6, 0xd7, 0x08, 0x7a, 0x38, 0x23, 0x21, 0x5e, 0x32,
+	0x94, 0x8c, 0x06, 0xf6, 0xd9, 0x51, 0x47, 0xdd, 0x01, 0xaa, 0x74, 0x87, 0xd2, 0x63, 0x26, 0xe9,
+	0x80, 0x65, 0x46, 0xba, 0xe5, 0x0a, 0x6e, 0xd3, 0x6a, 0xad, 0xd9, 0x97, 0xd0, 0x98, 0x48, 0xaf,
+	0x72, 0x61, 0x71, 0xea, 0x72, 0x9c, 0xb3, 0x7e, 0xe2, 0x25, 0x8a, 0xaa, 0xc4, 0xfb, 0x29, 0x19,
+	0x75, 0x5e, 0x0d, 0x35, 0xdf, 0x24, 0x82, 0x3b, 0xbf, 0x40, 0x6b, 0x0f, 0x2f, 0xe3, 0xdc, 0x25,
+	0x7c, 0xee, 0x72, 0xbd, 0xe0, 0xa1, 0x76, 0xfe, 0x1d, 0x54, 0x7c, 0x8f, 0x77, 0x90, 0x73, 0x0f,
+	0xda, 0x39, 0xf7, 0xb6, 0x1e, 0xf3, 0x26, 0xc0, 0xf6, 0x3f, 0x45, 0xb8, 0x94, 0xdd, 0xc8, 0x01,
+	0x55, 0xf4, 0xc0, 0x64, 0x4e, 0xf6, 0xa1, 0xde, 0x63, 0x2a, 0xd3, 0x92, 0x6b, 0x93, 0xbc, 0x4c,
+	0xbd, 0xdf, 0xbb, 0xd7, 0xe7, 0xc1, 0xd6, 0xfd, 0x43, 0x28, 0xf7, 0x98, 0x0e, 0x88, 0x4c, 0x3d,
+	0x61, 0xc7, 0x14, 0x75, 0x2f, 0xcf, 0x40, 0xec, 0xf2, 0x18, 0x56, 0x7b, 0x4c, 0xcd, 0x78, 0x6d,
+	0x90, 0x8d, 0xc9, 0x45, 0xf3, 0x9f, 0x2b, 0xdd, 0xdb, 0xff, 0xc1, 0xd2, 0xba, 0x7b, 0x0a, 0xb5,
+	0x1e, 0x53, 0xe6, 0x3e, 0x27, 0x57, 0x66, 0xdc, 0xdb, 0xd9, 0xbd, 0xdf, 0xbd, 0x3a, 0x1b, 0xb4,
+	0xfb, 0xbc, 0x80, 0x46, 0x8f, 0xa9, 0xd1, 0x40, 0x20, 0xd7, 0x2f, 0xbe, 0x5a, 0xba, 0x6b, 0x73,
+	0x71, 0xbb, 0xa1, 0x0b, 0xcd, 0x1e, 0x53, 0xe3, 0xe3, 0x46, 0xa6, 0x56, 0x9c, 0x9b, 0x10, 0xdd,
+	0xf5, 0xf9, 0x06, 0x66, 0xcf, 0xed, 0x7e, 0xfe, 0x6d, 0x90, 0x55, 0xff, 0x3b, 0x68, 0x58, 0x25,
+	0x3b, 0x4c, 0x25, 0x9f, 0xf6, 0x73, 0xee, 0x31, 0xd1, 0x5d, 0x9f, 0x6f, 0x60, 0xfd, 0x50, 0x68,
+	0x63, 0x5b, 0x60, 0x6b, 0x8e, 0xbb, 0xac, 0x36, 0xea, 0xd5, 0x69, 0x7a, 0xa6, 0xcf, 0x50, 0x77,
+	0x6d, 0x2e, 0x6e, 0x5c, 0x3c, 0xfa, 0xe2, 0x87, 0xcf, 0x07, 0xa1, 0x7a, 0x93, 0x1e, 0x6d, 0xfa,
+	0x22, 0xde, 0x0a, 0x44, 0x1c, 0x72, 0x71, 0xff, 0xc1, 0x96, 0xbd, 0x4a, 0xb6, 0xe4, 0xd0, 0xdf,
+	0x9a, 0xfd, 0xfb, 0xf7, 0xa8, 0x8c, 0xba, 0xcf, 0xfe, 0x1d, 0x00, 0x3b, 0x90, 0xe7, 0x8b, 0x20,
+	0x0f, 0x00, 0x00,
 }
@@ -185,27 +185,224 @@ func (x *InitRealmInfo) GetUserId() string {
 	return ""
 }
 
+// A GameHandoff is sent by an API node that's shutting down, with the games
+// it owned, so that another node can take them over.
+type GameHandoff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameIds []string `protobuf:"bytes,1,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`
+}
+
+func (x *GameHandoff) Reset() {
+	*x = GameHandoff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_ipc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameHandoff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameHandoff) ProtoMessage() {}
+
+func (x *GameHandoff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_ipc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameHandoff.ProtoReflect.Descriptor instead.
+func (*GameHandoff) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_ipc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameHandoff) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+// A BotGameRequest starts a game between two bots, for soak testing or for
+// exhibition games. Each bot is given by username; if either is left blank,
+// a random bot of the given tier (or any tier, if 0) plays instead. The game
+// is always casual.
+type BotGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameRequest *GameRequest `protobuf:"bytes,1,opt,name=game_request,json=gameRequest,proto3" json:"game_request,omitempty"`
+	Bot1        string       `protobuf:"bytes,2,opt,name=bot1,proto3" json:"bot1,omitempty"`
+	Bot2        string       `protobuf:"bytes,3,opt,name=bot2,proto3" json:"bot2,omitempty"`
+	BotTier     int32        `protobuf:"varint,4,opt,name=bot_tier,json=botTier,proto3" json:"bot_tier,omitempty"`
+}
+
+func (x *BotGameRequest) Reset() {
+	*x = BotGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_ipc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BotGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotGameRequest) ProtoMessage() {}
+
+func (x *BotGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_ipc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotGameRequest.ProtoReflect.Descriptor instead.
+func (*BotGameRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_ipc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BotGameRequest) GetGameRequest() *GameRequest {
+	if x != nil {
+		return x.GameRequest
+	}
+	return nil
+}
+
+func (x *BotGameRequest) GetBot1() string {
+	if x != nil {
+		return x.Bot1
+	}
+	return ""
+}
+
+func (x *BotGameRequest) GetBot2() string {
+	if x != nil {
+		return x.Bot2
+	}
+	return ""
+}
+
+func (x *BotGameRequest) GetBotTier() int32 {
+	if x != nil {
+		return x.BotTier
+	}
+	return 0
+}
+
+type BotGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BotGameResponse) Reset() {
+	*x = BotGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_ipc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BotGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotGameResponse) ProtoMessage() {}
+
+func (x *BotGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_ipc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotGameResponse.ProtoReflect.Descriptor instead.
+func (*BotGameResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_ipc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BotGameResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *BotGameResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_api_proto_realtime_ipc_proto protoreflect.FileDescriptor
 
 var file_api_proto_realtime_ipc_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x61, 0x6c,
 	0x74, 0x69, 0x6d, 0x65, 0x2f, 0x69, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2d,
-	0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x3e, 0x0a,
-	0x0d, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x34, 0x5a,
-	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69,
-	0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72, 0x70, 0x63,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x61, 0x6c, 0x74,
-	0x69, 0x6d, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x21, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x72, 0x65, 0x61, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x14, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x22, 0x3e, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x28, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x42,
+	0x6f, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x74, 0x31, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x74, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
+	0x74, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x74, 0x32, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x62, 0x6f, 0x74, 0x54, 0x69, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x0f, 0x42, 0x6f, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x34, 0x5a, 0x32, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f,
+	0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -220,18 +417,23 @@ func file_api_proto_realtime_ipc_proto_rawDescGZIP() []byte {
 	return file_api_proto_realtime_ipc_proto_rawDescData
 }
 
-var file_api_proto_realtime_ipc_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_proto_realtime_ipc_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_api_proto_realtime_ipc_proto_goTypes = []interface{}{
 	(*RegisterRealmRequest)(nil),  // 0: liwords.RegisterRealmRequest
 	(*RegisterRealmResponse)(nil), // 1: liwords.RegisterRealmResponse
 	(*InitRealmInfo)(nil),         // 2: liwords.InitRealmInfo
+	(*GameHandoff)(nil),           // 3: liwords.GameHandoff
+	(*BotGameRequest)(nil),        // 4: liwords.BotGameRequest
+	(*BotGameResponse)(nil),       // 5: liwords.BotGameResponse
+	(*GameRequest)(nil),           // 6: liwords.GameRequest
 }
 var file_api_proto_realtime_ipc_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6, // 0: liwords.BotGameRequest.game_request:type_name -> liwords.GameRequest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_realtime_ipc_proto_init() }
@@ -239,6 +441,7 @@ func file_api_proto_realtime_ipc_proto_init() {
 	if File_api_proto_realtime_ipc_proto != nil {
 		return
 	}
+	file_api_proto_realtime_realtime_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_api_proto_realtime_ipc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterRealmRequest); i {
@@ -276,6 +479,42 @@ func file_api_proto_realtime_ipc_proto_init() {
 				return nil
 			}
 		}
+		file_api_proto_realtime_ipc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHandoff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_ipc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BotGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_ipc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BotGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -283,7 +522,7 @@ func file_api_proto_realtime_ipc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_realtime_ipc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -148,6 +148,15 @@ const (
 	MessageType_USER_PRESENCES                MessageType = 23
 	MessageType_SERVER_MESSAGE                MessageType = 24
 	MessageType_READY_FOR_GAME                MessageType = 25
+	MessageType_QUICK_PAIR_REQUEST            MessageType = 26
+	MessageType_QUICK_PAIR_CANCEL             MessageType = 27
+	MessageType_QUICK_PAIR_POOLS              MessageType = 28
+	MessageType_ADJOURN_OFFER                 MessageType = 29
+	MessageType_ADJOURN_RESPONSE              MessageType = 30
+	MessageType_GAME_ADJOURNED                MessageType = 31
+	MessageType_RESUME_OFFER                  MessageType = 32
+	MessageType_TAKEBACK_REQUEST              MessageType = 33
+	MessageType_TAKEBACK_RESPONSE             MessageType = 34
 )
 
 // Enum value maps for MessageType.
@@ -176,6 +185,15 @@ var (
 		23: "USER_PRESENCES",
 		24: "SERVER_MESSAGE",
 		25: "READY_FOR_GAME",
+		26: "QUICK_PAIR_REQUEST",
+		27: "QUICK_PAIR_CANCEL",
+		28: "QUICK_PAIR_POOLS",
+		29: "ADJOURN_OFFER",
+		30: "ADJOURN_RESPONSE",
+		31: "GAME_ADJOURNED",
+		32: "RESUME_OFFER",
+		33: "TAKEBACK_REQUEST",
+		34: "TAKEBACK_RESPONSE",
 	}
 	MessageType_value = map[string]int32{
 		"SEEK_REQUEST":                  0,
@@ -201,6 +219,15 @@ var (
 		"USER_PRESENCES":                23,
 		"SERVER_MESSAGE":                24,
 		"READY_FOR_GAME":                25,
+		"QUICK_PAIR_REQUEST":            26,
+		"QUICK_PAIR_CANCEL":             27,
+		"QUICK_PAIR_POOLS":              28,
+		"ADJOURN_OFFER":                 29,
+		"ADJOURN_RESPONSE":              30,
+		"GAME_ADJOURNED":                31,
+		"RESUME_OFFER":                  32,
+		"TAKEBACK_REQUEST":              33,
+		"TAKEBACK_RESPONSE":             34,
 	}
 )
 
@@ -246,6 +273,9 @@ const (
 	GameEndReason_RESIGNED           GameEndReason = 4
 	GameEndReason_ABANDONED          GameEndReason = 5
 	GameEndReason_TRIPLE_CHALLENGE   GameEndReason = 6
+	// ABORTED: the game was called off before both players had moved, either
+	// by one of them or because they never both showed up. It is not rated.
+	GameEndReason_ABORTED GameEndReason = 7
 )
 
 // Enum value maps for GameEndReason.
@@ -258,6 +288,7 @@ var (
 		4: "RESIGNED",
 		5: "ABANDONED",
 		6: "TRIPLE_CHALLENGE",
+		7: "ABORTED",
 	}
 	GameEndReason_value = map[string]int32{
 		"NONE":               0,
@@ -267,6 +298,7 @@ var (
 		"RESIGNED":           4,
 		"ABANDONED":          5,
 		"TRIPLE_CHALLENGE":   6,
+		"ABORTED":            7,
 	}
 )
 
@@ -305,6 +337,8 @@ const (
 	ClientGameplayEvent_EXCHANGE       ClientGameplayEvent_EventType = 2
 	ClientGameplayEvent_CHALLENGE_PLAY ClientGameplayEvent_EventType = 3
 	ClientGameplayEvent_RESIGN         ClientGameplayEvent_EventType = 4
+	// ABORT can be sent by either player until both have made a move.
+	ClientGameplayEvent_ABORT ClientGameplayEvent_EventType = 5
 )
 
 // Enum value maps for ClientGameplayEvent_EventType.
@@ -315,6 +349,7 @@ var (
 		2: "EXCHANGE",
 		3: "CHALLENGE_PLAY",
 		4: "RESIGN",
+		5: "ABORT",
 	}
 	ClientGameplayEvent_EventType_value = map[string]int32{
 		"TILE_PLACEMENT": 0,
@@ -322,6 +357,7 @@ var (
 		"EXCHANGE":       2,
 		"CHALLENGE_PLAY": 3,
 		"RESIGN":         4,
+		"ABORT":          5,
 	}
 )
 
@@ -349,7 +385,7 @@ func (x ClientGameplayEvent_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ClientGameplayEvent_EventType.Descriptor instead.
 func (ClientGameplayEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{23, 0}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{32, 0}
 }
 
 // A GameRules is just the name of a board layout + the name of a letter
@@ -434,6 +470,11 @@ type GameRequest struct {
 	RequestId          string                `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	MaxOvertimeMinutes int32                 `protobuf:"varint,9,opt,name=max_overtime_minutes,json=maxOvertimeMinutes,proto3" json:"max_overtime_minutes,omitempty"`
 	PlayerVsBot        bool                  `protobuf:"varint,10,opt,name=player_vs_bot,json=playerVsBot,proto3" json:"player_vs_bot,omitempty"`
+	// tournament_id is only set for games that are part of a tournament.
+	TournamentId string `protobuf:"bytes,11,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// imported_by is only set for games that were imported from a GCG. It's
+	// the ID of the user who imported the game.
+	ImportedBy string `protobuf:"bytes,12,opt,name=imported_by,json=importedBy,proto3" json:"imported_by,omitempty"`
 }
 
 func (x *GameRequest) Reset() {
@@ -538,6 +579,20 @@ func (x *GameRequest) GetPlayerVsBot() bool {
 	return false
 }
 
+func (x *GameRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GameRequest) GetImportedBy() string {
+	if x != nil {
+		return x.ImportedBy
+	}
+	return ""
+}
+
 // A MatchUser requests or receives a match via a seek or a match request.
 type MatchUser struct {
 	state         protoimpl.MessageState
@@ -1056,6 +1111,10 @@ type MatchRequest struct {
 	RematchFor string `protobuf:"bytes,4,opt,name=rematch_for,json=rematchFor,proto3" json:"rematch_for,omitempty"`
 	// connection_id is the websocket ID via which this game was requested.
 	ConnectionId string `protobuf:"bytes,5,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// In a bot game, the receiving_user's display_name picks a specific bot.
+	// Otherwise, bot_tier picks a random bot of that difficulty tier; 0 means
+	// any bot.
+	BotTier int32 `protobuf:"varint,6,opt,name=bot_tier,json=botTier,proto3" json:"bot_tier,omitempty"`
 }
 
 func (x *MatchRequest) Reset() {
@@ -1125,6 +1184,13 @@ func (x *MatchRequest) GetConnectionId() string {
 	return ""
 }
 
+func (x *MatchRequest) GetBotTier() int32 {
+	if x != nil {
+		return x.BotTier
+	}
+	return 0
+}
+
 type ReadyForGame struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1172,6 +1238,375 @@ func (x *ReadyForGame) GetGameId() string {
 	return ""
 }
 
+// A QuickPairRequest puts the user in the quick-pair pool for games of the
+// given shape. The server pairs everyone in the pool every few seconds.
+type QuickPairRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameRequest *GameRequest `protobuf:"bytes,1,opt,name=game_request,json=gameRequest,proto3" json:"game_request,omitempty"`
+	// The ratings are those the user would prefer their opponent to have;
+	// they're a preference and not a hard limit. Zero means no limit.
+	MinimumRating int32 `protobuf:"varint,2,opt,name=minimum_rating,json=minimumRating,proto3" json:"minimum_rating,omitempty"`
+	MaximumRating int32 `protobuf:"varint,3,opt,name=maximum_rating,json=maximumRating,proto3" json:"maximum_rating,omitempty"`
+}
+
+func (x *QuickPairRequest) Reset() {
+	*x = QuickPairRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuickPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickPairRequest) ProtoMessage() {}
+
+func (x *QuickPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickPairRequest.ProtoReflect.Descriptor instead.
+func (*QuickPairRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QuickPairRequest) GetGameRequest() *GameRequest {
+	if x != nil {
+		return x.GameRequest
+	}
+	return nil
+}
+
+func (x *QuickPairRequest) GetMinimumRating() int32 {
+	if x != nil {
+		return x.MinimumRating
+	}
+	return 0
+}
+
+func (x *QuickPairRequest) GetMaximumRating() int32 {
+	if x != nil {
+		return x.MaximumRating
+	}
+	return 0
+}
+
+// A QuickPairCancel takes the user out of the quick-pair pool.
+type QuickPairCancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QuickPairCancel) Reset() {
+	*x = QuickPairCancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuickPairCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickPairCancel) ProtoMessage() {}
+
+func (x *QuickPairCancel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickPairCancel.ProtoReflect.Descriptor instead.
+func (*QuickPairCancel) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{13}
+}
+
+// An AdjournOffer proposes to pause a live game, freezing both clocks. It is
+// sent from a player to the server, which passes it on to their opponent.
+type AdjournOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *AdjournOffer) Reset() {
+	*x = AdjournOffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjournOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjournOffer) ProtoMessage() {}
+
+func (x *AdjournOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjournOffer.ProtoReflect.Descriptor instead.
+func (*AdjournOffer) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AdjournOffer) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// An AdjournResponse accepts or declines the opponent's AdjournOffer. A
+// declined offer is passed back to the player who made it.
+type AdjournResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Accept bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *AdjournResponse) Reset() {
+	*x = AdjournResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjournResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjournResponse) ProtoMessage() {}
+
+func (x *AdjournResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjournResponse.ProtoReflect.Descriptor instead.
+func (*AdjournResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AdjournResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AdjournResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+// A ResumeOffer proposes to resume an adjourned game. It is sent from a
+// player to the server, which passes it on to their opponent. The clocks
+// start again once both players have sent a ReadyForGame.
+type ResumeOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *ResumeOffer) Reset() {
+	*x = ResumeOffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeOffer) ProtoMessage() {}
+
+func (x *ResumeOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeOffer.ProtoReflect.Descriptor instead.
+func (*ResumeOffer) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResumeOffer) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// A TakebackRequest asks to take back the sender's last turn, in a casual or
+// bot game. It is passed on to the opponent, unless it's a bot, which always
+// accepts. Once accepted, a fresh GameHistoryRefresher is sent out.
+type TakebackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *TakebackRequest) Reset() {
+	*x = TakebackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TakebackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakebackRequest) ProtoMessage() {}
+
+func (x *TakebackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakebackRequest.ProtoReflect.Descriptor instead.
+func (*TakebackRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TakebackRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// A TakebackResponse accepts or declines the opponent's TakebackRequest. A
+// declined request is passed back to the player who made it.
+type TakebackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Accept bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *TakebackResponse) Reset() {
+	*x = TakebackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TakebackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakebackResponse) ProtoMessage() {}
+
+func (x *TakebackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakebackResponse.ProtoReflect.Descriptor instead.
+func (*TakebackResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TakebackResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *TakebackResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
 // A SoughtGameProcessEvent gets sent when a match request (or seek request)
 // get accepted (from client to server), or canceled -- when sent from server to
 // client.
@@ -1186,7 +1621,7 @@ type SoughtGameProcessEvent struct {
 func (x *SoughtGameProcessEvent) Reset() {
 	*x = SoughtGameProcessEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[12]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1199,7 +1634,7 @@ func (x *SoughtGameProcessEvent) String() string {
 func (*SoughtGameProcessEvent) ProtoMessage() {}
 
 func (x *SoughtGameProcessEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[12]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,7 +1647,7 @@ func (x *SoughtGameProcessEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SoughtGameProcessEvent.ProtoReflect.Descriptor instead.
 func (*SoughtGameProcessEvent) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SoughtGameProcessEvent) GetRequestId() string {
@@ -1234,7 +1669,7 @@ type SeekRequests struct {
 func (x *SeekRequests) Reset() {
 	*x = SeekRequests{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[13]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1247,7 +1682,7 @@ func (x *SeekRequests) String() string {
 func (*SeekRequests) ProtoMessage() {}
 
 func (x *SeekRequests) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[13]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,7 +1695,7 @@ func (x *SeekRequests) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeekRequests.ProtoReflect.Descriptor instead.
 func (*SeekRequests) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SeekRequests) GetRequests() []*SeekRequest {
@@ -1281,7 +1716,7 @@ type MatchRequests struct {
 func (x *MatchRequests) Reset() {
 	*x = MatchRequests{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[14]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1294,7 +1729,7 @@ func (x *MatchRequests) String() string {
 func (*MatchRequests) ProtoMessage() {}
 
 func (x *MatchRequests) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[14]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,7 +1742,7 @@ func (x *MatchRequests) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchRequests.ProtoReflect.Descriptor instead.
 func (*MatchRequests) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{14}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MatchRequests) GetRequests() []*MatchRequest {
@@ -1328,7 +1763,7 @@ type ActiveGames struct {
 func (x *ActiveGames) Reset() {
 	*x = ActiveGames{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[15]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1341,7 +1776,7 @@ func (x *ActiveGames) String() string {
 func (*ActiveGames) ProtoMessage() {}
 
 func (x *ActiveGames) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[15]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1349,19 +1784,133 @@ func (x *ActiveGames) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveGames.ProtoReflect.Descriptor instead.
+func (*ActiveGames) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ActiveGames) GetGames() []*GameMeta {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+// QuickPairPools sends the number of users waiting in every quick-pair pool.
+type QuickPairPools struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pools []*QuickPairPools_Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (x *QuickPairPools) Reset() {
+	*x = QuickPairPools{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuickPairPools) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickPairPools) ProtoMessage() {}
+
+func (x *QuickPairPools) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickPairPools.ProtoReflect.Descriptor instead.
+func (*QuickPairPools) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QuickPairPools) GetPools() []*QuickPairPools_Pool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+// GameAdjourned is sent to everyone in a game when it gets adjourned. The
+// times are those left on each player's frozen clock, in milliseconds, in
+// the same order as in the GameHistoryRefresher.
+type GameAdjourned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId      string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TimePlayer1 int32  `protobuf:"varint,2,opt,name=time_player1,json=timePlayer1,proto3" json:"time_player1,omitempty"`
+	TimePlayer2 int32  `protobuf:"varint,3,opt,name=time_player2,json=timePlayer2,proto3" json:"time_player2,omitempty"`
+}
+
+func (x *GameAdjourned) Reset() {
+	*x = GameAdjourned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameAdjourned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameAdjourned) ProtoMessage() {}
+
+func (x *GameAdjourned) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameAdjourned.ProtoReflect.Descriptor instead.
+func (*GameAdjourned) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GameAdjourned) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
 }
 
-// Deprecated: Use ActiveGames.ProtoReflect.Descriptor instead.
-func (*ActiveGames) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{15}
+func (x *GameAdjourned) GetTimePlayer1() int32 {
+	if x != nil {
+		return x.TimePlayer1
+	}
+	return 0
 }
 
-func (x *ActiveGames) GetGames() []*GameMeta {
+func (x *GameAdjourned) GetTimePlayer2() int32 {
 	if x != nil {
-		return x.Games
+		return x.TimePlayer2
 	}
-	return nil
+	return 0
 }
 
 // The server will send back a ServerGameplayEvent to a ClientGameplayEvent.
@@ -1383,7 +1932,7 @@ type ServerGameplayEvent struct {
 func (x *ServerGameplayEvent) Reset() {
 	*x = ServerGameplayEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[16]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1396,7 +1945,7 @@ func (x *ServerGameplayEvent) String() string {
 func (*ServerGameplayEvent) ProtoMessage() {}
 
 func (x *ServerGameplayEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[16]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,7 +1958,7 @@ func (x *ServerGameplayEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerGameplayEvent.ProtoReflect.Descriptor instead.
 func (*ServerGameplayEvent) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{16}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ServerGameplayEvent) GetEvent() *macondo.GameEvent {
@@ -1475,7 +2024,7 @@ type ServerChallengeResultEvent struct {
 func (x *ServerChallengeResultEvent) Reset() {
 	*x = ServerChallengeResultEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[17]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1488,7 +2037,7 @@ func (x *ServerChallengeResultEvent) String() string {
 func (*ServerChallengeResultEvent) ProtoMessage() {}
 
 func (x *ServerChallengeResultEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[17]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,7 +2050,7 @@ func (x *ServerChallengeResultEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerChallengeResultEvent.ProtoReflect.Descriptor instead.
 func (*ServerChallengeResultEvent) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{17}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ServerChallengeResultEvent) GetValid() bool {
@@ -1541,13 +2090,17 @@ type GameEndedEvent struct {
 	// If it was a tie, the winner and loser above can be interchanged.
 	Tie bool `protobuf:"varint,6,opt,name=tie,proto3" json:"tie,omitempty"`
 	// Time that the game ended
-	Time int64 `protobuf:"varint,7,opt,name=time,proto3" json:"time,omitempty"`
+	Time   int64  `protobuf:"varint,7,opt,name=time,proto3" json:"time,omitempty"`
+	GameId string `protobuf:"bytes,8,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// tournament_id is set if this game was part of a tournament.
+	TournamentId        string           `protobuf:"bytes,9,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	NewRatingDeviations map[string]int32 `protobuf:"bytes,10,rep,name=new_rating_deviations,json=newRatingDeviations,proto3" json:"new_rating_deviations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *GameEndedEvent) Reset() {
 	*x = GameEndedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[18]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1560,7 +2113,7 @@ func (x *GameEndedEvent) String() string {
 func (*GameEndedEvent) ProtoMessage() {}
 
 func (x *GameEndedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[18]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,7 +2126,7 @@ func (x *GameEndedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameEndedEvent.ProtoReflect.Descriptor instead.
 func (*GameEndedEvent) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{18}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GameEndedEvent) GetScores() map[string]int32 {
@@ -1625,6 +2178,27 @@ func (x *GameEndedEvent) GetTime() int64 {
 	return 0
 }
 
+func (x *GameEndedEvent) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameEndedEvent) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GameEndedEvent) GetNewRatingDeviations() map[string]int32 {
+	if x != nil {
+		return x.NewRatingDeviations
+	}
+	return nil
+}
+
 // A GameHistoryRefresher is sent to both players when the game starts,
 // and any observers at the time that they begin observing. It can also be sent
 // to a player who reconnects in the middle of a game.
@@ -1656,7 +2230,7 @@ type GameHistoryRefresher struct {
 func (x *GameHistoryRefresher) Reset() {
 	*x = GameHistoryRefresher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[19]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1669,7 +2243,7 @@ func (x *GameHistoryRefresher) String() string {
 func (*GameHistoryRefresher) ProtoMessage() {}
 
 func (x *GameHistoryRefresher) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[19]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +2256,7 @@ func (x *GameHistoryRefresher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameHistoryRefresher.ProtoReflect.Descriptor instead.
 func (*GameHistoryRefresher) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{19}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GameHistoryRefresher) GetHistory() *macondo.GameHistory {
@@ -1729,7 +2303,7 @@ type NewGameEvent struct {
 func (x *NewGameEvent) Reset() {
 	*x = NewGameEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[20]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1742,7 +2316,7 @@ func (x *NewGameEvent) String() string {
 func (*NewGameEvent) ProtoMessage() {}
 
 func (x *NewGameEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[20]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,7 +2329,7 @@ func (x *NewGameEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewGameEvent.ProtoReflect.Descriptor instead.
 func (*NewGameEvent) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{20}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *NewGameEvent) GetGameId() string {
@@ -1790,7 +2364,7 @@ type ErrorMessage struct {
 func (x *ErrorMessage) Reset() {
 	*x = ErrorMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[21]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1803,7 +2377,7 @@ func (x *ErrorMessage) String() string {
 func (*ErrorMessage) ProtoMessage() {}
 
 func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[21]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +2390,7 @@ func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
 func (*ErrorMessage) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{21}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ErrorMessage) GetMessage() string {
@@ -1837,7 +2411,7 @@ type ServerMessage struct {
 func (x *ServerMessage) Reset() {
 	*x = ServerMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[22]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1850,7 +2424,7 @@ func (x *ServerMessage) String() string {
 func (*ServerMessage) ProtoMessage() {}
 
 func (x *ServerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[22]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1863,7 +2437,7 @@ func (x *ServerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerMessage.ProtoReflect.Descriptor instead.
 func (*ServerMessage) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{22}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ServerMessage) GetMessage() string {
@@ -1892,7 +2466,7 @@ type ClientGameplayEvent struct {
 func (x *ClientGameplayEvent) Reset() {
 	*x = ClientGameplayEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[23]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1905,7 +2479,7 @@ func (x *ClientGameplayEvent) String() string {
 func (*ClientGameplayEvent) ProtoMessage() {}
 
 func (x *ClientGameplayEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[23]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +2492,7 @@ func (x *ClientGameplayEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientGameplayEvent.ProtoReflect.Descriptor instead.
 func (*ClientGameplayEvent) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ClientGameplayEvent) GetType() ClientGameplayEvent_EventType {
@@ -1961,7 +2535,7 @@ type TimedOut struct {
 func (x *TimedOut) Reset() {
 	*x = TimedOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[24]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1974,7 +2548,7 @@ func (x *TimedOut) String() string {
 func (*TimedOut) ProtoMessage() {}
 
 func (x *TimedOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[24]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1987,7 +2561,7 @@ func (x *TimedOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimedOut.ProtoReflect.Descriptor instead.
 func (*TimedOut) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{24}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *TimedOut) GetGameId() string {
@@ -2015,7 +2589,7 @@ type DeclineMatchRequest struct {
 func (x *DeclineMatchRequest) Reset() {
 	*x = DeclineMatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[25]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2028,7 +2602,7 @@ func (x *DeclineMatchRequest) String() string {
 func (*DeclineMatchRequest) ProtoMessage() {}
 
 func (x *DeclineMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[25]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,7 +2615,7 @@ func (x *DeclineMatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeclineMatchRequest.ProtoReflect.Descriptor instead.
 func (*DeclineMatchRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeclineMatchRequest) GetRequestId() string {
@@ -2064,7 +2638,7 @@ type JoinPath struct {
 func (x *JoinPath) Reset() {
 	*x = JoinPath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[26]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2077,7 +2651,7 @@ func (x *JoinPath) String() string {
 func (*JoinPath) ProtoMessage() {}
 
 func (x *JoinPath) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[26]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2664,7 @@ func (x *JoinPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinPath.ProtoReflect.Descriptor instead.
 func (*JoinPath) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *JoinPath) GetPath() string {
@@ -2109,7 +2683,7 @@ type UnjoinRealm struct {
 func (x *UnjoinRealm) Reset() {
 	*x = UnjoinRealm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[27]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2122,7 +2696,7 @@ func (x *UnjoinRealm) String() string {
 func (*UnjoinRealm) ProtoMessage() {}
 
 func (x *UnjoinRealm) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[27]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2135,7 +2709,7 @@ func (x *UnjoinRealm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnjoinRealm.ProtoReflect.Descriptor instead.
 func (*UnjoinRealm) Descriptor() ([]byte, []int) {
-	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{36}
 }
 
 type GameMeta_UserMeta struct {
@@ -2150,7 +2724,7 @@ type GameMeta_UserMeta struct {
 func (x *GameMeta_UserMeta) Reset() {
 	*x = GameMeta_UserMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_realtime_realtime_proto_msgTypes[28]
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2163,7 +2737,7 @@ func (x *GameMeta_UserMeta) String() string {
 func (*GameMeta_UserMeta) ProtoMessage() {}
 
 func (x *GameMeta_UserMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_realtime_realtime_proto_msgTypes[28]
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2193,6 +2767,61 @@ func (x *GameMeta_UserMeta) GetDisplayName() string {
 	return ""
 }
 
+type QuickPairPools_Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameRequest *GameRequest `protobuf:"bytes,1,opt,name=game_request,json=gameRequest,proto3" json:"game_request,omitempty"`
+	Size        int32        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *QuickPairPools_Pool) Reset() {
+	*x = QuickPairPools_Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_realtime_realtime_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuickPairPools_Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickPairPools_Pool) ProtoMessage() {}
+
+func (x *QuickPairPools_Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_realtime_realtime_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickPairPools_Pool.ProtoReflect.Descriptor instead.
+func (*QuickPairPools_Pool) Descriptor() ([]byte, []int) {
+	return file_api_proto_realtime_realtime_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *QuickPairPools_Pool) GetGameRequest() *GameRequest {
+	if x != nil {
+		return x.GameRequest
+	}
+	return nil
+}
+
+func (x *QuickPairPools_Pool) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
 var File_api_proto_realtime_realtime_proto protoreflect.FileDescriptor
 
 var file_api_proto_realtime_realtime_proto_rawDesc = []byte{
@@ -2210,7 +2839,7 @@ var file_api_proto_realtime_realtime_proto_rawDesc = []byte{
 	0x09, 0x52, 0x16, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
 	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x72,
 	0x69, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xca, 0x03, 0x0a,
+	0x0b, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x90, 0x04, 0x0a,
 	0x0b, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
 	0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
 	0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
@@ -2239,254 +2868,333 @@ var file_api_proto_realtime_realtime_proto_rawDesc = []byte{
 	0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x69,
 	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
 	0x76, 0x73, 0x5f, 0x62, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x56, 0x73, 0x42, 0x6f, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x76,
-	0x61, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f,
-	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0xdd, 0x01, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x37,
-	0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x56, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4d,
-	0x65, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x5f,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
-	0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x1e, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x7b, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x40, 0x0a, 0x0c,
-	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x80,
-	0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75,
-	0x73, 0x22, 0x44, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x70, 0x72,
-	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x65, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x69,
-	0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
-	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0c,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c,
-	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a,
-	0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
-	0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x27,
-	0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x16, 0x53, 0x6f, 0x75, 0x67, 0x68,
-	0x74, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x22, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x65,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x22, 0x42, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xe1,
-	0x01, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61,
-	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x63, 0x6f, 0x6e, 0x64, 0x6f, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77,
-	0x5f, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77,
-	0x52, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6d,
-	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x69,
-	0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x07, 0x70,
-	0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d,
-	0x61, 0x63, 0x6f, 0x6e, 0x64, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x6d, 0x61, 0x63, 0x6f, 0x6e, 0x64, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x9c, 0x03, 0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65, 0x45,
-	0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69,
+	0x61, 0x79, 0x65, 0x72, 0x56, 0x73, 0x42, 0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22,
+	0x93, 0x01, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f,
+	0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x56, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7b, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0x40, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x6d, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xe1, 0x01,
+	0x0a, 0x0b, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x8b, 0x02, 0x0a, 0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b,
+	0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x69, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x6f, 0x74, 0x54, 0x69, 0x65, 0x72, 0x22,
+	0x27, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x69,
+	0x63, 0x6b, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x22, 0x11, 0x0a, 0x0f, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x69,
+	0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0x27, 0x0a, 0x0c, 0x41, 0x64, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x22, 0x42, 0x0a, 0x0f, 0x41, 0x64, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x22, 0x26, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0f,
+	0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x10, 0x54, 0x61, 0x6b, 0x65,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x37, 0x0a,
+	0x16, 0x53, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x2e, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0b,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x67,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x05, 0x67,
+	0x61, 0x6d, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x50, 0x61,
+	0x69, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x2e, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x69, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x2e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x1a, 0x53, 0x0a, 0x04, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x22, 0x6e, 0x0a, 0x0d, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32,
+	0x22, 0xe1, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x63, 0x6f, 0x6e, 0x64,
+	0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x65, 0x77, 0x5f, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x65, 0x77, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x6d, 0x61, 0x63, 0x6f, 0x6e, 0x64, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0e, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x6f, 0x6e, 0x64, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x88, 0x05, 0x0a, 0x0e, 0x47, 0x61, 0x6d,
+	0x65, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69,
 	0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x35, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x03, 0x74, 0x69, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x52, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x72, 0x12, 0x2e,
-	0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x6d, 0x61, 0x63, 0x6f, 0x6e, 0x64, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21,
-	0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x31, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x32, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x76, 0x65, 0x72,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x4d,
-	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x09,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x69, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x64, 0x0a, 0x15, 0x6e,
+	0x65, 0x77, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x69, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6e, 0x65,
+	0x77, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f,
+	0x4e, 0x65, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4e,
+	0x65, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6d, 0x61, 0x63, 0x6f, 0x6e, 0x64, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x32, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x6d, 0x61, 0x78, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x63,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x72, 0x43, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x29, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x13, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x43,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x53, 0x53, 0x10,
+	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x50, 0x4c, 0x41,
+	0x59, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x04, 0x12,
+	0x09, 0x0a, 0x05, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x05, 0x22, 0x3c, 0x0a, 0x08, 0x54, 0x69,
+	0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x43, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x72,
-	0x5f, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x65, 0x72, 0x43, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x29, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x82, 0x02, 0x0a,
-	0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6f, 0x72,
-	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x4c,
-	0x41, 0x43, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x53,
-	0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10,
-	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x50,
-	0x4c, 0x41, 0x59, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x10,
-	0x04, 0x22, 0x3c, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x34, 0x0a, 0x13, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x0d, 0x0a, 0x0b, 0x55, 0x6e, 0x6a, 0x6f, 0x69, 0x6e, 0x52,
-	0x65, 0x61, 0x6c, 0x6d, 0x2a, 0x2d, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x41, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x45, 0x4e, 0x43,
-	0x45, 0x10, 0x01, 0x2a, 0x23, 0x0a, 0x0a, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x43, 0x41, 0x53, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x2a, 0x82, 0x04, 0x0a, 0x0b, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x45, 0x4b,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41,
-	0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a,
-	0x19, 0x53, 0x4f, 0x55, 0x47, 0x48, 0x54, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x52, 0x4f,
-	0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
-	0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x52, 0x56, 0x45,
-	0x52, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x41, 0x4d, 0x45,
-	0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48,
-	0x45, 0x52, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x45,
-	0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x45, 0x57, 0x5f, 0x47,
-	0x41, 0x4d, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x12, 0x21, 0x0a, 0x1d, 0x53,
-	0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f,
-	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x09, 0x12, 0x11,
-	0x0a, 0x0d, 0x53, 0x45, 0x45, 0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x53, 0x10,
-	0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x0d,
-	0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x10, 0x0f, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f,
-	0x47, 0x41, 0x4d, 0x45, 0x53, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x41, 0x4d, 0x45, 0x5f,
-	0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x11, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41,
-	0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x53, 0x10, 0x12, 0x12, 0x19,
-	0x0a, 0x15, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x13, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41,
-	0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x43,
-	0x48, 0x41, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x53, 0x10, 0x15, 0x12, 0x11,
-	0x0a, 0x0d, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x10,
-	0x16, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e,
-	0x43, 0x45, 0x53, 0x10, 0x17, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f,
-	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x18, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41,
-	0x44, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x19, 0x2a, 0x7c, 0x0a,
-	0x0d, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x08,
-	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x49, 0x4d, 0x45,
-	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x02,
-	0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x43, 0x55, 0x54, 0x49, 0x56, 0x45, 0x5f,
-	0x5a, 0x45, 0x52, 0x4f, 0x45, 0x53, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x49,
-	0x47, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f,
-	0x4e, 0x45, 0x44, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x49, 0x50, 0x4c, 0x45, 0x5f,
-	0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x10, 0x06, 0x42, 0x34, 0x5a, 0x32, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f,
-	0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x44, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x1e,
+	0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x0d,
+	0x0a, 0x0b, 0x55, 0x6e, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x2a, 0x2d, 0x0a,
+	0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x41,
+	0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x2a, 0x23, 0x0a, 0x0a,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x53, 0x55, 0x41, 0x4c, 0x10,
+	0x01, 0x2a, 0xc3, 0x05, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x45, 0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x47, 0x48, 0x54,
+	0x5f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f,
+	0x47, 0x41, 0x4d, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x50,
+	0x4c, 0x41, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x47,
+	0x41, 0x4d, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10,
+	0x05, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52,
+	0x59, 0x5f, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x45, 0x52, 0x10, 0x06, 0x12, 0x11, 0x0a,
+	0x0d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x07,
+	0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x45, 0x57, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x10, 0x08, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x43,
+	0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x09, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x45, 0x4b, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x53, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49,
+	0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x0d, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x0f, 0x12, 0x10,
+	0x0a, 0x0c, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x53, 0x10, 0x10,
+	0x12, 0x11, 0x0a, 0x0d, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x11, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x53, 0x10, 0x12, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x43, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x13, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x53, 0x10, 0x15, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x16, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x53, 0x10, 0x17, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x10, 0x18, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x5f,
+	0x47, 0x41, 0x4d, 0x45, 0x10, 0x19, 0x12, 0x16, 0x0a, 0x12, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x5f,
+	0x50, 0x41, 0x49, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x1a, 0x12, 0x15,
+	0x0a, 0x11, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x10, 0x1b, 0x12, 0x14, 0x0a, 0x10, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x5f, 0x50,
+	0x41, 0x49, 0x52, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x53, 0x10, 0x1c, 0x12, 0x11, 0x0a, 0x0d, 0x41,
+	0x44, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x10, 0x1d, 0x12, 0x14,
+	0x0a, 0x10, 0x41, 0x44, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x10, 0x1e, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x44, 0x4a,
+	0x4f, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x10, 0x1f, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x53, 0x55,
+	0x4d, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x10, 0x20, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x41,
+	0x4b, 0x45, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x21,
+	0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x4b, 0x45, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x22, 0x2a, 0x89, 0x01, 0x0a, 0x0d, 0x47, 0x61, 0x6d, 0x65,
+	0x45, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x43,
+	0x4f, 0x4e, 0x53, 0x45, 0x43, 0x55, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x45,
+	0x53, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x05,
+	0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x49, 0x50, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c,
+	0x45, 0x4e, 0x47, 0x45, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45,
+	0x44, 0x10, 0x07, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2502,7 +3210,7 @@ func file_api_proto_realtime_realtime_proto_rawDescGZIP() []byte {
 }
 
 var file_api_proto_realtime_realtime_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_api_proto_realtime_realtime_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_api_proto_realtime_realtime_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_api_proto_realtime_realtime_proto_goTypes = []interface{}{
 	(GameMode)(0),                      // 0: liwords.GameMode
 	(RatingMode)(0),                    // 1: liwords.RatingMode
@@ -2521,36 +3229,47 @@ var file_api_proto_realtime_realtime_proto_goTypes = []interface{}{
 	(*SeekRequest)(nil),                // 14: liwords.SeekRequest
 	(*MatchRequest)(nil),               // 15: liwords.MatchRequest
 	(*ReadyForGame)(nil),               // 16: liwords.ReadyForGame
-	(*SoughtGameProcessEvent)(nil),     // 17: liwords.SoughtGameProcessEvent
-	(*SeekRequests)(nil),               // 18: liwords.SeekRequests
-	(*MatchRequests)(nil),              // 19: liwords.MatchRequests
-	(*ActiveGames)(nil),                // 20: liwords.ActiveGames
-	(*ServerGameplayEvent)(nil),        // 21: liwords.ServerGameplayEvent
-	(*ServerChallengeResultEvent)(nil), // 22: liwords.ServerChallengeResultEvent
-	(*GameEndedEvent)(nil),             // 23: liwords.GameEndedEvent
-	(*GameHistoryRefresher)(nil),       // 24: liwords.GameHistoryRefresher
-	(*NewGameEvent)(nil),               // 25: liwords.NewGameEvent
-	(*ErrorMessage)(nil),               // 26: liwords.ErrorMessage
-	(*ServerMessage)(nil),              // 27: liwords.ServerMessage
-	(*ClientGameplayEvent)(nil),        // 28: liwords.ClientGameplayEvent
-	(*TimedOut)(nil),                   // 29: liwords.TimedOut
-	(*DeclineMatchRequest)(nil),        // 30: liwords.DeclineMatchRequest
-	(*JoinPath)(nil),                   // 31: liwords.JoinPath
-	(*UnjoinRealm)(nil),                // 32: liwords.UnjoinRealm
-	(*GameMeta_UserMeta)(nil),          // 33: liwords.GameMeta.UserMeta
-	nil,                                // 34: liwords.GameEndedEvent.ScoresEntry
-	nil,                                // 35: liwords.GameEndedEvent.NewRatingsEntry
-	(macondo.ChallengeRule)(0),         // 36: macondo.ChallengeRule
-	(*macondo.GameEvent)(nil),          // 37: macondo.GameEvent
-	(macondo.PlayState)(0),             // 38: macondo.PlayState
-	(*macondo.GameHistory)(nil),        // 39: macondo.GameHistory
+	(*QuickPairRequest)(nil),           // 17: liwords.QuickPairRequest
+	(*QuickPairCancel)(nil),            // 18: liwords.QuickPairCancel
+	(*AdjournOffer)(nil),               // 19: liwords.AdjournOffer
+	(*AdjournResponse)(nil),            // 20: liwords.AdjournResponse
+	(*ResumeOffer)(nil),                // 21: liwords.ResumeOffer
+	(*TakebackRequest)(nil),            // 22: liwords.TakebackRequest
+	(*TakebackResponse)(nil),           // 23: liwords.TakebackResponse
+	(*SoughtGameProcessEvent)(nil),     // 24: liwords.SoughtGameProcessEvent
+	(*SeekRequests)(nil),               // 25: liwords.SeekRequests
+	(*MatchRequests)(nil),              // 26: liwords.MatchRequests
+	(*ActiveGames)(nil),                // 27: liwords.ActiveGames
+	(*QuickPairPools)(nil),             // 28: liwords.QuickPairPools
+	(*GameAdjourned)(nil),              // 29: liwords.GameAdjourned
+	(*ServerGameplayEvent)(nil),        // 30: liwords.ServerGameplayEvent
+	(*ServerChallengeResultEvent)(nil), // 31: liwords.ServerChallengeResultEvent
+	(*GameEndedEvent)(nil),             // 32: liwords.GameEndedEvent
+	(*GameHistoryRefresher)(nil),       // 33: liwords.GameHistoryRefresher
+	(*NewGameEvent)(nil),               // 34: liwords.NewGameEvent
+	(*ErrorMessage)(nil),               // 35: liwords.ErrorMessage
+	(*ServerMessage)(nil),              // 36: liwords.ServerMessage
+	(*ClientGameplayEvent)(nil),        // 37: liwords.ClientGameplayEvent
+	(*TimedOut)(nil),                   // 38: liwords.TimedOut
+	(*DeclineMatchRequest)(nil),        // 39: liwords.DeclineMatchRequest
+	(*JoinPath)(nil),                   // 40: liwords.JoinPath
+	(*UnjoinRealm)(nil),                // 41: liwords.UnjoinRealm
+	(*GameMeta_UserMeta)(nil),          // 42: liwords.GameMeta.UserMeta
+	(*QuickPairPools_Pool)(nil),        // 43: liwords.QuickPairPools.Pool
+	nil,                                // 44: liwords.GameEndedEvent.ScoresEntry
+	nil,                                // 45: liwords.GameEndedEvent.NewRatingsEntry
+	nil,                                // 46: liwords.GameEndedEvent.NewRatingDeviationsEntry
+	(macondo.ChallengeRule)(0),         // 47: macondo.ChallengeRule
+	(*macondo.GameEvent)(nil),          // 48: macondo.GameEvent
+	(macondo.PlayState)(0),             // 49: macondo.PlayState
+	(*macondo.GameHistory)(nil),        // 50: macondo.GameHistory
 }
 var file_api_proto_realtime_realtime_proto_depIdxs = []int32{
 	5,  // 0: liwords.GameRequest.rules:type_name -> liwords.GameRules
-	36, // 1: liwords.GameRequest.challenge_rule:type_name -> macondo.ChallengeRule
+	47, // 1: liwords.GameRequest.challenge_rule:type_name -> macondo.ChallengeRule
 	0,  // 2: liwords.GameRequest.game_mode:type_name -> liwords.GameMode
 	1,  // 3: liwords.GameRequest.rating_mode:type_name -> liwords.RatingMode
-	33, // 4: liwords.GameMeta.users:type_name -> liwords.GameMeta.UserMeta
+	42, // 4: liwords.GameMeta.users:type_name -> liwords.GameMeta.UserMeta
 	6,  // 5: liwords.GameMeta.game_request:type_name -> liwords.GameRequest
 	10, // 6: liwords.ChatMessages.messages:type_name -> liwords.ChatMessage
 	12, // 7: liwords.UserPresences.presences:type_name -> liwords.UserPresence
@@ -2559,22 +3278,26 @@ var file_api_proto_realtime_realtime_proto_depIdxs = []int32{
 	6,  // 10: liwords.MatchRequest.game_request:type_name -> liwords.GameRequest
 	7,  // 11: liwords.MatchRequest.user:type_name -> liwords.MatchUser
 	7,  // 12: liwords.MatchRequest.receiving_user:type_name -> liwords.MatchUser
-	14, // 13: liwords.SeekRequests.requests:type_name -> liwords.SeekRequest
-	15, // 14: liwords.MatchRequests.requests:type_name -> liwords.MatchRequest
-	8,  // 15: liwords.ActiveGames.games:type_name -> liwords.GameMeta
-	37, // 16: liwords.ServerGameplayEvent.event:type_name -> macondo.GameEvent
-	38, // 17: liwords.ServerGameplayEvent.playing:type_name -> macondo.PlayState
-	36, // 18: liwords.ServerChallengeResultEvent.challenge_rule:type_name -> macondo.ChallengeRule
-	34, // 19: liwords.GameEndedEvent.scores:type_name -> liwords.GameEndedEvent.ScoresEntry
-	35, // 20: liwords.GameEndedEvent.new_ratings:type_name -> liwords.GameEndedEvent.NewRatingsEntry
-	3,  // 21: liwords.GameEndedEvent.end_reason:type_name -> liwords.GameEndReason
-	39, // 22: liwords.GameHistoryRefresher.history:type_name -> macondo.GameHistory
-	4,  // 23: liwords.ClientGameplayEvent.type:type_name -> liwords.ClientGameplayEvent.EventType
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	6,  // 13: liwords.QuickPairRequest.game_request:type_name -> liwords.GameRequest
+	14, // 14: liwords.SeekRequests.requests:type_name -> liwords.SeekRequest
+	15, // 15: liwords.MatchRequests.requests:type_name -> liwords.MatchRequest
+	8,  // 16: liwords.ActiveGames.games:type_name -> liwords.GameMeta
+	43, // 17: liwords.QuickPairPools.pools:type_name -> liwords.QuickPairPools.Pool
+	48, // 18: liwords.ServerGameplayEvent.event:type_name -> macondo.GameEvent
+	49, // 19: liwords.ServerGameplayEvent.playing:type_name -> macondo.PlayState
+	47, // 20: liwords.ServerChallengeResultEvent.challenge_rule:type_name -> macondo.ChallengeRule
+	44, // 21: liwords.GameEndedEvent.scores:type_name -> liwords.GameEndedEvent.ScoresEntry
+	45, // 22: liwords.GameEndedEvent.new_ratings:type_name -> liwords.GameEndedEvent.NewRatingsEntry
+	3,  // 23: liwords.GameEndedEvent.end_reason:type_name -> liwords.GameEndReason
+	46, // 24: liwords.GameEndedEvent.new_rating_deviations:type_name -> liwords.GameEndedEvent.NewRatingDeviationsEntry
+	50, // 25: liwords.GameHistoryRefresher.history:type_name -> macondo.GameHistory
+	4,  // 26: liwords.ClientGameplayEvent.type:type_name -> liwords.ClientGameplayEvent.EventType
+	6,  // 27: liwords.QuickPairPools.Pool.game_request:type_name -> liwords.GameRequest
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_realtime_realtime_proto_init() }
@@ -2728,7 +3451,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SoughtGameProcessEvent); i {
+			switch v := v.(*QuickPairRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2740,7 +3463,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SeekRequests); i {
+			switch v := v.(*QuickPairCancel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2752,7 +3475,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchRequests); i {
+			switch v := v.(*AdjournOffer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2764,7 +3487,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveGames); i {
+			switch v := v.(*AdjournResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2776,7 +3499,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerGameplayEvent); i {
+			switch v := v.(*ResumeOffer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2788,7 +3511,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerChallengeResultEvent); i {
+			switch v := v.(*TakebackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2800,7 +3523,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameEndedEvent); i {
+			switch v := v.(*TakebackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2812,7 +3535,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameHistoryRefresher); i {
+			switch v := v.(*SoughtGameProcessEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2824,7 +3547,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGameEvent); i {
+			switch v := v.(*SeekRequests); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2836,7 +3559,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrorMessage); i {
+			switch v := v.(*MatchRequests); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2848,7 +3571,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerMessage); i {
+			switch v := v.(*ActiveGames); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2860,7 +3583,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientGameplayEvent); i {
+			switch v := v.(*QuickPairPools); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2872,7 +3595,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimedOut); i {
+			switch v := v.(*GameAdjourned); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2884,7 +3607,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeclineMatchRequest); i {
+			switch v := v.(*ServerGameplayEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2896,7 +3619,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinPath); i {
+			switch v := v.(*ServerChallengeResultEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2908,7 +3631,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnjoinRealm); i {
+			switch v := v.(*GameEndedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2920,6 +3643,114 @@ func file_api_proto_realtime_realtime_proto_init() {
 			}
 		}
 		file_api_proto_realtime_realtime_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistoryRefresher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientGameplayEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimedOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineMatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinPath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnjoinRealm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_realtime_realtime_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameMeta_UserMeta); i {
 			case 0:
 				return &v.state
@@ -2931,6 +3762,18 @@ func file_api_proto_realtime_realtime_proto_init() {
 				return nil
 			}
 		}
+		file_api_proto_realtime_realtime_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuickPairPools_Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2938,7 +3781,7 @@ func file_api_proto_realtime_realtime_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_realtime_realtime_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   31,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,1267 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: api/proto/tournament_service/tournament_service.proto
+
+package tournament_service
+
+import (
+	realtime "github.com/domino14/liwords/rpc/api/proto/realtime"
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type PairingMethod int32
+
+const (
+	PairingMethod_RANDOM PairingMethod = 0
+	// KING_OF_THE_HILL pairs 1st vs 2nd, 3rd vs 4th, etc.
+	PairingMethod_KING_OF_THE_HILL PairingMethod = 1
+	PairingMethod_ROUND_ROBIN      PairingMethod = 2
+	// SWISS pairs players with the same number of wins, avoiding rematches.
+	PairingMethod_SWISS PairingMethod = 3
+)
+
+// Enum value maps for PairingMethod.
+var (
+	PairingMethod_name = map[int32]string{
+		0: "RANDOM",
+		1: "KING_OF_THE_HILL",
+		2: "ROUND_ROBIN",
+		3: "SWISS",
+	}
+	PairingMethod_value = map[string]int32{
+		"RANDOM":           0,
+		"KING_OF_THE_HILL": 1,
+		"ROUND_ROBIN":      2,
+		"SWISS":            3,
+	}
+)
+
+func (x PairingMethod) Enum() *PairingMethod {
+	p := new(PairingMethod)
+	*p = x
+	return p
+}
+
+func (x PairingMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PairingMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_tournament_service_tournament_service_proto_enumTypes[0].Descriptor()
+}
+
+func (PairingMethod) Type() protoreflect.EnumType {
+	return &file_api_proto_tournament_service_tournament_service_proto_enumTypes[0]
+}
+
+func (x PairingMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PairingMethod.Descriptor instead.
+func (PairingMethod) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{0}
+}
+
+type NewTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// lexicon, if set, overrides the lexicon of the game request.
+	Lexicon string `protobuf:"bytes,2,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// game_request is the template for every game in the tournament.
+	GameRequest   *realtime.GameRequest `protobuf:"bytes,3,opt,name=game_request,json=gameRequest,proto3" json:"game_request,omitempty"`
+	NumRounds     int32                 `protobuf:"varint,4,opt,name=num_rounds,json=numRounds,proto3" json:"num_rounds,omitempty"`
+	PairingMethod PairingMethod         `protobuf:"varint,5,opt,name=pairing_method,json=pairingMethod,proto3,enum=tournament_service.PairingMethod" json:"pairing_method,omitempty"`
+}
+
+func (x *NewTournamentRequest) Reset() {
+	*x = NewTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewTournamentRequest) ProtoMessage() {}
+
+func (x *NewTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewTournamentRequest.ProtoReflect.Descriptor instead.
+func (*NewTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NewTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewTournamentRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *NewTournamentRequest) GetGameRequest() *realtime.GameRequest {
+	if x != nil {
+		return x.GameRequest
+	}
+	return nil
+}
+
+func (x *NewTournamentRequest) GetNumRounds() int32 {
+	if x != nil {
+		return x.NumRounds
+	}
+	return 0
+}
+
+func (x *NewTournamentRequest) GetPairingMethod() PairingMethod {
+	if x != nil {
+		return x.PairingMethod
+	}
+	return PairingMethod_RANDOM
+}
+
+type NewTournamentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *NewTournamentResponse) Reset() {
+	*x = NewTournamentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewTournamentResponse) ProtoMessage() {}
+
+func (x *NewTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewTournamentResponse.ProtoReflect.Descriptor instead.
+func (*NewTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NewTournamentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The user registering is the one making the request.
+type RegisterPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *RegisterPlayerRequest) Reset() {
+	*x = RegisterPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerRequest) ProtoMessage() {}
+
+func (x *RegisterPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterPlayerRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type RegisterPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterPlayerResponse) Reset() {
+	*x = RegisterPlayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerResponse) ProtoMessage() {}
+
+func (x *RegisterPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{3}
+}
+
+// A FixedPairing is a pairing that the director makes by hand. The first
+// player goes first. If there is no second player, the first gets a bye.
+type FixedPairing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	First  string `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second string `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+}
+
+func (x *FixedPairing) Reset() {
+	*x = FixedPairing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixedPairing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixedPairing) ProtoMessage() {}
+
+func (x *FixedPairing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixedPairing.ProtoReflect.Descriptor instead.
+func (*FixedPairing) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FixedPairing) GetFirst() string {
+	if x != nil {
+		return x.First
+	}
+	return ""
+}
+
+func (x *FixedPairing) GetSecond() string {
+	if x != nil {
+		return x.Second
+	}
+	return ""
+}
+
+// StartRoundRequest pairs and starts the next round. Only the director can
+// start a round, and only once every game of the previous round is over. If
+// some games of the current round couldn't be started, it starts just those
+// instead, and the fixed pairings are ignored.
+type StartRoundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// fixed_pairings are only supported by Swiss tournaments.
+	FixedPairings []*FixedPairing `protobuf:"bytes,2,rep,name=fixed_pairings,json=fixedPairings,proto3" json:"fixed_pairings,omitempty"`
+}
+
+func (x *StartRoundRequest) Reset() {
+	*x = StartRoundRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRoundRequest) ProtoMessage() {}
+
+func (x *StartRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRoundRequest.ProtoReflect.Descriptor instead.
+func (*StartRoundRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StartRoundRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *StartRoundRequest) GetFixedPairings() []*FixedPairing {
+	if x != nil {
+		return x.FixedPairings
+	}
+	return nil
+}
+
+// A Pairing is a single game in a round. A bye only has one player.
+type Pairing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds   []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Usernames []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	GameId    string   `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Scores    []int32  `protobuf:"varint,4,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// winner is the index of the winner in user_ids, or -1 for a tie.
+	Winner    int32                  `protobuf:"varint,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	Done      bool                   `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	EndReason realtime.GameEndReason `protobuf:"varint,7,opt,name=end_reason,json=endReason,proto3,enum=liwords.GameEndReason" json:"end_reason,omitempty"`
+}
+
+func (x *Pairing) Reset() {
+	*x = Pairing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pairing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pairing) ProtoMessage() {}
+
+func (x *Pairing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pairing.ProtoReflect.Descriptor instead.
+func (*Pairing) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Pairing) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *Pairing) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+func (x *Pairing) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Pairing) GetScores() []int32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Pairing) GetWinner() int32 {
+	if x != nil {
+		return x.Winner
+	}
+	return 0
+}
+
+func (x *Pairing) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *Pairing) GetEndReason() realtime.GameEndReason {
+	if x != nil {
+		return x.EndReason
+	}
+	return realtime.GameEndReason_NONE
+}
+
+type StartRoundResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rounds are zero-indexed.
+	Round    int32      `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Pairings []*Pairing `protobuf:"bytes,2,rep,name=pairings,proto3" json:"pairings,omitempty"`
+}
+
+func (x *StartRoundResponse) Reset() {
+	*x = StartRoundResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartRoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRoundResponse) ProtoMessage() {}
+
+func (x *StartRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRoundResponse.ProtoReflect.Descriptor instead.
+func (*StartRoundResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StartRoundResponse) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *StartRoundResponse) GetPairings() []*Pairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+type PairingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Round        int32  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (x *PairingsRequest) Reset() {
+	*x = PairingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingsRequest) ProtoMessage() {}
+
+func (x *PairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingsRequest.ProtoReflect.Descriptor instead.
+func (*PairingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PairingsRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *PairingsRequest) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+type PairingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairings []*Pairing `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
+}
+
+func (x *PairingsResponse) Reset() {
+	*x = PairingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingsResponse) ProtoMessage() {}
+
+func (x *PairingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingsResponse.ProtoReflect.Descriptor instead.
+func (*PairingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PairingsResponse) GetPairings() []*Pairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+type StandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *StandingsRequest) Reset() {
+	*x = StandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsRequest) ProtoMessage() {}
+
+func (x *StandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsRequest.ProtoReflect.Descriptor instead.
+func (*StandingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StandingsRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Wins     int32  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses   int32  `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws    int32  `protobuf:"varint,5,opt,name=draws,proto3" json:"draws,omitempty"`
+	Spread   int32  `protobuf:"varint,6,opt,name=spread,proto3" json:"spread,omitempty"`
+	// buchholz is the sum of the scores (wins plus half the draws) of this
+	// player's opponents. It breaks ties after wins and spread.
+	Buchholz float64 `protobuf:"fixed64,7,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Standing) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Standing) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Standing) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Standing) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Standing) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *Standing) GetSpread() int32 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *Standing) GetBuchholz() float64 {
+	if x != nil {
+		return x.Buchholz
+	}
+	return 0
+}
+
+type StandingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NumRounds int32  `protobuf:"varint,2,opt,name=num_rounds,json=numRounds,proto3" json:"num_rounds,omitempty"`
+	// current_round is -1 if the tournament hasn't started.
+	CurrentRound int32       `protobuf:"varint,3,opt,name=current_round,json=currentRound,proto3" json:"current_round,omitempty"`
+	Standings    []*Standing `protobuf:"bytes,4,rep,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *StandingsResponse) Reset() {
+	*x = StandingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsResponse) ProtoMessage() {}
+
+func (x *StandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tournament_service_tournament_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsResponse.ProtoReflect.Descriptor instead.
+func (*StandingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StandingsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StandingsResponse) GetNumRounds() int32 {
+	if x != nil {
+		return x.NumRounds
+	}
+	return 0
+}
+
+func (x *StandingsResponse) GetCurrentRound() int32 {
+	if x != nil {
+		return x.CurrentRound
+	}
+	return 0
+}
+
+func (x *StandingsResponse) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+var File_api_proto_tournament_service_tournament_service_proto protoreflect.FileDescriptor
+
+var file_api_proto_tournament_service_tournament_service_proto_rawDesc = []byte{
+	0x0a, 0x35, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x21, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2f,
+	0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6,
+	0x01, 0x0a, 0x14, 0x4e, 0x65, 0x77, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65,
+	0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a,
+	0x0e, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x4e, 0x65, 0x77, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x3c, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x18,
+	0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x47, 0x0a, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x07, 0x50,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x0a,
+	0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45,
+	0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x37, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4c, 0x0a, 0x0f, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x4b, 0x0a, 0x10, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x37, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xb5, 0x01, 0x0a,
+	0x08, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72,
+	0x61, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x63, 0x68,
+	0x68, 0x6f, 0x6c, 0x7a, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x75, 0x63, 0x68,
+	0x68, 0x6f, 0x6c, 0x7a, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2a, 0x4d,
+	0x0a, 0x0d, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x0a, 0x0a, 0x06, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b,
+	0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x48, 0x49, 0x4c, 0x4c, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e,
+	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x57, 0x49, 0x53, 0x53, 0x10, 0x03, 0x32, 0xf6, 0x03,
+	0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x25, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23,
+	0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x6c, 0x69,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_proto_tournament_service_tournament_service_proto_rawDescOnce sync.Once
+	file_api_proto_tournament_service_tournament_service_proto_rawDescData = file_api_proto_tournament_service_tournament_service_proto_rawDesc
+)
+
+func file_api_proto_tournament_service_tournament_service_proto_rawDescGZIP() []byte {
+	file_api_proto_tournament_service_tournament_service_proto_rawDescOnce.Do(func() {
+		file_api_proto_tournament_service_tournament_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_proto_tournament_service_tournament_service_proto_rawDescData)
+	})
+	return file_api_proto_tournament_service_tournament_service_proto_rawDescData
+}
+
+var file_api_proto_tournament_service_tournament_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_tournament_service_tournament_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_proto_tournament_service_tournament_service_proto_goTypes = []interface{}{
+	(PairingMethod)(0),             // 0: tournament_service.PairingMethod
+	(*NewTournamentRequest)(nil),   // 1: tournament_service.NewTournamentRequest
+	(*NewTournamentResponse)(nil),  // 2: tournament_service.NewTournamentResponse
+	(*RegisterPlayerRequest)(nil),  // 3: tournament_service.RegisterPlayerRequest
+	(*RegisterPlayerResponse)(nil), // 4: tournament_service.RegisterPlayerResponse
+	(*FixedPairing)(nil),           // 5: tournament_service.FixedPairing
+	(*StartRoundRequest)(nil),      // 6: tournament_service.StartRoundRequest
+	(*Pairing)(nil),                // 7: tournament_service.Pairing
+	(*StartRoundResponse)(nil),     // 8: tournament_service.StartRoundResponse
+	(*PairingsRequest)(nil),        // 9: tournament_service.PairingsRequest
+	(*PairingsResponse)(nil),       // 10: tournament_service.PairingsResponse
+	(*StandingsRequest)(nil),       // 11: tournament_service.StandingsRequest
+	(*Standing)(nil),               // 12: tournament_service.Standing
+	(*StandingsResponse)(nil),      // 13: tournament_service.StandingsResponse
+	(*realtime.GameRequest)(nil),   // 14: liwords.GameRequest
+	(realtime.GameEndReason)(0),    // 15: liwords.GameEndReason
+}
+var file_api_proto_tournament_service_tournament_service_proto_depIdxs = []int32{
+	14, // 0: tournament_service.NewTournamentRequest.game_request:type_name -> liwords.GameRequest
+	0,  // 1: tournament_service.NewTournamentRequest.pairing_method:type_name -> tournament_service.PairingMethod
+	5,  // 2: tournament_service.StartRoundRequest.fixed_pairings:type_name -> tournament_service.FixedPairing
+	15, // 3: tournament_service.Pairing.end_reason:type_name -> liwords.GameEndReason
+	7,  // 4: tournament_service.StartRoundResponse.pairings:type_name -> tournament_service.Pairing
+	7,  // 5: tournament_service.PairingsResponse.pairings:type_name -> tournament_service.Pairing
+	12, // 6: tournament_service.StandingsResponse.standings:type_name -> tournament_service.Standing
+	1,  // 7: tournament_service.TournamentService.NewTournament:input_type -> tournament_service.NewTournamentRequest
+	3,  // 8: tournament_service.TournamentService.RegisterPlayer:input_type -> tournament_service.RegisterPlayerRequest
+	6,  // 9: tournament_service.TournamentService.StartRound:input_type -> tournament_service.StartRoundRequest
+	9,  // 10: tournament_service.TournamentService.GetPairings:input_type -> tournament_service.PairingsRequest
+	11, // 11: tournament_service.TournamentService.GetStandings:input_type -> tournament_service.StandingsRequest
+	2,  // 12: tournament_service.TournamentService.NewTournament:output_type -> tournament_service.NewTournamentResponse
+	4,  // 13: tournament_service.TournamentService.RegisterPlayer:output_type -> tournament_service.RegisterPlayerResponse
+	8,  // 14: tournament_service.TournamentService.StartRound:output_type -> tournament_service.StartRoundResponse
+	10, // 15: tournament_service.TournamentService.GetPairings:output_type -> tournament_service.PairingsResponse
+	13, // 16: tournament_service.TournamentService.GetStandings:output_type -> tournament_service.StandingsResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_tournament_service_tournament_service_proto_init() }
+func file_api_proto_tournament_service_tournament_service_proto_init() {
+	if File_api_proto_tournament_service_tournament_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewTournamentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterPlayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixedPairing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRoundRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pairing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRoundResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_tournament_service_tournament_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_proto_tournament_service_tournament_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_tournament_service_tournament_service_proto_goTypes,
+		DependencyIndexes: file_api_proto_tournament_service_tournament_service_proto_depIdxs,
+		EnumInfos:         file_api_proto_tournament_service_tournament_service_proto_enumTypes,
+		MessageInfos:      file_api_proto_tournament_service_tournament_service_proto_msgTypes,
+	}.Build()
+	File_api_proto_tournament_service_tournament_service_proto = out.File
+	file_api_proto_tournament_service_tournament_service_proto_rawDesc = nil
+	file_api_proto_tournament_service_tournament_service_proto_goTypes = nil
+	file_api_proto_tournament_service_tournament_service_proto_depIdxs = nil
+}
@@ -0,0 +1,2197 @@
+// Code generated by protoc-gen-twirp v7.1.0, DO NOT EDIT.
+// source: api/proto/tournament_service/tournament_service.proto
+
+/*
+Package tournament_service is a generated twirp stub package.
+This code was generated with github.com/twitchtv/twirp/protoc-gen-twirp v7.1.0.
+
+It is generated from these files:
+	api/proto/tournament_service/tournament_service.proto
+*/
+package tournament_service
+
+import bytes "bytes"
+import strings "strings"
+import context "context"
+import fmt "fmt"
+import ioutil "io/ioutil"
+import http "net/http"
+import strconv "strconv"
+
+import jsonpb "github.com/golang/protobuf/jsonpb"
+import proto "github.com/golang/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Imports only used by utility functions:
+import io "io"
+import json "encoding/json"
+import path "path"
+import url "net/url"
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the twirp package used in your project.
+// A compilation error at this line likely means your copy of the
+// twirp package needs to be updated.
+const _ = twirp.TwirpPackageIsVersion7
+
+// ===========================
+// TournamentService Interface
+// ===========================
+
+type TournamentService interface {
+	NewTournament(context.Context, *NewTournamentRequest) (*NewTournamentResponse, error)
+
+	RegisterPlayer(context.Context, *RegisterPlayerRequest) (*RegisterPlayerResponse, error)
+
+	StartRound(context.Context, *StartRoundRequest) (*StartRoundResponse, error)
+
+	GetPairings(context.Context, *PairingsRequest) (*PairingsResponse, error)
+
+	GetStandings(context.Context, *StandingsRequest) (*StandingsResponse, error)
+}
+
+// =================================
+// TournamentService Protobuf Client
+// =================================
+
+type tournamentServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewTournamentServiceProtobufClient creates a Protobuf client that implements the TournamentService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewTournamentServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TournamentService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "tournament_service", "TournamentService")
+	urls := [5]string{
+		serviceURL + "NewTournament",
+		serviceURL + "RegisterPlayer",
+		serviceURL + "StartRound",
+		serviceURL + "GetPairings",
+		serviceURL + "GetStandings",
+	}
+
+	return &tournamentServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *tournamentServiceProtobufClient) NewTournament(ctx context.Context, in *NewTournamentRequest) (*NewTournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	caller := c.callNewTournament
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+					}
+					return c.callNewTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NewTournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callNewTournament(ctx context.Context, in *NewTournamentRequest) (*NewTournamentResponse, error) {
+	out := new(NewTournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceProtobufClient) RegisterPlayer(ctx context.Context, in *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterPlayer")
+	caller := c.callRegisterPlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterPlayerRequest) when calling interceptor")
+					}
+					return c.callRegisterPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterPlayerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterPlayerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callRegisterPlayer(ctx context.Context, in *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+	out := new(RegisterPlayerResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceProtobufClient) StartRound(ctx context.Context, in *StartRoundRequest) (*StartRoundResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "StartRound")
+	caller := c.callStartRound
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StartRoundRequest) (*StartRoundResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartRoundRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartRoundRequest) when calling interceptor")
+					}
+					return c.callStartRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartRoundResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartRoundResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callStartRound(ctx context.Context, in *StartRoundRequest) (*StartRoundResponse, error) {
+	out := new(StartRoundResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceProtobufClient) GetPairings(ctx context.Context, in *PairingsRequest) (*PairingsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPairings")
+	caller := c.callGetPairings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PairingsRequest) (*PairingsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairingsRequest) when calling interceptor")
+					}
+					return c.callGetPairings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PairingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PairingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callGetPairings(ctx context.Context, in *PairingsRequest) (*PairingsResponse, error) {
+	out := new(PairingsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceProtobufClient) GetStandings(ctx context.Context, in *StandingsRequest) (*StandingsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetStandings")
+	caller := c.callGetStandings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StandingsRequest) (*StandingsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StandingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StandingsRequest) when calling interceptor")
+					}
+					return c.callGetStandings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StandingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StandingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callGetStandings(ctx context.Context, in *StandingsRequest) (*StandingsResponse, error) {
+	out := new(StandingsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// TournamentService JSON Client
+// =============================
+
+type tournamentServiceJSONClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewTournamentServiceJSONClient creates a JSON client that implements the TournamentService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewTournamentServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TournamentService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "tournament_service", "TournamentService")
+	urls := [5]string{
+		serviceURL + "NewTournament",
+		serviceURL + "RegisterPlayer",
+		serviceURL + "StartRound",
+		serviceURL + "GetPairings",
+		serviceURL + "GetStandings",
+	}
+
+	return &tournamentServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *tournamentServiceJSONClient) NewTournament(ctx context.Context, in *NewTournamentRequest) (*NewTournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	caller := c.callNewTournament
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+					}
+					return c.callNewTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NewTournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callNewTournament(ctx context.Context, in *NewTournamentRequest) (*NewTournamentResponse, error) {
+	out := new(NewTournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RegisterPlayer(ctx context.Context, in *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterPlayer")
+	caller := c.callRegisterPlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterPlayerRequest) when calling interceptor")
+					}
+					return c.callRegisterPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterPlayerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterPlayerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRegisterPlayer(ctx context.Context, in *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+	out := new(RegisterPlayerResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) StartRound(ctx context.Context, in *StartRoundRequest) (*StartRoundResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "StartRound")
+	caller := c.callStartRound
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StartRoundRequest) (*StartRoundResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartRoundRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartRoundRequest) when calling interceptor")
+					}
+					return c.callStartRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartRoundResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartRoundResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callStartRound(ctx context.Context, in *StartRoundRequest) (*StartRoundResponse, error) {
+	out := new(StartRoundResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetPairings(ctx context.Context, in *PairingsRequest) (*PairingsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPairings")
+	caller := c.callGetPairings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PairingsRequest) (*PairingsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairingsRequest) when calling interceptor")
+					}
+					return c.callGetPairings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PairingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PairingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetPairings(ctx context.Context, in *PairingsRequest) (*PairingsResponse, error) {
+	out := new(PairingsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetStandings(ctx context.Context, in *StandingsRequest) (*StandingsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetStandings")
+	caller := c.callGetStandings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StandingsRequest) (*StandingsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StandingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StandingsRequest) when calling interceptor")
+					}
+					return c.callGetStandings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StandingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StandingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetStandings(ctx context.Context, in *StandingsRequest) (*StandingsResponse, error) {
+	out := new(StandingsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ================================
+// TournamentService Server Handler
+// ================================
+
+type tournamentServiceServer struct {
+	TournamentService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewTournamentServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewTournamentServiceServer(svc TournamentService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewTournamentServiceServer", o))
+		}
+	}
+
+	return &tournamentServiceServer{
+		TournamentService: svc,
+		pathPrefix:        serverOpts.PathPrefix(),
+		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:             serverOpts.Hooks,
+		jsonSkipDefaults:  serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *tournamentServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// TournamentServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const TournamentServicePathPrefix = "/twirp/tournament_service.TournamentService/"
+
+func (s *tournamentServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "tournament_service.TournamentService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "NewTournament":
+		s.serveNewTournament(ctx, resp, req)
+		return
+	case "RegisterPlayer":
+		s.serveRegisterPlayer(ctx, resp, req)
+		return
+	case "StartRound":
+		s.serveStartRound(ctx, resp, req)
+		return
+	case "GetPairings":
+		s.serveGetPairings(ctx, resp, req)
+		return
+	case "GetStandings":
+		s.serveGetStandings(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *tournamentServiceServer) serveNewTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveNewTournamentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveNewTournamentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveNewTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(NewTournamentRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.NewTournament
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+					}
+					return s.TournamentService.NewTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NewTournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NewTournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NewTournamentResponse and nil error while calling NewTournament. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveNewTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(NewTournamentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.NewTournament
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+					}
+					return s.TournamentService.NewTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NewTournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NewTournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NewTournamentResponse and nil error while calling NewTournament. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRegisterPlayer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRegisterPlayerJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRegisterPlayerProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveRegisterPlayerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterPlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(RegisterPlayerRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.RegisterPlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterPlayerRequest) when calling interceptor")
+					}
+					return s.TournamentService.RegisterPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterPlayerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterPlayerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterPlayerResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterPlayerResponse and nil error while calling RegisterPlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRegisterPlayerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterPlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(RegisterPlayerRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.RegisterPlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterPlayerRequest) when calling interceptor")
+					}
+					return s.TournamentService.RegisterPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterPlayerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterPlayerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterPlayerResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterPlayerResponse and nil error while calling RegisterPlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveStartRound(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStartRoundJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStartRoundProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveStartRoundJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartRound")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(StartRoundRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.StartRound
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StartRoundRequest) (*StartRoundResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartRoundRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartRoundRequest) when calling interceptor")
+					}
+					return s.TournamentService.StartRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartRoundResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartRoundResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *StartRoundResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartRoundResponse and nil error while calling StartRound. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveStartRoundProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartRound")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(StartRoundRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.StartRound
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StartRoundRequest) (*StartRoundResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartRoundRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartRoundRequest) when calling interceptor")
+					}
+					return s.TournamentService.StartRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartRoundResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartRoundResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *StartRoundResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartRoundResponse and nil error while calling StartRound. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetPairings(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPairingsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPairingsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveGetPairingsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPairings")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(PairingsRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.GetPairings
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PairingsRequest) (*PairingsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairingsRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetPairings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PairingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PairingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PairingsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PairingsResponse and nil error while calling GetPairings. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetPairingsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPairings")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(PairingsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.GetPairings
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PairingsRequest) (*PairingsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairingsRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetPairings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PairingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PairingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PairingsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PairingsResponse and nil error while calling GetPairings. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetStandings(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetStandingsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetStandingsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveGetStandingsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStandings")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(StandingsRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.GetStandings
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StandingsRequest) (*StandingsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StandingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StandingsRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetStandings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StandingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StandingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *StandingsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StandingsResponse and nil error while calling GetStandings. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetStandingsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStandings")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(StandingsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.GetStandings
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StandingsRequest) (*StandingsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StandingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StandingsRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetStandings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StandingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StandingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *StandingsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StandingsResponse and nil error while calling GetStandings. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *tournamentServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *tournamentServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "tournament_service", "TournamentService")
+}
+
+// =====
+// Utils
+// =====
+
+// HTTPClient is the interface used by generated clients to send HTTP requests.
+// It is fulfilled by *(net/http).Client, which is sufficient for most users.
+// Users can provide their own implementation for special retry policies.
+//
+// HTTPClient implementations should not follow redirects. Redirects are
+// automatically disabled if *(net/http).Client is passed to client
+// constructors. See the withoutRedirects function in this file for more
+// details.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// TwirpServer is the interface generated server structs will support: they're
+// HTTP handlers with additional methods for accessing metadata about the
+// service. Those accessors are a low-level API for building reflection tools.
+// Most people can think of TwirpServers as just http.Handlers.
+type TwirpServer interface {
+	http.Handler
+
+	// ServiceDescriptor returns gzipped bytes describing the .proto file that
+	// this service was generated from. Once unzipped, the bytes can be
+	// unmarshalled as a
+	// github.com/golang/protobuf/protoc-gen-go/descriptor.FileDescriptorProto.
+	//
+	// The returned integer is the index of this particular service within that
+	// FileDescriptorProto's 'Service' slice of ServiceDescriptorProtos. This is a
+	// low-level field, expected to be used for reflection.
+	ServiceDescriptor() ([]byte, int)
+
+	// ProtocGenTwirpVersion is the semantic version string of the version of
+	// twirp used to generate this file.
+	ProtocGenTwirpVersion() string
+
+	// PathPrefix returns the HTTP URL path prefix for all methods handled by this
+	// service. This can be used with an HTTP mux to route Twirp requests.
+	// The path prefix is in the form: "/<prefix>/<package>.<Service>/"
+	// that is, everything in a Twirp route except for the <Method> at the end.
+	PathPrefix() string
+}
+
+// WriteError writes an HTTP response with a valid Twirp error format (code, msg, meta).
+// Useful outside of the Twirp server (e.g. http middleware), but does not trigger hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func WriteError(resp http.ResponseWriter, err error) {
+	writeError(context.Background(), resp, err, nil)
+}
+
+// writeError writes Twirp errors in the response and triggers hooks.
+func writeError(ctx context.Context, resp http.ResponseWriter, err error, hooks *twirp.ServerHooks) {
+	// Non-twirp errors are wrapped as Internal (default)
+	twerr, ok := err.(twirp.Error)
+	if !ok {
+		twerr = twirp.InternalErrorWith(err)
+	}
+
+	statusCode := twirp.ServerHTTPStatusFromErrorCode(twerr.Code())
+	ctx = ctxsetters.WithStatusCode(ctx, statusCode)
+	ctx = callError(ctx, hooks, twerr)
+
+	respBody := marshalErrorToJSON(twerr)
+
+	resp.Header().Set("Content-Type", "application/json") // Error responses are always JSON
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBody)))
+	resp.WriteHeader(statusCode) // set HTTP status code and send response
+
+	_, writeErr := resp.Write(respBody)
+	if writeErr != nil {
+		// We have three options here. We could log the error, call the Error
+		// hook, or just silently ignore the error.
+		//
+		// Logging is unacceptable because we don't have a user-controlled
+		// logger; writing out to stderr without permission is too rude.
+		//
+		// Calling the Error hook would confuse users: it would mean the Error
+		// hook got called twice for one request, which is likely to lead to
+		// duplicated log messages and metrics, no matter how well we document
+		// the behavior.
+		//
+		// Silently ignoring the error is our least-bad option. It's highly
+		// likely that the connection is broken and the original 'err' says
+		// so anyway.
+		_ = writeErr
+	}
+
+	callResponseSent(ctx, hooks)
+}
+
+// sanitizeBaseURL parses the the baseURL, and adds the "http" scheme if needed.
+// If the URL is unparsable, the baseURL is returned unchaged.
+func sanitizeBaseURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL // invalid URL will fail later when making requests
+	}
+	if u.Scheme == "" {
+		u.Scheme = "http"
+	}
+	return u.String()
+}
+
+// baseServicePath composes the path prefix for the service (without <Method>).
+// e.g.: baseServicePath("/twirp", "my.pkg", "MyService")
+//       returns => "/twirp/my.pkg.MyService/"
+// e.g.: baseServicePath("", "", "MyService")
+//       returns => "/MyService/"
+func baseServicePath(prefix, pkg, service string) string {
+	fullServiceName := service
+	if pkg != "" {
+		fullServiceName = pkg + "." + service
+	}
+	return path.Join("/", prefix, fullServiceName) + "/"
+}
+
+// parseTwirpPath extracts path components form a valid Twirp route.
+// Expected format: "[<prefix>]/<package>.<Service>/<Method>"
+// e.g.: prefix, pkgService, method := parseTwirpPath("/twirp/pkg.Svc/MakeHat")
+func parseTwirpPath(path string) (string, string, string) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return "", "", ""
+	}
+	method := parts[len(parts)-1]
+	pkgService := parts[len(parts)-2]
+	prefix := strings.Join(parts[0:len(parts)-2], "/")
+	return prefix, pkgService, method
+}
+
+// getCustomHTTPReqHeaders retrieves a copy of any headers that are set in
+// a context through the twirp.WithHTTPRequestHeaders function.
+// If there are no headers set, or if they have the wrong type, nil is returned.
+func getCustomHTTPReqHeaders(ctx context.Context) http.Header {
+	header, ok := twirp.HTTPRequestHeaders(ctx)
+	if !ok || header == nil {
+		return nil
+	}
+	copied := make(http.Header)
+	for k, vv := range header {
+		if vv == nil {
+			copied[k] = nil
+			continue
+		}
+		copied[k] = make([]string, len(vv))
+		copy(copied[k], vv)
+	}
+	return copied
+}
+
+// newRequest makes an http.Request from a client, adding common headers.
+func newRequest(ctx context.Context, url string, reqBody io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequest("POST", url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if customHeader := getCustomHTTPReqHeaders(ctx); customHeader != nil {
+		req.Header = customHeader
+	}
+	req.Header.Set("Accept", contentType)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Twirp-Version", "v7.1.0")
+	return req, nil
+}
+
+// JSON serialization for errors
+type twerrJSON struct {
+	Code string            `json:"code"`
+	Msg  string            `json:"msg"`
+	Meta map[string]string `json:"meta,omitempty"`
+}
+
+// marshalErrorToJSON returns JSON from a twirp.Error, that can be used as HTTP error response body.
+// If serialization fails, it will use a descriptive Internal error instead.
+func marshalErrorToJSON(twerr twirp.Error) []byte {
+	// make sure that msg is not too large
+	msg := twerr.Msg()
+	if len(msg) > 1e6 {
+		msg = msg[:1e6]
+	}
+
+	tj := twerrJSON{
+		Code: string(twerr.Code()),
+		Msg:  msg,
+		Meta: twerr.MetaMap(),
+	}
+
+	buf, err := json.Marshal(&tj)
+	if err != nil {
+		buf = []byte("{\"type\": \"" + twirp.Internal + "\", \"msg\": \"There was an error but it could not be serialized into JSON\"}") // fallback
+	}
+
+	return buf
+}
+
+// errorFromResponse builds a twirp.Error from a non-200 HTTP response.
+// If the response has a valid serialized Twirp error, then it's returned.
+// If not, the response status code is used to generate a similar twirp
+// error. See twirpErrorFromIntermediary for more info on intermediary errors.
+func errorFromResponse(resp *http.Response) twirp.Error {
+	statusCode := resp.StatusCode
+	statusText := http.StatusText(statusCode)
+
+	if isHTTPRedirect(statusCode) {
+		// Unexpected redirect: it must be an error from an intermediary.
+		// Twirp clients don't follow redirects automatically, Twirp only handles
+		// POST requests, redirects should only happen on GET and HEAD requests.
+		location := resp.Header.Get("Location")
+		msg := fmt.Sprintf("unexpected HTTP status code %d %q received, Location=%q", statusCode, statusText, location)
+		return twirpErrorFromIntermediary(statusCode, msg, location)
+	}
+
+	respBodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return wrapInternal(err, "failed to read server error response body")
+	}
+
+	var tj twerrJSON
+	dec := json.NewDecoder(bytes.NewReader(respBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&tj); err != nil || tj.Code == "" {
+		// Invalid JSON response; it must be an error from an intermediary.
+		msg := fmt.Sprintf("Error from intermediary with HTTP status code %d %q", statusCode, statusText)
+		return twirpErrorFromIntermediary(statusCode, msg, string(respBodyBytes))
+	}
+
+	errorCode := twirp.ErrorCode(tj.Code)
+	if !twirp.IsValidErrorCode(errorCode) {
+		msg := "invalid type returned from server error response: " + tj.Code
+		return twirp.InternalError(msg).WithMeta("body", string(respBodyBytes))
+	}
+
+	twerr := twirp.NewError(errorCode, tj.Msg)
+	for k, v := range tj.Meta {
+		twerr = twerr.WithMeta(k, v)
+	}
+	return twerr
+}
+
+// twirpErrorFromIntermediary maps HTTP errors from non-twirp sources to twirp errors.
+// The mapping is similar to gRPC: https://github.com/grpc/grpc/blob/master/doc/http-grpc-status-mapping.md.
+// Returned twirp Errors have some additional metadata for inspection.
+func twirpErrorFromIntermediary(status int, msg string, bodyOrLocation string) twirp.Error {
+	var code twirp.ErrorCode
+	if isHTTPRedirect(status) { // 3xx
+		code = twirp.Internal
+	} else {
+		switch status {
+		case 400: // Bad Request
+			code = twirp.Internal
+		case 401: // Unauthorized
+			code = twirp.Unauthenticated
+		case 403: // Forbidden
+			code = twirp.PermissionDenied
+		case 404: // Not Found
+			code = twirp.BadRoute
+		case 429: // Too Many Requests
+			code = twirp.ResourceExhausted
+		case 502, 503, 504: // Bad Gateway, Service Unavailable, Gateway Timeout
+			code = twirp.Unavailable
+		default: // All other codes
+			code = twirp.Unknown
+		}
+	}
+
+	twerr := twirp.NewError(code, msg)
+	twerr = twerr.WithMeta("http_error_from_intermediary", "true") // to easily know if this error was from intermediary
+	twerr = twerr.WithMeta("status_code", strconv.Itoa(status))
+	if isHTTPRedirect(status) {
+		twerr = twerr.WithMeta("location", bodyOrLocation)
+	} else {
+		twerr = twerr.WithMeta("body", bodyOrLocation)
+	}
+	return twerr
+}
+
+func isHTTPRedirect(status int) bool {
+	return status >= 300 && status <= 399
+}
+
+// wrapInternal wraps an error with a prefix as an Internal error.
+// The original error cause is accessible by github.com/pkg/errors.Cause.
+func wrapInternal(err error, prefix string) twirp.Error {
+	return twirp.InternalErrorWith(&wrappedError{prefix: prefix, cause: err})
+}
+
+type wrappedError struct {
+	prefix string
+	cause  error
+}
+
+func (e *wrappedError) Error() string { return e.prefix + ": " + e.cause.Error() }
+func (e *wrappedError) Unwrap() error { return e.cause } // for go1.13 + errors.Is/As
+func (e *wrappedError) Cause() error  { return e.cause } // for github.com/pkg/errors
+
+// ensurePanicResponses makes sure that rpc methods causing a panic still result in a Twirp Internal
+// error response (status 500), and error hooks are properly called with the panic wrapped as an error.
+// The panic is re-raised so it can be handled normally with middleware.
+func ensurePanicResponses(ctx context.Context, resp http.ResponseWriter, hooks *twirp.ServerHooks) {
+	if r := recover(); r != nil {
+		// Wrap the panic as an error so it can be passed to error hooks.
+		// The original error is accessible from error hooks, but not visible in the response.
+		err := errFromPanic(r)
+		twerr := &internalWithCause{msg: "Internal service panic", cause: err}
+		// Actually write the error
+		writeError(ctx, resp, twerr, hooks)
+		// If possible, flush the error to the wire.
+		f, ok := resp.(http.Flusher)
+		if ok {
+			f.Flush()
+		}
+
+		panic(r)
+	}
+}
+
+// errFromPanic returns the typed error if the recovered panic is an error, otherwise formats as error.
+func errFromPanic(p interface{}) error {
+	if err, ok := p.(error); ok {
+		return err
+	}
+	return fmt.Errorf("panic: %v", p)
+}
+
+// internalWithCause is a Twirp Internal error wrapping an original error cause,
+// but the original error message is not exposed on Msg(). The original error
+// can be checked with go1.13+ errors.Is/As, and also by (github.com/pkg/errors).Unwrap
+type internalWithCause struct {
+	msg   string
+	cause error
+}
+
+func (e *internalWithCause) Unwrap() error                               { return e.cause } // for go1.13 + errors.Is/As
+func (e *internalWithCause) Cause() error                                { return e.cause } // for github.com/pkg/errors
+func (e *internalWithCause) Error() string                               { return e.msg + ": " + e.cause.Error() }
+func (e *internalWithCause) Code() twirp.ErrorCode                       { return twirp.Internal }
+func (e *internalWithCause) Msg() string                                 { return e.msg }
+func (e *internalWithCause) Meta(key string) string                      { return "" }
+func (e *internalWithCause) MetaMap() map[string]string                  { return nil }
+func (e *internalWithCause) WithMeta(key string, val string) twirp.Error { return e }
+
+// malformedRequestError is used when the twirp server cannot unmarshal a request
+func malformedRequestError(msg string) twirp.Error {
+	return twirp.NewError(twirp.Malformed, msg)
+}
+
+// badRouteError is used when the twirp server cannot route a request
+func badRouteError(msg string, method, url string) twirp.Error {
+	err := twirp.NewError(twirp.BadRoute, msg)
+	err = err.WithMeta("twirp_invalid_route", method+" "+url)
+	return err
+}
+
+// withoutRedirects makes sure that the POST request can not be redirected.
+// The standard library will, by default, redirect requests (including POSTs) if it gets a 302 or
+// 303 response, and also 301s in go1.8. It redirects by making a second request, changing the
+// method to GET and removing the body. This produces very confusing error messages, so instead we
+// set a redirect policy that always errors. This stops Go from executing the redirect.
+//
+// We have to be a little careful in case the user-provided http.Client has its own CheckRedirect
+// policy - if so, we'll run through that policy first.
+//
+// Because this requires modifying the http.Client, we make a new copy of the client and return it.
+func withoutRedirects(in *http.Client) *http.Client {
+	copy := *in
+	copy.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if in.CheckRedirect != nil {
+			// Run the input's redirect if it exists, in case it has side effects, but ignore any error it
+			// returns, since we want to use ErrUseLastResponse.
+			err := in.CheckRedirect(req, via)
+			_ = err // Silly, but this makes sure generated code passes errcheck -blank, which some people use.
+		}
+		return http.ErrUseLastResponse
+	}
+	return &copy
+}
+
+// doProtobufRequest makes a Protobuf request to the remote Twirp service.
+func doProtobufRequest(ctx context.Context, client HTTPClient, hooks *twirp.ClientHooks, url string, in, out proto.Message) (_ context.Context, err error) {
+	reqBodyBytes, err := proto.Marshal(in)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to marshal proto request")
+	}
+	reqBody := bytes.NewBuffer(reqBodyBytes)
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	req, err := newRequest(ctx, url, reqBody, "application/protobuf")
+	if err != nil {
+		return ctx, wrapInternal(err, "could not build request")
+	}
+	ctx, err = callClientRequestPrepared(ctx, hooks, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	req = req.WithContext(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to do request")
+	}
+
+	defer func() {
+		cerr := resp.Body.Close()
+		if err == nil && cerr != nil {
+			err = wrapInternal(cerr, "failed to close response body")
+		}
+	}()
+
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if resp.StatusCode != 200 {
+		return ctx, errorFromResponse(resp)
+	}
+
+	respBodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to read response body")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if err = proto.Unmarshal(respBodyBytes, out); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal proto response")
+	}
+	return ctx, nil
+}
+
+// doJSONRequest makes a JSON request to the remote Twirp service.
+func doJSONRequest(ctx context.Context, client HTTPClient, hooks *twirp.ClientHooks, url string, in, out proto.Message) (_ context.Context, err error) {
+	reqBody := bytes.NewBuffer(nil)
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(reqBody, in); err != nil {
+		return ctx, wrapInternal(err, "failed to marshal json request")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	req, err := newRequest(ctx, url, reqBody, "application/json")
+	if err != nil {
+		return ctx, wrapInternal(err, "could not build request")
+	}
+	ctx, err = callClientRequestPrepared(ctx, hooks, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	req = req.WithContext(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to do request")
+	}
+
+	defer func() {
+		cerr := resp.Body.Close()
+		if err == nil && cerr != nil {
+			err = wrapInternal(cerr, "failed to close response body")
+		}
+	}()
+
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if resp.StatusCode != 200 {
+		return ctx, errorFromResponse(resp)
+	}
+
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(resp.Body, out); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal json response")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+	return ctx, nil
+}
+
+// Call twirp.ServerHooks.RequestReceived if the hook is available
+func callRequestReceived(ctx context.Context, h *twirp.ServerHooks) (context.Context, error) {
+	if h == nil || h.RequestReceived == nil {
+		return ctx, nil
+	}
+	return h.RequestReceived(ctx)
+}
+
+// Call twirp.ServerHooks.RequestRouted if the hook is available
+func callRequestRouted(ctx context.Context, h *twirp.ServerHooks) (context.Context, error) {
+	if h == nil || h.RequestRouted == nil {
+		return ctx, nil
+	}
+	return h.RequestRouted(ctx)
+}
+
+// Call twirp.ServerHooks.ResponsePrepared if the hook is available
+func callResponsePrepared(ctx context.Context, h *twirp.ServerHooks) context.Context {
+	if h == nil || h.ResponsePrepared == nil {
+		return ctx
+	}
+	return h.ResponsePrepared(ctx)
+}
+
+// Call twirp.ServerHooks.ResponseSent if the hook is available
+func callResponseSent(ctx context.Context, h *twirp.ServerHooks) {
+	if h == nil || h.ResponseSent == nil {
+		return
+	}
+	h.ResponseSent(ctx)
+}
+
+// Call twirp.ServerHooks.Error if the hook is available
+func callError(ctx context.Context, h *twirp.ServerHooks, err twirp.Error) context.Context {
+	if h == nil || h.Error == nil {
+		return ctx
+	}
+	return h.Error(ctx, err)
+}
+
+func callClientResponseReceived(ctx context.Context, h *twirp.ClientHooks) {
+	if h == nil || h.ResponseReceived == nil {
+		return
+	}
+	h.ResponseReceived(ctx)
+}
+
+func callClientRequestPrepared(ctx context.Context, h *twirp.ClientHooks, req *http.Request) (context.Context, error) {
+	if h == nil || h.RequestPrepared == nil {
+		return ctx, nil
+	}
+	return h.RequestPrepared(ctx, req)
+}
+
+func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error) {
+	if h == nil || h.Error == nil {
+		return
+	}
+	h.Error(ctx, err)
+}
+
+var twirpFileDescriptor0 = []byte{
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xd1, 0x8e, 0x1b, 0x35,
+	0x14, 0x65, 0x92, 0x4e, 0x76, 0x73, 0xb3, 0x49, 0x53, 0x2b, 0x5d, 0x86, 0x50, 0xa4, 0x74, 0xb6,
+	0x85, 0xd0, 0x87, 0x44, 0x04, 0xaa, 0x95, 0xd0, 0x0a, 0x89, 0xaa, 0x6d, 0x36, 0xea, 0x6e, 0xb6,
+	0x72, 0x8a, 0x40, 0xf0, 0x30, 0x9a, 0x1d, 0x7b, 0xb3, 0x96, 0x32, 0x76, 0xb0, 0x67, 0x48, 0xe1,
+	0x8d, 0xaf, 0xe1, 0x0b, 0xf8, 0x15, 0x3e, 0x81, 0x4f, 0xe0, 0x19, 0xd9, 0xe3, 0x99, 0x24, 0xcb,
+	0x90, 0x76, 0xdf, 0x7c, 0xee, 0x5c, 0xdf, 0x7b, 0x7c, 0x7c, 0x7c, 0x13, 0x78, 0x1a, 0x2e, 0xd9,
+	0x70, 0x29, 0x45, 0x22, 0x86, 0x89, 0x48, 0x25, 0x0f, 0x63, 0xca, 0x93, 0x40, 0x51, 0xf9, 0x0b,
+	0x8b, 0x68, 0x49, 0x68, 0x60, 0x72, 0x11, 0xfa, 0xef, 0x97, 0xee, 0xc3, 0x75, 0x29, 0x49, 0xc3,
+	0x45, 0xc2, 0x62, 0x5a, 0x2c, 0xb2, 0x6d, 0xfe, 0xdf, 0x0e, 0x74, 0xa6, 0x74, 0xf5, 0xa6, 0xd8,
+	0x8c, 0xe9, 0xcf, 0x29, 0x55, 0x09, 0x42, 0x70, 0x47, 0x07, 0x3c, 0xa7, 0xe7, 0xf4, 0xeb, 0xd8,
+	0xac, 0x91, 0x07, 0x7b, 0x0b, 0xfa, 0x96, 0x45, 0x82, 0x7b, 0x15, 0x13, 0xce, 0x21, 0x3a, 0x86,
+	0x83, 0x79, 0x18, 0xd3, 0x40, 0x66, 0xbb, 0xbd, 0x6a, 0xcf, 0xe9, 0x37, 0x46, 0x9d, 0xc1, 0x82,
+	0xad, 0x84, 0x24, 0x6a, 0x30, 0x0e, 0x63, 0x6a, 0x2b, 0xe3, 0xc6, 0x7c, 0x0d, 0xd0, 0x27, 0x00,
+	0x3c, 0x8d, 0x03, 0x29, 0x52, 0x4e, 0x94, 0x77, 0xa7, 0xe7, 0xf4, 0x5d, 0x5c, 0xe7, 0x69, 0x8c,
+	0x4d, 0x00, 0x9d, 0x42, 0x6b, 0x19, 0x32, 0xc9, 0xf8, 0x3c, 0x88, 0x69, 0x72, 0x2d, 0x88, 0xe7,
+	0xf6, 0x9c, 0x7e, 0x6b, 0xf4, 0x70, 0x50, 0x22, 0xc4, 0xeb, 0x2c, 0xf3, 0xdc, 0x24, 0xe2, 0xe6,
+	0x72, 0x13, 0xfa, 0x9f, 0xc1, 0xfd, 0x1b, 0xe7, 0x54, 0x4b, 0xc1, 0x15, 0x45, 0x2d, 0xa8, 0x30,
+	0x62, 0x8f, 0x59, 0x61, 0xc4, 0x3f, 0x81, 0xfb, 0x98, 0xce, 0x99, 0x4a, 0xa8, 0x7c, 0xbd, 0x08,
+	0x7f, 0xa5, 0x32, 0xa7, 0x7a, 0x04, 0xcd, 0x8d, 0xa6, 0xc5, 0x9e, 0x83, 0x75, 0x70, 0x42, 0x7c,
+	0x0f, 0x0e, 0x6f, 0xee, 0xce, 0xfa, 0xf8, 0x27, 0x70, 0xf0, 0x92, 0xbd, 0xa5, 0xc4, 0xb2, 0x44,
+	0x1d, 0x70, 0xaf, 0x98, 0x54, 0x89, 0x2d, 0x93, 0x01, 0x74, 0x08, 0x35, 0x45, 0x23, 0xc1, 0x89,
+	0x55, 0xd8, 0x22, 0xff, 0x77, 0x07, 0xee, 0xcd, 0x92, 0x50, 0x26, 0x46, 0x98, 0xdb, 0x50, 0x42,
+	0x63, 0x68, 0x5d, 0xe9, 0xc6, 0x81, 0x15, 0x44, 0x79, 0x95, 0x5e, 0xb5, 0xdf, 0x18, 0xf5, 0xca,
+	0x34, 0xdc, 0xa4, 0x88, 0x9b, 0x57, 0x1b, 0x48, 0xf9, 0x7f, 0x39, 0xb0, 0x97, 0xb3, 0xff, 0x08,
+	0xf6, 0x53, 0x45, 0x65, 0xc0, 0x88, 0xf2, 0x9c, 0x5e, 0x55, 0x7b, 0x41, 0xe3, 0x09, 0x51, 0xe8,
+	0x01, 0xd4, 0xf5, 0x52, 0x17, 0xce, 0x5a, 0xd5, 0xf1, 0x3a, 0x80, 0x3e, 0x84, 0x3d, 0xe3, 0x14,
+	0x46, 0x8c, 0x49, 0xea, 0xb8, 0xa6, 0xe1, 0x84, 0x98, 0x93, 0x47, 0x42, 0x52, 0xed, 0x82, 0x6a,
+	0xdf, 0xc5, 0x16, 0xe9, 0xf8, 0x8a, 0x71, 0x4e, 0xa5, 0xb9, 0x7a, 0x17, 0x5b, 0xa4, 0x0d, 0x4a,
+	0x04, 0xa7, 0x5e, 0xad, 0xe7, 0xf4, 0xf7, 0xb1, 0x59, 0xa3, 0xa7, 0x00, 0x94, 0x93, 0x40, 0xd2,
+	0x50, 0x09, 0xee, 0xed, 0x19, 0xab, 0x1c, 0x6e, 0x99, 0xf0, 0x85, 0x16, 0x4f, 0x7f, 0xc5, 0x75,
+	0x9a, 0x2f, 0xfd, 0x08, 0xd0, 0xa6, 0xb6, 0xd6, 0x18, 0x1d, 0x70, 0x8d, 0x2d, 0x8d, 0xa8, 0x2e,
+	0xce, 0x00, 0x3a, 0x86, 0xfd, 0x1b, 0x3a, 0x7e, 0xbc, 0xc3, 0x8b, 0xb8, 0x48, 0xf6, 0xcf, 0xe0,
+	0xae, 0x0d, 0xaa, 0x5b, 0x5d, 0x5f, 0x41, 0xa3, 0xb2, 0x41, 0xc3, 0x7f, 0x05, 0xed, 0x75, 0x35,
+	0x4b, 0x78, 0x93, 0x9a, 0x73, 0x1b, 0x6a, 0xc7, 0xd0, 0x9e, 0x25, 0x21, 0x27, 0xb7, 0xe5, 0xe6,
+	0xff, 0xe9, 0xc0, 0x7e, 0xbe, 0x53, 0xdf, 0xac, 0xb5, 0x84, 0xcd, 0xad, 0x65, 0x8e, 0x40, 0xdd,
+	0xcc, 0x2b, 0x66, 0x9c, 0x64, 0xae, 0x2e, 0xb0, 0xbe, 0xc5, 0x15, 0xe3, 0xca, 0x78, 0xc1, 0xc5,
+	0x66, 0xad, 0x6f, 0x7c, 0x21, 0x94, 0xa2, 0xf9, 0x3c, 0xb0, 0x48, 0x2b, 0x41, 0x64, 0xb8, 0x52,
+	0xd6, 0x08, 0x19, 0xd0, 0xd9, 0x6a, 0x29, 0x69, 0x48, 0x8c, 0x13, 0x5c, 0x6c, 0x91, 0xee, 0x7a,
+	0x99, 0x46, 0xd7, 0xd7, 0x62, 0xf1, 0x9b, 0x71, 0x82, 0x83, 0x0b, 0xec, 0xff, 0x91, 0xbd, 0x26,
+	0x4e, 0xb6, 0xf4, 0x2b, 0x1b, 0x79, 0xdb, 0xf3, 0xa9, 0x72, 0x73, 0x3e, 0x1d, 0x41, 0x33, 0x4a,
+	0xa5, 0xd4, 0x12, 0x65, 0x97, 0x94, 0x9d, 0xe3, 0xc0, 0x06, 0x4d, 0x16, 0xfa, 0x1a, 0xea, 0x2a,
+	0x6f, 0x66, 0xcc, 0xdd, 0x18, 0x3d, 0x28, 0xbb, 0x98, 0x9c, 0x11, 0x5e, 0xa7, 0x3f, 0x39, 0x87,
+	0xe6, 0xd6, 0x58, 0x43, 0x00, 0x35, 0xfc, 0xed, 0xf4, 0xf9, 0xc5, 0x79, 0xfb, 0x03, 0xd4, 0x81,
+	0xf6, 0xab, 0xc9, 0x74, 0x1c, 0x5c, 0xbc, 0x0c, 0xde, 0x9c, 0xbe, 0x08, 0x4e, 0x27, 0x67, 0x67,
+	0x6d, 0x07, 0xdd, 0x85, 0x06, 0xbe, 0xf8, 0x6e, 0xfa, 0x3c, 0xc0, 0x17, 0xcf, 0x26, 0xd3, 0x76,
+	0x05, 0xd5, 0xc1, 0x9d, 0x7d, 0x3f, 0x99, 0xcd, 0xda, 0xd5, 0xd1, 0x3f, 0x55, 0xb8, 0xb7, 0x9e,
+	0x81, 0xb3, 0xac, 0x31, 0x22, 0xd0, 0xdc, 0x9a, 0x8d, 0xa8, 0x5f, 0x46, 0xaf, 0xec, 0x67, 0xa2,
+	0xfb, 0xf9, 0x7b, 0x64, 0x5a, 0x79, 0xe7, 0xd0, 0xda, 0x1e, 0x8d, 0xa8, 0x74, 0x73, 0xe9, 0xf0,
+	0xed, 0x3e, 0x79, 0x9f, 0x54, 0xdb, 0xe8, 0x27, 0x80, 0xf5, 0x73, 0x46, 0x8f, 0xff, 0x47, 0xea,
+	0xed, 0x51, 0xda, 0xfd, 0xf4, 0x5d, 0x69, 0xb6, 0xf8, 0x0f, 0xd0, 0x18, 0xd3, 0x24, 0x7f, 0x7b,
+	0xe8, 0x68, 0xc7, 0x0b, 0xcb, 0xdf, 0x52, 0xf7, 0xd1, 0xee, 0xa4, 0x82, 0xf6, 0xc1, 0x98, 0x26,
+	0x85, 0x2d, 0xd1, 0xa3, 0x5d, 0x1e, 0x29, 0x6a, 0x3f, 0x7e, 0x47, 0x56, 0x56, 0xfc, 0xd9, 0x37,
+	0x3f, 0x9e, 0xcc, 0x59, 0x72, 0x9d, 0x5e, 0x0e, 0x22, 0x11, 0x0f, 0x89, 0x88, 0x19, 0x17, 0x5f,
+	0x7c, 0x35, 0xb4, 0xa3, 0x71, 0x28, 0x97, 0xd1, 0x70, 0xd7, 0xff, 0x8e, 0xcb, 0x9a, 0xf9, 0xf2,
+	0xe5, 0xbf, 0x03, 0x00, 0x8b, 0x3f, 0x3e, 0x4d, 0x9e, 0x08, 0x00, 0x00,
+}
@@ -2335,6 +2335,10 @@ type ProfileService interface {
 	GetStats(context.Context, *StatsRequest) (*StatsResponse, error)
 
 	GetProfile(context.Context, *ProfileRequest) (*ProfileResponse, error)
+
+	// GetRatingHistory returns a user's rating after each of their rated
+	// games in one variant, oldest first.
+	GetRatingHistory(context.Context, *RatingHistoryRequest) (*RatingHistoryResponse, error)
 }
 
 // ==============================
@@ -2343,7 +2347,7 @@ type ProfileService interface {
 
 type profileServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [3]string
+	urls        [4]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -2363,10 +2367,11 @@ func NewProfileServiceProtobufClient(baseURL string, client HTTPClient, opts ...
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "user_service", "ProfileService")
-	urls := [3]string{
+	urls := [4]string{
 		serviceURL + "GetRatings",
 		serviceURL + "GetStats",
 		serviceURL + "GetProfile",
+		serviceURL + "GetRatingHistory",
 	}
 
 	return &profileServiceProtobufClient{
@@ -2515,13 +2520,59 @@ func (c *profileServiceProtobufClient) callGetProfile(ctx context.Context, in *P
 	return out, nil
 }
 
+func (c *profileServiceProtobufClient) GetRatingHistory(ctx context.Context, in *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingHistory")
+	caller := c.callGetRatingHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatingHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatingHistoryRequest) when calling interceptor")
+					}
+					return c.callGetRatingHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *profileServiceProtobufClient) callGetRatingHistory(ctx context.Context, in *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+	out := new(RatingHistoryResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ==========================
 // ProfileService JSON Client
 // ==========================
 
 type profileServiceJSONClient struct {
 	client      HTTPClient
-	urls        [3]string
+	urls        [4]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -2541,10 +2592,11 @@ func NewProfileServiceJSONClient(baseURL string, client HTTPClient, opts ...twir
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "user_service", "ProfileService")
-	urls := [3]string{
+	urls := [4]string{
 		serviceURL + "GetRatings",
 		serviceURL + "GetStats",
 		serviceURL + "GetProfile",
+		serviceURL + "GetRatingHistory",
 	}
 
 	return &profileServiceJSONClient{
@@ -2693,6 +2745,52 @@ func (c *profileServiceJSONClient) callGetProfile(ctx context.Context, in *Profi
 	return out, nil
 }
 
+func (c *profileServiceJSONClient) GetRatingHistory(ctx context.Context, in *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingHistory")
+	caller := c.callGetRatingHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatingHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatingHistoryRequest) when calling interceptor")
+					}
+					return c.callGetRatingHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *profileServiceJSONClient) callGetRatingHistory(ctx context.Context, in *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+	out := new(RatingHistoryResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // =============================
 // ProfileService Server Handler
 // =============================
@@ -2786,6 +2884,9 @@ func (s *profileServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Req
 	case "GetProfile":
 		s.serveGetProfile(ctx, resp, req)
 		return
+	case "GetRatingHistory":
+		s.serveGetRatingHistory(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -3318,6 +3419,181 @@ func (s *profileServiceServer) serveGetProfileProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *profileServiceServer) serveGetRatingHistory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRatingHistoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRatingHistoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *profileServiceServer) serveGetRatingHistoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(RatingHistoryRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.ProfileService.GetRatingHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatingHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatingHistoryRequest) when calling interceptor")
+					}
+					return s.ProfileService.GetRatingHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RatingHistoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RatingHistoryResponse and nil error while calling GetRatingHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *profileServiceServer) serveGetRatingHistoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(RatingHistoryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProfileService.GetRatingHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatingHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatingHistoryRequest) when calling interceptor")
+					}
+					return s.ProfileService.GetRatingHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RatingHistoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RatingHistoryResponse and nil error while calling GetRatingHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *profileServiceServer) ServiceDescriptor() ([]byte, int) {
 	return twirpFileDescriptor0, 2
 }
@@ -3333,6 +3609,743 @@ func (s *profileServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "user_service", "ProfileService")
 }
 
+// ============================
+// LeaderboardService Interface
+// ============================
+
+// A leaderboard ranks the users with a known rating in a variant, who have
+// played a rated game in it recently. Bots aren't on leaderboards.
+type LeaderboardService interface {
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error)
+
+	// GetLeaderboardPosition finds a user on a leaderboard.
+	GetLeaderboardPosition(context.Context, *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error)
+}
+
+// ==================================
+// LeaderboardService Protobuf Client
+// ==================================
+
+type leaderboardServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [2]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewLeaderboardServiceProtobufClient creates a Protobuf client that implements the LeaderboardService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewLeaderboardServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) LeaderboardService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "user_service", "LeaderboardService")
+	urls := [2]string{
+		serviceURL + "GetLeaderboard",
+		serviceURL + "GetLeaderboardPosition",
+	}
+
+	return &leaderboardServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *leaderboardServiceProtobufClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest) (*LeaderboardResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "LeaderboardService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	caller := c.callGetLeaderboard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardRequest) when calling interceptor")
+					}
+					return c.callGetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *leaderboardServiceProtobufClient) callGetLeaderboard(ctx context.Context, in *LeaderboardRequest) (*LeaderboardResponse, error) {
+	out := new(LeaderboardResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *leaderboardServiceProtobufClient) GetLeaderboardPosition(ctx context.Context, in *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "LeaderboardService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboardPosition")
+	caller := c.callGetLeaderboardPosition
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardPositionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardPositionRequest) when calling interceptor")
+					}
+					return c.callGetLeaderboardPosition(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardPositionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardPositionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *leaderboardServiceProtobufClient) callGetLeaderboardPosition(ctx context.Context, in *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error) {
+	out := new(LeaderboardPositionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==============================
+// LeaderboardService JSON Client
+// ==============================
+
+type leaderboardServiceJSONClient struct {
+	client      HTTPClient
+	urls        [2]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewLeaderboardServiceJSONClient creates a JSON client that implements the LeaderboardService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewLeaderboardServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) LeaderboardService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(clientOpts.PathPrefix(), "user_service", "LeaderboardService")
+	urls := [2]string{
+		serviceURL + "GetLeaderboard",
+		serviceURL + "GetLeaderboardPosition",
+	}
+
+	return &leaderboardServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *leaderboardServiceJSONClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest) (*LeaderboardResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "LeaderboardService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	caller := c.callGetLeaderboard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardRequest) when calling interceptor")
+					}
+					return c.callGetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *leaderboardServiceJSONClient) callGetLeaderboard(ctx context.Context, in *LeaderboardRequest) (*LeaderboardResponse, error) {
+	out := new(LeaderboardResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *leaderboardServiceJSONClient) GetLeaderboardPosition(ctx context.Context, in *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "LeaderboardService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboardPosition")
+	caller := c.callGetLeaderboardPosition
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardPositionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardPositionRequest) when calling interceptor")
+					}
+					return c.callGetLeaderboardPosition(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardPositionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardPositionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *leaderboardServiceJSONClient) callGetLeaderboardPosition(ctx context.Context, in *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error) {
+	out := new(LeaderboardPositionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =================================
+// LeaderboardService Server Handler
+// =================================
+
+type leaderboardServiceServer struct {
+	LeaderboardService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+}
+
+// NewLeaderboardServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewLeaderboardServiceServer(svc LeaderboardService, opts ...interface{}) TwirpServer {
+	serverOpts := twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(&serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(&serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T on NewLeaderboardServiceServer", o))
+		}
+	}
+
+	return &leaderboardServiceServer{
+		LeaderboardService: svc,
+		pathPrefix:         serverOpts.PathPrefix(),
+		interceptor:        twirp.ChainInterceptors(serverOpts.Interceptors...),
+		hooks:              serverOpts.Hooks,
+		jsonSkipDefaults:   serverOpts.JSONSkipDefaults,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *leaderboardServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// LeaderboardServicePathPrefix is a convenience constant that could used to identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// that add a "/twirp" prefix by default, and use CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const LeaderboardServicePathPrefix = "/twirp/user_service.LeaderboardService/"
+
+func (s *leaderboardServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "LeaderboardService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "user_service.LeaderboardService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetLeaderboard":
+		s.serveGetLeaderboard(ctx, resp, req)
+		return
+	case "GetLeaderboardPosition":
+		s.serveGetLeaderboardPosition(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *leaderboardServiceServer) serveGetLeaderboard(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetLeaderboardJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetLeaderboardProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *leaderboardServiceServer) serveGetLeaderboardJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(LeaderboardRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.LeaderboardService.GetLeaderboard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardRequest) when calling interceptor")
+					}
+					return s.LeaderboardService.GetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LeaderboardResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LeaderboardResponse and nil error while calling GetLeaderboard. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *leaderboardServiceServer) serveGetLeaderboardProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(LeaderboardRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.LeaderboardService.GetLeaderboard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardRequest) when calling interceptor")
+					}
+					return s.LeaderboardService.GetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LeaderboardResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LeaderboardResponse and nil error while calling GetLeaderboard. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *leaderboardServiceServer) serveGetLeaderboardPosition(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetLeaderboardPositionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetLeaderboardPositionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *leaderboardServiceServer) serveGetLeaderboardPositionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboardPosition")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(LeaderboardPositionRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.LeaderboardService.GetLeaderboardPosition
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardPositionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardPositionRequest) when calling interceptor")
+					}
+					return s.LeaderboardService.GetLeaderboardPosition(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardPositionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardPositionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LeaderboardPositionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LeaderboardPositionResponse and nil error while calling GetLeaderboardPosition. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *leaderboardServiceServer) serveGetLeaderboardPositionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboardPosition")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(LeaderboardPositionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.LeaderboardService.GetLeaderboardPosition
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LeaderboardPositionRequest) (*LeaderboardPositionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardPositionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardPositionRequest) when calling interceptor")
+					}
+					return s.LeaderboardService.GetLeaderboardPosition(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardPositionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardPositionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LeaderboardPositionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LeaderboardPositionResponse and nil error while calling GetLeaderboardPosition. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *leaderboardServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 3
+}
+
+func (s *leaderboardServiceServer) ProtocGenTwirpVersion() string {
+	return "v7.1.0"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *leaderboardServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "user_service", "LeaderboardService")
+}
+
 // =====
 // Utils
 // =====
@@ -3880,53 +4893,77 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 761 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xed, 0x4e, 0x13, 0x4d,
-	0x14, 0x4e, 0x5f, 0x28, 0xd0, 0x43, 0x29, 0x65, 0x28, 0x2f, 0x7d, 0x97, 0x17, 0x85, 0x45, 0xe2,
-	0x17, 0xa1, 0x52, 0x8d, 0xf1, 0x8f, 0x3f, 0x14, 0x13, 0x84, 0x18, 0x43, 0x5a, 0xf1, 0x87, 0xc6,
-	0x34, 0xdb, 0xdd, 0xa1, 0x8c, 0x6c, 0x77, 0xea, 0xcc, 0xac, 0xc4, 0xfb, 0xf0, 0x0a, 0xbc, 0x30,
-	0x6f, 0xc0, 0x9b, 0x30, 0xf3, 0x55, 0x76, 0xb6, 0xa5, 0x36, 0xf1, 0x5f, 0xcf, 0x73, 0xce, 0x3c,
-	0xe7, 0xec, 0xf9, 0x78, 0x00, 0xee, 0x07, 0x03, 0xd2, 0x18, 0x30, 0x2a, 0x68, 0x23, 0xe5, 0x98,
-	0x75, 0x38, 0x66, 0x5f, 0x49, 0x88, 0x1d, 0x63, 0x5f, 0xf9, 0x51, 0x39, 0x8b, 0xf9, 0x27, 0x50,
-	0x3d, 0xe3, 0x98, 0xbd, 0xa1, 0x3d, 0x92, 0xb4, 0xf0, 0x97, 0x14, 0x73, 0x81, 0x3c, 0x58, 0x90,
-	0x31, 0x49, 0xd0, 0xc7, 0xf5, 0xc2, 0x56, 0xe1, 0x5e, 0xa9, 0x35, 0xb4, 0xa5, 0x6f, 0x10, 0x70,
-	0x7e, 0x45, 0x59, 0x54, 0xff, 0x47, 0xfb, 0xac, 0xed, 0x7f, 0x82, 0xb5, 0xc3, 0x8b, 0x20, 0xe9,
-	0xe1, 0x53, 0x83, 0x58, 0xc2, 0x6d, 0x28, 0xd3, 0x38, 0xea, 0x0c, 0x1f, 0x6a, 0xd2, 0x45, 0x1a,
-	0x47, 0x36, 0x52, 0x86, 0x24, 0xf8, 0xaa, 0x93, 0xe3, 0x5e, 0x4c, 0xf0, 0x95, 0x0d, 0xf1, 0x5f,
-	0xc3, 0x92, 0x29, 0x93, 0x0f, 0x68, 0xc2, 0x31, 0xaa, 0xc3, 0x7c, 0x1f, 0x73, 0x1e, 0xf4, 0x6c,
-	0x99, 0xd6, 0x44, 0x9b, 0x00, 0x1c, 0x73, 0x4e, 0x68, 0xd2, 0x21, 0x96, 0xab, 0x64, 0x90, 0xe3,
-	0xc8, 0xaf, 0xc3, 0xbf, 0xf9, 0x42, 0x35, 0xa5, 0x7f, 0x00, 0xff, 0xb5, 0x30, 0xc7, 0x22, 0xf7,
-	0x05, 0x6d, 0x81, 0x07, 0x07, 0xa8, 0x06, 0x45, 0xdc, 0x0f, 0x48, 0x6c, 0xb2, 0x69, 0xc3, 0x7f,
-	0x7f, 0xf3, 0x93, 0xa6, 0xd3, 0xae, 0x82, 0xdb, 0x2e, 0x59, 0x24, 0x93, 0x0f, 0x3b, 0x21, 0x8d,
-	0xb0, 0x2d, 0x52, 0x21, 0x87, 0x34, 0xc2, 0xfe, 0x3a, 0xac, 0xe5, 0x78, 0x4d, 0x8d, 0x35, 0x40,
-	0x6d, 0x1a, 0x5e, 0x62, 0xf1, 0x8e, 0x5e, 0x62, 0x3b, 0x34, 0xff, 0x39, 0xac, 0x3a, 0xa8, 0xe9,
-	0x51, 0x0d, 0x8a, 0x42, 0x02, 0xb6, 0x66, 0x65, 0xa0, 0x2a, 0xcc, 0x84, 0xc3, 0xc6, 0xc8, 0x9f,
-	0xfe, 0x2a, 0xac, 0x98, 0x3d, 0xa0, 0xa9, 0xb0, 0x9c, 0x55, 0xa8, 0x58, 0xc0, 0xe4, 0xfe, 0x5e,
-	0x80, 0x75, 0x19, 0xd7, 0xc2, 0x3d, 0xc2, 0x05, 0x0b, 0x04, 0xa1, 0x7f, 0xbb, 0x36, 0xd7, 0x6d,
-	0x9d, 0xc9, 0xb4, 0x15, 0x3d, 0x84, 0x15, 0x96, 0x49, 0xa2, 0x9b, 0x34, 0xab, 0x22, 0xaa, 0x59,
-	0x87, 0xea, 0xd5, 0x23, 0xa8, 0xb9, 0x15, 0xfd, 0x69, 0x43, 0xfc, 0x3d, 0xa8, 0xb4, 0x02, 0x41,
-	0x92, 0x1e, 0x9f, 0xa2, 0x7c, 0x7f, 0x17, 0x96, 0x87, 0xd1, 0x86, 0x1a, 0xc1, 0xec, 0x67, 0x4e,
-	0x6d, 0x5f, 0xd5, 0x6f, 0xff, 0x01, 0x94, 0xdb, 0x22, 0x10, 0x53, 0x51, 0xee, 0xc0, 0x92, 0x89,
-	0x9d, 0x40, 0xb8, 0x07, 0x95, 0x53, 0x46, 0xcf, 0x49, 0x8c, 0xa7, 0xa1, 0xfc, 0x59, 0x80, 0xe5,
-	0x61, 0xb8, 0x61, 0xdd, 0x04, 0x38, 0x27, 0x8c, 0x8b, 0x4e, 0xe6, 0x45, 0x49, 0x21, 0x6f, 0xe5,
-	0x5c, 0x36, 0xa0, 0x14, 0x07, 0xd6, 0x6b, 0x06, 0x23, 0x01, 0xe5, 0xdc, 0x86, 0x72, 0x48, 0xd3,
-	0x44, 0xb0, 0x6f, 0xba, 0xfb, 0x7a, 0x3e, 0x8b, 0x06, 0x93, 0x8d, 0x57, 0xeb, 0x45, 0x44, 0x6c,
-	0x27, 0xa3, 0x0d, 0x89, 0x06, 0x5d, 0x9a, 0x8a, 0x7a, 0x51, 0xa3, 0xca, 0x90, 0x74, 0x4c, 0x37,
-	0xb1, 0xa3, 0x3e, 0x74, 0x4e, 0xd3, 0x19, 0xec, 0x84, 0xd3, 0x44, 0xdd, 0xad, 0x6c, 0x8a, 0x0e,
-	0x98, 0x37, 0x77, 0x2b, 0x11, 0xe9, 0x6e, 0xfe, 0x98, 0x85, 0xb5, 0x17, 0xa9, 0xb8, 0xc0, 0x89,
-	0x20, 0xa1, 0x9a, 0x74, 0x5b, 0xcb, 0x18, 0x7a, 0x05, 0x45, 0xa5, 0x0d, 0xe8, 0xd6, 0xbe, 0x23,
-	0x79, 0x79, 0x6d, 0xf3, 0x36, 0x5c, 0xbf, 0x2b, 0x28, 0x47, 0x30, 0xa7, 0xf7, 0x1d, 0xdd, 0x1e,
-	0x4b, 0x73, 0x7d, 0x1a, 0xde, 0xff, 0x23, 0x3c, 0x99, 0x33, 0x41, 0x67, 0x50, 0x39, 0xc2, 0x22,
-	0x73, 0x8f, 0x68, 0xcb, 0x8d, 0x1f, 0x3d, 0x60, 0x6f, 0x7b, 0x42, 0x84, 0xa1, 0xed, 0x02, 0x72,
-	0x24, 0x41, 0xcb, 0xd2, 0x5d, 0xf7, 0xe1, 0x8d, 0xfa, 0xe5, 0xed, 0x4c, 0x0c, 0x9c, 0x90, 0xa3,
-	0x39, 0x6d, 0x8e, 0xe6, 0x74, 0x39, 0x3e, 0x42, 0xc5, 0xd5, 0x5f, 0x94, 0x7b, 0x36, 0xf6, 0xcf,
-	0x88, 0x77, 0x67, 0x72, 0x90, 0x26, 0x6f, 0xc6, 0xb0, 0x9a, 0xd5, 0x02, 0xbb, 0x21, 0x67, 0xb0,
-	0xa0, 0x61, 0xcc, 0xd0, 0xee, 0xe8, 0x74, 0xc7, 0x08, 0x9a, 0xe7, 0xe7, 0xbf, 0x65, 0x54, 0x61,
-	0x9a, 0xbf, 0x0a, 0xc3, 0x13, 0xb5, 0x99, 0x8e, 0x01, 0x8e, 0xb0, 0x30, 0x7a, 0x81, 0x72, 0x8b,
-	0xe2, 0x8a, 0x8e, 0xb7, 0x79, 0x83, 0xd7, 0x34, 0xea, 0x10, 0x16, 0xe4, 0x1e, 0xc9, 0x03, 0x40,
-	0x5e, 0x6e, 0x3f, 0x32, 0x42, 0x93, 0xdf, 0x6a, 0x57, 0x58, 0x74, 0x3d, 0xa6, 0xc8, 0x7c, 0x3d,
-	0xae, 0xbc, 0xe4, 0xeb, 0xc9, 0xa9, 0xc9, 0xcb, 0x67, 0x1f, 0x9e, 0xf6, 0x88, 0xb8, 0x48, 0xbb,
-	0xfb, 0x21, 0xed, 0x37, 0x22, 0xda, 0x27, 0x09, 0x3d, 0x78, 0xd2, 0x88, 0x89, 0x9c, 0x00, 0x6f,
-	0xb0, 0x41, 0xd8, 0x18, 0xff, 0x8f, 0x48, 0x77, 0x4e, 0x61, 0x8f, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x8e, 0x7a, 0xff, 0x0d, 0xa9, 0x08, 0x00, 0x00,
+	// 1146 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x4e, 0x1b, 0xc7,
+	0x17, 0x97, 0x31, 0x36, 0x70, 0x4c, 0xc0, 0x0c, 0x9f, 0x59, 0xc2, 0x3f, 0x30, 0xfc, 0xa3, 0x42,
+	0x1b, 0xe1, 0xe2, 0x46, 0x55, 0x6e, 0x7a, 0xd1, 0x92, 0x8a, 0x90, 0x46, 0x15, 0x5a, 0x42, 0xa5,
+	0xb6, 0xaa, 0xdc, 0xb1, 0x3d, 0x36, 0x53, 0x76, 0x77, 0xdc, 0x99, 0x31, 0x84, 0x8b, 0xbe, 0x45,
+	0x1f, 0xa0, 0xea, 0x3b, 0xf4, 0x1d, 0xfa, 0x0c, 0xbd, 0xe8, 0xb3, 0x54, 0xf3, 0x65, 0x76, 0xd7,
+	0x1f, 0x58, 0xca, 0xdd, 0x9e, 0x8f, 0xf9, 0xcd, 0x39, 0xbf, 0x73, 0x66, 0xce, 0x2c, 0x1c, 0x92,
+	0x1e, 0xab, 0xf5, 0x04, 0x57, 0xbc, 0xd6, 0x97, 0x54, 0x34, 0x24, 0x15, 0x37, 0xac, 0x45, 0x33,
+	0xc2, 0x91, 0xb1, 0xa3, 0xc5, 0xb4, 0x0e, 0xbf, 0x81, 0xea, 0xa5, 0xa4, 0xe2, 0x2d, 0xef, 0xb2,
+	0x24, 0xa4, 0xbf, 0xf6, 0xa9, 0x54, 0x28, 0x80, 0x79, 0xed, 0x93, 0x90, 0x98, 0x6e, 0x15, 0x76,
+	0x0b, 0x07, 0x0b, 0xe1, 0x40, 0xd6, 0xb6, 0x1e, 0x91, 0xf2, 0x96, 0x8b, 0xf6, 0xd6, 0x8c, 0xb5,
+	0x79, 0x19, 0xff, 0x04, 0xeb, 0x27, 0x57, 0x24, 0xe9, 0xd2, 0x73, 0xa7, 0xf1, 0x80, 0x7b, 0xb0,
+	0xc8, 0xa3, 0x76, 0x63, 0xb0, 0xd0, 0x82, 0x56, 0x78, 0xd4, 0xf6, 0x9e, 0xda, 0x25, 0xa1, 0xb7,
+	0x8d, 0x1c, 0x76, 0x25, 0xa1, 0xb7, 0xde, 0x05, 0xbf, 0x86, 0x47, 0x2e, 0x4c, 0xd9, 0xe3, 0x89,
+	0xa4, 0x68, 0x0b, 0xe6, 0x62, 0x2a, 0x25, 0xe9, 0xfa, 0x30, 0xbd, 0x88, 0x76, 0x00, 0x24, 0x95,
+	0x92, 0xf1, 0xa4, 0xc1, 0x3c, 0xd6, 0x82, 0xd3, 0x9c, 0xb5, 0xf1, 0x16, 0x6c, 0xe4, 0x03, 0xb5,
+	0x90, 0xf8, 0x18, 0x1e, 0x87, 0x54, 0x52, 0x95, 0xcb, 0xe0, 0x42, 0xd1, 0xde, 0x31, 0x5a, 0x83,
+	0x12, 0x8d, 0x09, 0x8b, 0xdc, 0x6e, 0x56, 0xc0, 0xdf, 0x8d, 0x5f, 0x52, 0xcf, 0xd0, 0x55, 0xc8,
+	0xd2, 0xa5, 0x83, 0x14, 0x7a, 0x61, 0xa3, 0xc5, 0xdb, 0xd4, 0x07, 0x69, 0x34, 0x27, 0xbc, 0x4d,
+	0xf1, 0x26, 0xac, 0xe7, 0x70, 0x5d, 0x8c, 0x6b, 0x80, 0x2e, 0x78, 0xeb, 0x9a, 0xaa, 0x77, 0xfc,
+	0x9a, 0xfa, 0xa2, 0xe1, 0x2f, 0x60, 0x35, 0xa3, 0x75, 0x1c, 0xad, 0x41, 0x49, 0x69, 0x85, 0x8f,
+	0xd9, 0x08, 0xa8, 0x0a, 0xc5, 0xd6, 0x80, 0x18, 0xfd, 0x89, 0x57, 0x61, 0xc5, 0xf5, 0x01, 0xef,
+	0x2b, 0x8f, 0x59, 0x85, 0x25, 0xaf, 0x70, 0x7b, 0xff, 0x5e, 0x80, 0x4d, 0xed, 0x17, 0xd2, 0x2e,
+	0x93, 0x4a, 0x10, 0xc5, 0xf8, 0x87, 0xb6, 0xcd, 0x3d, 0xad, 0xc5, 0x14, 0xad, 0xe8, 0x13, 0x58,
+	0x11, 0xa9, 0x4d, 0x2c, 0x49, 0xb3, 0xc6, 0xa3, 0x9a, 0x36, 0x18, 0xae, 0x3e, 0x85, 0xb5, 0x6c,
+	0x44, 0x0f, 0x75, 0x08, 0x7e, 0x0e, 0x4b, 0x21, 0x51, 0x2c, 0xe9, 0xca, 0x29, 0xc2, 0xc7, 0xcf,
+	0x60, 0x79, 0xe0, 0xed, 0xa0, 0x11, 0xcc, 0xfe, 0x22, 0xb9, 0xe7, 0xd5, 0x7c, 0xe3, 0x8f, 0x61,
+	0xf1, 0x42, 0x11, 0x35, 0x15, 0xe4, 0x3e, 0x3c, 0x72, 0xbe, 0x13, 0x00, 0x9f, 0xc3, 0xd2, 0xb9,
+	0xe0, 0x1d, 0x16, 0xd1, 0x69, 0x20, 0xff, 0x2d, 0xc0, 0xf2, 0xc0, 0xdd, 0xa1, 0xee, 0x00, 0x74,
+	0x98, 0x90, 0xaa, 0x91, 0x5a, 0xb1, 0x60, 0x34, 0xdf, 0xea, 0xba, 0x6c, 0xc3, 0x42, 0x44, 0xbc,
+	0xd5, 0x15, 0x26, 0x22, 0xce, 0xb8, 0x07, 0x8b, 0x2d, 0xde, 0x4f, 0x94, 0xb8, 0xb3, 0xec, 0xdb,
+	0xfa, 0x54, 0x9c, 0x4e, 0x13, 0x6f, 0xda, 0x8b, 0xa9, 0xc8, 0x57, 0xc6, 0x0a, 0x5a, 0x4b, 0x9a,
+	0xbc, 0xaf, 0xb6, 0x4a, 0x56, 0x6b, 0x04, 0x0d, 0x27, 0x2c, 0x89, 0x0d, 0x93, 0x68, 0xd9, 0xc2,
+	0x39, 0xdd, 0x1b, 0xc9, 0x13, 0x73, 0x6e, 0x35, 0x29, 0xd6, 0x61, 0xce, 0x9d, 0x5b, 0xad, 0xd1,
+	0x66, 0xfc, 0x47, 0x01, 0xd6, 0x6c, 0x1d, 0x5e, 0x33, 0xa9, 0xb8, 0xb8, 0x9b, 0xa6, 0xf5, 0x9e,
+	0x42, 0xe5, 0x86, 0x08, 0x46, 0x12, 0xd5, 0xb8, 0xa6, 0x77, 0x2e, 0x49, 0x70, 0xaa, 0x6f, 0xe8,
+	0x9d, 0x89, 0xb6, 0xa3, 0xa8, 0x30, 0xf9, 0x15, 0x43, 0x2b, 0xa0, 0x0d, 0x28, 0x37, 0x69, 0x87,
+	0x0b, 0x9b, 0x5a, 0x31, 0x74, 0x92, 0x0e, 0x31, 0x26, 0xef, 0x1b, 0x3d, 0xce, 0x12, 0x25, 0x4d,
+	0x82, 0xa5, 0x70, 0x21, 0x26, 0xef, 0xcf, 0x8d, 0x02, 0xff, 0x06, 0x15, 0x1b, 0xa1, 0x91, 0xd1,
+	0x26, 0xcc, 0x75, 0x49, 0x4c, 0xf5, 0x2d, 0x64, 0xe3, 0x2a, 0x6b, 0xf1, 0xac, 0xad, 0xab, 0xad,
+	0x98, 0xe3, 0xbc, 0x18, 0x9a, 0x6f, 0xbd, 0xa5, 0x25, 0xc3, 0x44, 0x52, 0x0a, 0x9d, 0x84, 0x0e,
+	0xa1, 0x6a, 0xbf, 0x1a, 0x6d, 0x7a, 0xc3, 0x4c, 0x87, 0x9b, 0xa0, 0x4a, 0xe1, 0xb2, 0xd5, 0xbf,
+	0xf2, 0x6a, 0xfc, 0x33, 0xac, 0xe7, 0x08, 0x72, 0x7d, 0x70, 0x0c, 0x65, 0x17, 0x72, 0x61, 0xb7,
+	0x78, 0x50, 0xa9, 0x3f, 0x3e, 0xca, 0x8c, 0x86, 0x54, 0xcc, 0xa1, 0x73, 0xb4, 0x57, 0x87, 0x22,
+	0x91, 0x89, 0xb1, 0x14, 0x5a, 0x01, 0xb7, 0x00, 0xbd, 0xa5, 0xa4, 0x4d, 0x45, 0x93, 0x93, 0xfb,
+	0x1b, 0x3e, 0x47, 0x72, 0x61, 0x14, 0xc9, 0x11, 0x8b, 0x99, 0xf2, 0x60, 0x46, 0xd0, 0x19, 0xf3,
+	0x4e, 0x47, 0x52, 0xe5, 0x33, 0xb6, 0x12, 0xfe, 0xab, 0x00, 0xd5, 0xd4, 0x2e, 0x5f, 0xeb, 0x7e,
+	0xd3, 0x94, 0x09, 0x92, 0x5c, 0x1b, 0xf0, 0x52, 0x68, 0xbe, 0x33, 0x85, 0x9f, 0xc9, 0x15, 0xfe,
+	0xc3, 0xe9, 0x44, 0x47, 0xb0, 0x6a, 0x8e, 0x87, 0xa9, 0xa1, 0xae, 0x91, 0x54, 0x24, 0xee, 0x99,
+	0xaa, 0x17, 0xc3, 0x15, 0x6d, 0x3a, 0x25, 0x31, 0x7d, 0xe7, 0x0d, 0x98, 0xc2, 0x6a, 0x86, 0x1c,
+	0x47, 0xfe, 0x4b, 0x98, 0xa3, 0x89, 0x12, 0x8c, 0x7a, 0xf6, 0xff, 0x97, 0x65, 0x3f, 0x9f, 0x6a,
+	0xe8, 0xdd, 0xc7, 0xd4, 0xe0, 0x7b, 0x08, 0x52, 0x4b, 0xce, 0xb9, 0x64, 0xe9, 0x7b, 0xf8, 0xc1,
+	0x5a, 0x4c, 0x20, 0x0d, 0x33, 0xd8, 0x1e, 0x09, 0xed, 0x32, 0x79, 0x01, 0x25, 0x1d, 0x9a, 0x45,
+	0x7d, 0x38, 0x0f, 0xeb, 0x3c, 0x3a, 0x8b, 0xfa, 0x9f, 0xb3, 0xb0, 0xfe, 0x65, 0x5f, 0x5d, 0xd1,
+	0x44, 0xb1, 0x96, 0xe1, 0xfb, 0xc2, 0xe2, 0xa0, 0x57, 0x50, 0x32, 0x93, 0x1e, 0xe5, 0xf0, 0xf3,
+	0x2f, 0x95, 0x60, 0x3b, 0xb7, 0x7f, 0xe6, 0x79, 0x70, 0x0a, 0x65, 0x3b, 0xbd, 0xd0, 0xd3, 0x91,
+	0x30, 0xf7, 0x83, 0x2e, 0x78, 0x32, 0x84, 0x93, 0x1a, 0x7a, 0xe8, 0x12, 0x96, 0x4e, 0xa9, 0x4a,
+	0x4d, 0x57, 0xb4, 0x9b, 0xf5, 0x1f, 0x1e, 0xc7, 0xc1, 0xde, 0x04, 0x0f, 0x07, 0xdb, 0x04, 0x94,
+	0x19, 0xf0, 0xf6, 0x91, 0xf1, 0x51, 0xee, 0x60, 0x8e, 0x7b, 0x8d, 0x04, 0xfb, 0x13, 0x1d, 0x27,
+	0xec, 0x51, 0x9f, 0x76, 0x8f, 0xfa, 0x74, 0x7b, 0xfc, 0x08, 0x4b, 0xd9, 0xd7, 0x14, 0xca, 0x2d,
+	0x1b, 0xf9, 0x28, 0x0c, 0xfe, 0x3f, 0xd9, 0xc9, 0x82, 0xd7, 0x23, 0x58, 0x4d, 0x4f, 0x76, 0xdf,
+	0x21, 0x97, 0x30, 0x6f, 0xd5, 0x54, 0xa0, 0x67, 0xc3, 0xd5, 0x1d, 0xf1, 0x3c, 0x09, 0x70, 0x3e,
+	0x97, 0xe1, 0xf7, 0x42, 0xfd, 0xef, 0x99, 0xc1, 0xc0, 0xf5, 0x3b, 0x9d, 0x01, 0x9c, 0x52, 0xe5,
+	0xa6, 0x3f, 0x7a, 0x32, 0xea, 0xda, 0xf4, 0xf3, 0x3e, 0xd8, 0x19, 0x63, 0x75, 0x44, 0x9d, 0xc0,
+	0xbc, 0xee, 0x23, 0x45, 0x94, 0x44, 0x41, 0xae, 0x3f, 0x52, 0xcf, 0x86, 0x60, 0x7b, 0xa4, 0xcd,
+	0x81, 0xd8, 0x78, 0x5c, 0x90, 0xf9, 0x78, 0xb2, 0x8f, 0x85, 0x60, 0x67, 0x8c, 0x75, 0x50, 0xb8,
+	0xea, 0x20, 0x35, 0x37, 0x2f, 0x10, 0x1e, 0x95, 0x42, 0x76, 0xda, 0x06, 0xfb, 0x13, 0x7d, 0x1c,
+	0x95, 0xff, 0x14, 0x32, 0x83, 0xe2, 0xbe, 0x70, 0xfa, 0x2c, 0xa5, 0x0c, 0xf9, 0xb3, 0x34, 0x3c,
+	0x5c, 0x82, 0xbd, 0x09, 0x1e, 0x2e, 0x95, 0x18, 0x36, 0xb2, 0xb0, 0xfe, 0xe6, 0x42, 0x07, 0x63,
+	0x17, 0xe7, 0xee, 0xcd, 0xe0, 0x70, 0x0a, 0x4f, 0xbb, 0xdd, 0x57, 0x2f, 0x7f, 0xf8, 0xbc, 0xcb,
+	0xd4, 0x55, 0xbf, 0x79, 0xd4, 0xe2, 0x71, 0xad, 0xcd, 0x63, 0x96, 0xf0, 0xe3, 0x17, 0xb5, 0x88,
+	0xe9, 0xde, 0x95, 0x35, 0xd1, 0x6b, 0xd5, 0x46, 0xff, 0x90, 0x35, 0xcb, 0x46, 0xf7, 0xd9, 0x7f,
+	0x03, 0x00, 0xe8, 0xd4, 0xc6, 0x03, 0xb1, 0x0d, 0x00, 0x00,
 }
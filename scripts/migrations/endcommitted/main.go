@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/config"
+	"github.com/domino14/liwords/pkg/stores/game"
+	"github.com/domino14/liwords/pkg/stores/user"
+)
+
+func main() {
+	// Mark the games that ended before game ends were committed along with
+	// the ratings and stats, so that they can't be rated again.
+	cfg := &config.Config{}
+	cfg.Load(os.Args[1:])
+	log.Info().Msgf("Loaded config: %v", cfg)
+
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	userStore, err := user.NewDBStore(cfg.DBConnString)
+	if err != nil {
+		panic(err)
+	}
+
+	gameStore, err := game.NewDBStore(cfg, userStore)
+	if err != nil {
+		panic(err)
+	}
+	n, err := gameStore.MarkEndedGamesCommitted(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	log.Info().Int64("games", n).Msg("marked-ended-games")
+}
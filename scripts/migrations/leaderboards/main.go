@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/config"
+	"github.com/domino14/liwords/pkg/stores/user"
+)
+
+func main() {
+	// Fill in the leaderboards from the ratings in the users' profiles. From
+	// then on, they're kept up to date as games are rated.
+	cfg := &config.Config{}
+	cfg.Load(os.Args[1:])
+	log.Info().Msgf("Loaded config: %v", cfg)
+
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	userStore, err := user.NewDBStore(cfg.DBConnString)
+	if err != nil {
+		panic(err)
+	}
+	err = userStore.RebuildLeaderboards(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	log.Info().Msg("rebuilt-leaderboards")
+}
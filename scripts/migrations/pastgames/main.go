@@ -8,14 +8,15 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/domino14/liwords/pkg/config"
-	"github.com/domino14/liwords/pkg/gameplay"
 	"github.com/domino14/liwords/pkg/stores/game"
 	"github.com/domino14/liwords/pkg/stores/user"
 	pb "github.com/domino14/liwords/rpc/api/proto/realtime"
 )
 
 func main() {
-	// Rerate all games in game store. Assumes that ratings are blanked out.
+	// Save every finished game again, so that the columns that past games
+	// are searched by are filled in. Rating changes weren't kept before, so
+	// they stay at zero.
 	cfg := &config.Config{}
 	cfg.Load(os.Args[1:])
 	log.Info().Msgf("Loaded config: %v", cfg)
@@ -37,7 +38,7 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	log.Info().Interface("ids", ids).Msg("listed-game-ids")
+	log.Info().Int("games", len(ids)).Msg("listed-game-ids")
 
 	for _, gid := range ids {
 		g, err := gameStore.Get(ctx, gid)
@@ -45,25 +46,14 @@ func main() {
 			log.Err(err).Str("gid", gid).Msg("bug")
 			continue
 		}
-		var winner string
-		winnerIdx := g.GetWinnerIdx()
-		if winnerIdx == 0 || winnerIdx == -1 {
-			winner = g.History().Players[0].Nickname
-		} else if winnerIdx == 1 {
-			winner = g.History().Players[1].Nickname
+		if g.GameEndReason == pb.GameEndReason_NONE {
+			continue
 		}
-
-		scores := map[string]int32{
-			g.History().Players[0].Nickname: int32(g.PointsFor(0)),
-			g.History().Players[1].Nickname: int32(g.PointsFor(1))}
-		ratings := map[string]int32{}
-		if g.CreationRequest().RatingMode == pb.RatingMode_RATED {
-			timeStarted := g.Timers.TimeStarted / 1000
-			ratings, err = gameplay.Rate(ctx, scores, g, winner, userStore, timeStarted)
-			if err != nil {
-				panic(err)
-			}
-			log.Info().Interface("ratings", ratings).Str("gameID", gid).Msg("rated")
+		err = gameStore.Set(ctx, g)
+		if err != nil {
+			log.Err(err).Str("gid", gid).Msg("saving")
+			continue
 		}
+		log.Info().Str("gameID", gid).Msg("saved")
 	}
 }